@@ -20,4 +20,15 @@ variable "DB_DEV_NAME" {
 env "local" {
   url = "postgres://${var.DB_USER}:${var.DB_PASSWORD}@${var.DB_HOST}:${var.DB_PORT}/${var.DB_NAME}?sslmode=disable"
   dev = "postgres://${var.DB_USER}:${var.DB_PASSWORD}@${var.DB_HOST}:${var.DB_PORT}/${var.DB_DEV_NAME}?sslmode=disable"
+
+  # Objetos da busca textual criados só em migrations (20261019220000_full_text_search.sql).
+  # Não existem no schema do ent, então sem o exclude o `migrate diff` geraria DROP para eles.
+  exclude = [
+    "*[type=extension]",
+    "*.f_unaccent[type=function]",
+    "*.debts.search_vector[type=column]",
+    "*.debts.debt_search_vector[type=index]",
+    "*.invoices.search_vector[type=column]",
+    "*.invoices.invoice_search_vector[type=index]",
+  ]
 }
@@ -1,6 +1,7 @@
 package cmd
 
 import (
+	"backend-go/internal/api/config"
 	"backend-go/internal/api/middlewares"
 	"backend-go/internal/api/v1/handlers"
 	queue "backend-go/internal/api/v1/queue/interfaces"
@@ -9,82 +10,216 @@ import (
 	"backend-go/internal/api/v1/repository/postgresql"
 	"backend-go/internal/api/v1/routes"
 	"backend-go/internal/api/v1/services"
+	storage "backend-go/internal/api/v1/storage/interfaces"
+	"backend-go/internal/api/v1/storage/local"
+	"backend-go/internal/api/v1/storage/s3"
+	"backend-go/pkg/auth"
+	"backend-go/pkg/metrics"
+	"backend-go/pkg/tracing"
+	"context"
+	"errors"
 	"fmt"
 	"log"
+	"log/slog"
+	"net/http"
 	"os"
+	"os/signal"
+	"strconv"
+	"syscall"
+	"time"
 
 	"github.com/gin-gonic/gin"
-	"github.com/joho/godotenv"
 	"github.com/spf13/cobra"
-)
-
-var (
-	apiPort     string
-	enableCORS  bool
-	enableDebug bool
+	"go.opentelemetry.io/contrib/instrumentation/github.com/gin-gonic/gin/otelgin"
 )
 
 var apiCmd = &cobra.Command{
 	Use:   "api",
 	Short: "Start the API server",
 	Run: func(cmd *cobra.Command, args []string) {
-		startAPIServer()
+		startAPIServer(cmd)
 	},
 }
 
 func init() {
 	rootCmd.AddCommand(apiCmd)
-	apiCmd.Flags().StringVarP(&apiPort, "port", "p", "8080", "Port to run API server on")
-	apiCmd.Flags().BoolVar(&enableCORS, "cors", false, "Enable CORS middleware")
-	apiCmd.Flags().BoolVar(&enableDebug, "debug", false, "Enable debug mode")
+	apiCmd.Flags().IntP("port", "p", 8080, "Port to run API server on (API_PORT)")
+	apiCmd.Flags().Bool("cors", false, "Enable CORS middleware (CORS_ENABLED)")
+	apiCmd.Flags().Bool("debug", false, "Enable debug mode (API_DEBUG)")
+	apiCmd.Flags().Bool("allow-pending-migrations", false, "Inicia mesmo com migrations pendentes (MIGRATIONS_ALLOW_PENDING)")
 }
 
-func startAPIServer() {
-	if enableDebug {
+func startAPIServer(cmd *cobra.Command) {
+	cfg := loadConfig(cmd)
+	if err := cfg.ValidateServer(); err != nil {
+		log.Fatalf("Configuração inválida:\n%v", err)
+	}
+
+	// Registrado antes das conexões para que o flush dos traces seja o último
+	// defer a rodar
+	shutdownTracing := setupTracing(cfg.Tracing)
+	defer shutdownTracing()
+
+	if cfg.Server.Debug {
 		gin.SetMode(gin.DebugMode)
 	} else {
 		gin.SetMode(gin.ReleaseMode)
 	}
 
-	_ = godotenv.Load()
-
-	db := connectDatabase()
+	// Os defers rodam na ordem inversa: a fila fecha antes do banco, e os dois
+	// só depois que as requisições em andamento terminam
+	db := connectDatabase(cfg.Database)
 	defer db.Close()
 
-	mq := connectQueue()
+	mq := connectQueue(cfg.Queue)
 	defer mq.Close()
 
-	r := setupRouter(db, mq)
+	tokens := newTokenManager(cfg.Auth)
+
+	fs := connectStorage(cfg.Storage)
+
+	health := services.NewHealthService(db, mq)
+
+	if cfg.Metrics.Enabled {
+		registerMetrics(db, mq, cfg.Queue.Name)
+	}
+
+	r := setupRouter(cfg, db, mq, tokens, fs, health)
 
 	for _, route := range r.Routes() {
-		fmt.Printf("[%s] %s\n", route.Method, route.Path)
+		slog.Debug("rota registrada", "method", route.Method, "path", route.Path)
 	}
 
-	r.Run(":" + apiPort)
+	srv := &http.Server{
+		Addr:              ":" + strconv.Itoa(cfg.Server.Port),
+		Handler:           r,
+		ReadTimeout:       cfg.Server.ReadTimeout,
+		ReadHeaderTimeout: cfg.Server.ReadHeaderTimeout,
+		WriteTimeout:      cfg.Server.WriteTimeout,
+		IdleTimeout:       cfg.Server.IdleTimeout,
+	}
+
+	if err := serve(srv, cfg.Server, health.SetShuttingDown); err != nil {
+		slog.Error("erro no servidor HTTP", "error", err)
+	}
 }
 
-func connectDatabase() *postgresql.PostgreSQL {
-	dsn := fmt.Sprintf(
-		"host=%s port=%s user=%s password=%s dbname=%s sslmode=disable",
-		os.Getenv("DB_HOST"),
-		os.Getenv("DB_PORT"),
-		os.Getenv("DB_USER"),
-		os.Getenv("DB_PASSWORD"),
-		os.Getenv("DB_NAME"),
-	)
-
-	db, err := postgresql.NewPostgreSQL(dsn)
+// setupTracing configura o exportador de traces e retorna a função que envia
+// os spans pendentes ao encerrar
+func setupTracing(cfg config.TracingConfig) func() {
+	shutdown, err := tracing.Setup(context.Background(), tracing.Config{
+		Exporter:    cfg.Exporter,
+		Endpoint:    cfg.Endpoint,
+		Insecure:    cfg.Insecure,
+		ServiceName: cfg.ServiceName,
+		SampleRatio: cfg.SampleRatio,
+	})
+	if err != nil {
+		log.Fatalf("Falha ao configurar os traces: %v", err)
+	}
+
+	return func() {
+		ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+		defer cancel()
+
+		if err := shutdown(ctx); err != nil {
+			slog.Error("erro ao enviar os traces pendentes", "error", err)
+		}
+	}
+}
+
+// registerMetrics expõe no /metrics o pool do banco, o tamanho da fila e os
+// indicadores de negócio
+func registerMetrics(db *postgresql.PostgreSQL, mq queue.MessageQueue, queueName string) {
+	metrics.RegisterDBStats(db.DB(), "postgres")
+	metrics.RegisterQueueDepth(queueName, mq.Depth)
+
+	metricsService := services.NewMetricsService(db)
+	metrics.RegisterGauge("debts_unpaid", "Débitos ainda não pagos, em todos os workspaces.", metricsService.UnpaidDebts)
+	metrics.RegisterGauge("invoices_overdue", "Faturas vencidas e não pagas, em todos os workspaces.", metricsService.OverdueInvoices)
+}
+
+// serve atende até receber SIGINT ou SIGTERM. Então chama onShutdown, espera
+// ShutdownDelay para que o balanceador perceba a falha no /readyz, para de
+// aceitar conexões e espera as requisições em andamento por até
+// ShutdownTimeout. Um segundo sinal encerra o processo imediatamente.
+func serve(srv *http.Server, cfg config.ServerConfig, onShutdown func()) error {
+	ctx, stop := signal.NotifyContext(context.Background(), os.Interrupt, syscall.SIGTERM)
+	defer stop()
+
+	errCh := make(chan error, 1)
+	go func() {
+		slog.Info("API ouvindo", "addr", srv.Addr)
+		errCh <- srv.ListenAndServe()
+	}()
+
+	select {
+	case err := <-errCh:
+		return err
+	case <-ctx.Done():
+	}
+
+	// Restaura o comportamento padrão dos sinais
+	stop()
+	onShutdown()
+
+	if cfg.ShutdownDelay > 0 {
+		slog.Info("sinal recebido, aguardando antes de parar de aceitar conexões", "delay", cfg.ShutdownDelay)
+		time.Sleep(cfg.ShutdownDelay)
+	}
+	slog.Info("aguardando as requisições em andamento", "timeout", cfg.ShutdownTimeout)
+
+	shutdownCtx, cancel := context.WithTimeout(context.Background(), cfg.ShutdownTimeout)
+	defer cancel()
+
+	if err := srv.Shutdown(shutdownCtx); err != nil {
+		slog.Warn("prazo de encerramento esgotado, fechando as conexões restantes", "error", err)
+		srv.Close()
+	}
+
+	if err := <-errCh; !errors.Is(err, http.ErrServerClosed) {
+		return err
+	}
+	slog.Info("servidor HTTP encerrado")
+	return nil
+}
+
+func connectDatabase(cfg config.DatabaseConfig) *postgresql.PostgreSQL {
+	checkMigrations(cfg)
+
+	db, err := postgresql.NewPostgreSQL(cfg)
 	if err != nil {
 		log.Fatalf("Falha ao conectar ao banco: %v", err)
 	}
 	return db
 }
 
-func connectQueue() queue.MessageQueue {
-	amqpURI := os.Getenv("AMQP_URI")
-	queueName := os.Getenv("QUEUE_NAME")
+// checkMigrations impede a inicialização com o schema desatualizado, a menos
+// que allow_pending_migrations esteja ativo
+func checkMigrations(cfg config.DatabaseConfig) {
+	migrator, err := postgresql.NewMigrator(cfg.DSN(cfg.Name))
+	if err != nil {
+		log.Fatalf("Falha ao carregar as migrations: %v", err)
+	}
+	defer migrator.Close()
+
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
+	defer cancel()
+
+	err = migrator.CheckUpToDate(ctx)
+	if err == nil {
+		return
+	}
+
+	if cfg.AllowPendingMigrations {
+		slog.Warn("schema desatualizado, iniciando mesmo assim", "error", err)
+		return
+	}
+	log.Fatalf("Schema desatualizado: %v. Rode `backend-go migrate up` antes de iniciar.", err)
+}
 
-	mq, err := rabbitmq.NewRabbitMQ(amqpURI, queueName)
+func connectQueue(cfg config.QueueConfig) queue.MessageQueue {
+	mq, err := rabbitmq.NewRabbitMQ(cfg.URI, cfg.Name)
 
 	if err != nil {
 		log.Fatalf("Falha ao conectar a fila: %v", err)
@@ -94,10 +229,74 @@ func connectQueue() queue.MessageQueue {
 
 }
 
-func setupRouter(db repository.Database, mq queue.MessageQueue) *gin.Engine {
+// connectStorage escolhe onde os anexos são salvos: "local" (padrão) ou "s3"
+func connectStorage(cfg config.StorageConfig) storage.FileStorage {
+	var fs storage.FileStorage
+	var err error
+
+	switch cfg.Driver {
+	case "local":
+		fs, err = local.NewLocalStorage(cfg.Path)
+	case "s3":
+		ctx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
+		defer cancel()
+
+		fs, err = s3.NewS3Storage(ctx, s3.Config{
+			Endpoint:  cfg.S3.Endpoint,
+			AccessKey: cfg.S3.AccessKey,
+			SecretKey: cfg.S3.SecretKey,
+			Bucket:    cfg.S3.Bucket,
+			Region:    cfg.S3.Region,
+			UseSSL:    cfg.S3.UseSSL,
+		})
+	default:
+		err = fmt.Errorf("driver de storage desconhecido: %s", cfg.Driver)
+	}
+
+	if err != nil {
+		log.Fatalf("Falha ao configurar storage de anexos: %v", err)
+	}
+	return fs
+}
+
+func newTokenManager(cfg config.AuthConfig) *auth.TokenManager {
+	tokens, err := auth.NewTokenManager(cfg.JWTSecret, cfg.AccessTTL, cfg.RefreshTTL)
+	if err != nil {
+		log.Fatalf("Falha ao configurar autenticação: %v", err)
+	}
+	return tokens
+}
+
+func setupRouter(cfg *config.Config, db repository.Database, mq queue.MessageQueue, tokens *auth.TokenManager, fs storage.FileStorage, health *services.HealthService) *gin.Engine {
+	healthHandler := handlers.NewHealthHandler(health)
+
+	authService := services.NewAuthService(db, tokens)
+	authHandler := handlers.NewAuthHandler(authService)
+
+	apiKeyService := services.NewAPIKeyService(db)
+	apiKeyHandler := handlers.NewAPIKeyHandler(apiKeyService)
+
+	workspaceService := services.NewWorkspaceService(db)
+	workspaceHandler := handlers.NewWorkspaceHandler(workspaceService)
+
 	debtService := services.NewDebtService(db, mq)
 	debtHandler := handlers.NewDebtHandler(debtService)
 
+	importService := services.NewImportService(db, debtService)
+	importHandler := handlers.NewImportHandler(importService)
+
+	attachmentService := services.NewAttachmentService(db, fs, cfg.Storage.MaxAttachmentSize)
+	attachmentHandler := handlers.NewAttachmentHandler(attachmentService)
+
+	tagService := services.NewTagService(db)
+	tagHandler := handlers.NewTagHandler(tagService)
+
+	reportService := services.NewReportService(db)
+	reportHandler := handlers.NewReportHandler(reportService)
+
+	personService := services.NewPersonService(db)
+	personHandler := handlers.NewPersonHandler(personService)
+
 	invoiceService := services.NewInvoiceService(db)
 	invoiceHandler := handlers.NewInvoiceHandler(invoiceService)
 
@@ -107,21 +306,50 @@ func setupRouter(db repository.Database, mq queue.MessageQueue) *gin.Engine {
 	paymentStatusService := services.NewPaymentStatusService(db)
 	paymentStatusHandler := handlers.NewPaymentStatusHandler(paymentStatusService)
 
-	r := gin.Default()
+	r := gin.New()
+	// Sem exportador configurado, os spans não são gravados
+	r.Use(otelgin.Middleware(cfg.Tracing.ServiceName, otelgin.WithFilter(middlewares.TraceFilter)))
+	r.Use(middlewares.RequestIDMiddleware())
+	r.Use(middlewares.LoggerMiddleware())
+	r.Use(gin.Recovery())
+	if cfg.Metrics.Enabled {
+		r.Use(middlewares.MetricsMiddleware())
+		r.GET("/metrics", gin.WrapH(metrics.Handler()))
+	}
 	v1 := r.Group("/api/v1")
 
-	if enableCORS {
-		v1.Use(middlewares.CORSMiddleware())
+	if cfg.CORS.Enabled {
+		v1.Use(middlewares.CORSMiddleware(cfg.CORS.AllowedOrigins))
 	}
 	v1.Use(middlewares.UUIDMiddleware())
 	v1.Use(middlewares.ErrorMiddleware())
 
+	authMiddleware := middlewares.AuthMiddleware(tokens, apiKeyService)
+
+	// Rotas que exigem usuário autenticado. Chaves de API só acessam os
+	// recursos dos seus escopos, verificados pelo ScopeMiddleware de cada grupo.
+	private := v1.Group("")
+	private.Use(authMiddleware)
+
+	// Rotas com dados de um workspace; viewers só podem ler
+	workspace := private.Group("")
+	workspace.Use(middlewares.WorkspaceMiddleware(workspaceService))
+
 	r.StaticFile("/favicon.ico", "./static/favicon.ico")
+	routes.RegisterHealthRoutes(r.Group(""), healthHandler)
 	routes.RegisterDocsRoutes(r.Group("/docs/v1"))
-	routes.RegisterDebtRoutes(v1.Group("/debts"), debtHandler)
-	routes.RegisterInvoiceRoutes(v1.Group("/invoices"), invoiceHandler)
-	routes.RegisterCategoryRoutes(v1.Group("/categories"), categoryHandler)
-	routes.RegisterPaymentStatusRoutes(v1.Group("/payment_status"), paymentStatusHandler)
+	routes.RegisterAuthRoutes(v1.Group("/auth"), authHandler, authMiddleware)
+	routes.RegisterAPIKeyRoutes(private.Group("/api_keys"), apiKeyHandler)
+	routes.RegisterWorkspaceRoutes(private.Group("/workspaces", middlewares.ScopeMiddleware("workspaces")), workspaceHandler)
+	routes.RegisterDebtRoutes(workspace.Group("/debts", middlewares.ScopeMiddleware("debts")), debtHandler)
+	routes.RegisterImportRoutes(workspace.Group("/imports", middlewares.ScopeMiddleware("imports")), importHandler)
+	routes.RegisterAttachmentRoutes(workspace.Group("", middlewares.ScopeMiddleware("attachments")), attachmentHandler)
+	routes.RegisterTagRoutes(workspace.Group("/tags", middlewares.ScopeMiddleware("tags")), tagHandler)
+	routes.RegisterReportRoutes(workspace.Group("/reports", middlewares.ScopeMiddleware("reports")), reportHandler)
+	routes.RegisterPersonRoutes(workspace.Group("/people", middlewares.ScopeMiddleware("people")), personHandler)
+	routes.RegisterInvoiceRoutes(workspace.Group("/invoices", middlewares.ScopeMiddleware("invoices")), invoiceHandler)
+	routes.RegisterCategoryRoutes(workspace.Group("/categories", middlewares.ScopeMiddleware("categories")), categoryHandler)
+	routes.RegisterPaymentStatusRoutes(private.Group("/payment_status", middlewares.ScopeMiddleware("payment_status")), paymentStatusHandler)
 
 	return r
 }
@@ -0,0 +1,79 @@
+package cmd
+
+import (
+	"backend-go/internal/api/config"
+	"backend-go/pkg/logger"
+	"fmt"
+	"log"
+	"os"
+
+	"github.com/joho/godotenv"
+	"github.com/spf13/cobra"
+	"gopkg.in/yaml.v3"
+)
+
+var configFile string
+
+var configCmd = &cobra.Command{
+	Use:   "config",
+	Short: "Inspeciona a configuração da aplicação",
+}
+
+var configPrintCmd = &cobra.Command{
+	Use:   "print",
+	Short: "Mostra a configuração efetiva em YAML, com os segredos escondidos",
+	Run: func(cmd *cobra.Command, args []string) {
+		printConfig(cmd)
+	},
+}
+
+func init() {
+	rootCmd.PersistentFlags().StringVarP(&configFile, "config", "c", "", "Arquivo de configuração YAML ou TOML (CONFIG_FILE)")
+	rootCmd.AddCommand(configCmd)
+	configCmd.AddCommand(configPrintCmd)
+}
+
+// readConfig combina os valores padrão, o arquivo de configuração, as
+// variáveis de ambiente (incluindo o .env) e as flags alteradas de cmd
+func readConfig(cmd *cobra.Command) *config.Config {
+	_ = godotenv.Load()
+
+	path := configFile
+	if path == "" {
+		path = os.Getenv(config.FileEnv)
+	}
+
+	cfg, err := config.Load(path, cmd.Flags())
+	if err != nil {
+		log.Fatalf("Falha ao carregar a configuração: %v", err)
+	}
+	return cfg
+}
+
+// loadConfig lê a configuração, encerra se ela for inválida e configura os logs
+func loadConfig(cmd *cobra.Command) *config.Config {
+	cfg := readConfig(cmd)
+	if err := cfg.Validate(); err != nil {
+		log.Fatalf("Configuração inválida:\n%v", err)
+	}
+
+	if err := logger.Setup(cfg.Log.Level, cfg.Log.Format); err != nil {
+		log.Fatalf("Falha ao configurar os logs: %v", err)
+	}
+	return cfg
+}
+
+func printConfig(cmd *cobra.Command) {
+	cfg := readConfig(cmd)
+
+	out, err := yaml.Marshal(cfg.Redacted())
+	if err != nil {
+		log.Fatalf("Falha ao exibir a configuração: %v", err)
+	}
+	fmt.Print(string(out))
+
+	if err := cfg.ValidateServer(); err != nil {
+		fmt.Fprintf(os.Stderr, "\n⚠️  Configuração inválida:\n%v\n", err)
+		os.Exit(1)
+	}
+}
@@ -0,0 +1,144 @@
+package cmd
+
+import (
+	"backend-go/internal/api/config"
+	"backend-go/internal/api/v1/repository/postgresql"
+	"context"
+	"fmt"
+	"log"
+	"strconv"
+
+	"github.com/spf13/cobra"
+)
+
+var migrateBaseline string
+
+var migrateCmd = &cobra.Command{
+	Use:   "migrate",
+	Short: "Gerencia as migrations versionadas do banco",
+}
+
+var migrateUpCmd = &cobra.Command{
+	Use:   "up [n]",
+	Short: "Aplica as n próximas migrations pendentes (todas se n for omitido)",
+	Args:  cobra.MaximumNArgs(1),
+	Run: func(cmd *cobra.Command, args []string) {
+		runMigrateUp(cmd, migrationCount(args, 0))
+	},
+}
+
+var migrateDownCmd = &cobra.Command{
+	Use:   "down [n]",
+	Short: "Reverte as n últimas migrations aplicadas (1 se n for omitido)",
+	Long: `Reverte as n últimas migrations aplicadas (1 se n for omitido).
+
+O schema anterior é reconstruído no banco de desenvolvimento (DB_DEV_NAME), que
+precisa estar vazio, e a diferença é aplicada no banco. Dados alterados pelas
+migrations revertidas não são restaurados.`,
+	Args: cobra.MaximumNArgs(1),
+	Run: func(cmd *cobra.Command, args []string) {
+		runMigrateDown(cmd, migrationCount(args, 1))
+	},
+}
+
+var migrateStatusCmd = &cobra.Command{
+	Use:   "status",
+	Short: "Mostra as migrations aplicadas e pendentes",
+	Run: func(cmd *cobra.Command, args []string) {
+		runMigrateStatus(cmd)
+	},
+}
+
+func init() {
+	rootCmd.AddCommand(migrateCmd)
+	migrateCmd.AddCommand(migrateUpCmd, migrateDownCmd, migrateStatusCmd)
+	migrateUpCmd.Flags().StringVar(&migrateBaseline, "baseline", "", "Versão já presente em um banco sem histórico de migrations")
+}
+
+func migrationCount(args []string, fallback int) int {
+	if len(args) == 0 {
+		return fallback
+	}
+
+	n, err := strconv.Atoi(args[0])
+	if err != nil || n < 1 {
+		log.Fatalf("Quantidade de migrations inválida: %s", args[0])
+	}
+	return n
+}
+
+func newMigrator(cfg config.DatabaseConfig) *postgresql.Migrator {
+	migrator, err := postgresql.NewMigrator(cfg.DSN(cfg.Name))
+	if err != nil {
+		log.Fatalf("Falha ao carregar as migrations: %v", err)
+	}
+	return migrator
+}
+
+func runMigrateUp(cmd *cobra.Command, n int) {
+	migrator := newMigrator(loadConfig(cmd).Database)
+	defer migrator.Close()
+
+	applied, err := migrator.Up(context.Background(), n, migrateBaseline)
+	for _, version := range applied {
+		fmt.Printf("✅ %s\n", version)
+	}
+	if err != nil {
+		log.Fatalf("Falha ao aplicar as migrations: %v", err)
+	}
+
+	if len(applied) == 0 {
+		fmt.Println("Nenhuma migration pendente")
+		return
+	}
+	fmt.Printf("🚀 %d migrations aplicadas\n", len(applied))
+}
+
+func runMigrateDown(cmd *cobra.Command, n int) {
+	cfg := loadConfig(cmd).Database
+	if cfg.DevName == "" {
+		log.Fatal("database.dev_name (DB_DEV_NAME) é obrigatório para reverter migrations")
+	}
+
+	migrator := newMigrator(cfg)
+	defer migrator.Close()
+
+	reverted, err := migrator.Down(context.Background(), cfg.DSN(cfg.DevName), n)
+	if err != nil {
+		log.Fatalf("Falha ao reverter as migrations: %v", err)
+	}
+
+	if len(reverted) == 0 {
+		fmt.Println("Nenhuma migration aplicada")
+		return
+	}
+	for _, version := range reverted {
+		fmt.Printf("↩️  %s\n", version)
+	}
+	fmt.Printf("%d migrations revertidas\n", len(reverted))
+}
+
+func runMigrateStatus(cmd *cobra.Command) {
+	migrator := newMigrator(loadConfig(cmd).Database)
+	defer migrator.Close()
+
+	status, err := migrator.Status(context.Background())
+	if err != nil {
+		log.Fatalf("Falha ao ler o status das migrations: %v", err)
+	}
+
+	current := status.Current
+	if current == "" {
+		current = "nenhuma"
+	}
+	fmt.Printf("Versão atual: %s (%d aplicadas)\n", current, status.Applied)
+
+	if len(status.Pending) == 0 {
+		fmt.Println("✅ Schema atualizado")
+		return
+	}
+	fmt.Printf("⏳ %d pendentes:\n", len(status.Pending))
+	for _, name := range status.Pending {
+		fmt.Printf("  %s\n", name)
+	}
+}
@@ -0,0 +1,42 @@
+package cmd
+
+import (
+	"backend-go/internal/api/v1/services"
+	"context"
+	"fmt"
+	"log"
+
+	"github.com/spf13/cobra"
+)
+
+var purgeCmd = &cobra.Command{
+	Use:   "purge",
+	Short: "Apaga definitivamente os registros da lixeira mais antigos que a retenção",
+	Run: func(cmd *cobra.Command, args []string) {
+		runPurge(cmd)
+	},
+}
+
+func init() {
+	rootCmd.AddCommand(purgeCmd)
+	purgeCmd.Flags().Duration("retention", services.DefaultTrashRetention, "Tempo que os registros ficam na lixeira (TRASH_RETENTION)")
+}
+
+func runPurge(cmd *cobra.Command) {
+	cfg := loadConfig(cmd)
+
+	db := connectDatabase(cfg.Database)
+	defer db.Close()
+
+	trashService := services.NewTrashService(db, connectStorage(cfg.Storage))
+
+	result, err := trashService.Purge(context.Background(), cfg.Trash.Retention)
+	if err != nil {
+		log.Fatalf("Falha ao limpar a lixeira: %v", err)
+	}
+
+	fmt.Printf(
+		"🧹 Lixeira limpa: %d débitos, %d faturas, %d categorias, %d pessoas e %d arquivos removidos\n",
+		result.Debts, result.Invoices, result.Categories, result.People, len(result.StorageKeys),
+	)
+}
@@ -7,31 +7,43 @@ import (
 	"log"
 	"os"
 
+	"backend-go/internal/api/config"
 	"backend-go/internal/api/v1/repository/models"
 	"backend-go/internal/api/v1/repository/postgresql"
+	"backend-go/pkg/auth"
+	"backend-go/pkg/ent"
 	"backend-go/pkg/ent/category"
 	"backend-go/pkg/ent/paymentstatus"
+	"backend-go/pkg/ent/user"
+	"backend-go/pkg/ent/workspacemember"
 
-	"github.com/joho/godotenv"
+	"github.com/google/uuid"
 	"github.com/spf13/cobra"
 )
 
+var (
+	seedUserName     string
+	seedUserEmail    string
+	seedUserPassword string
+)
+
 var seedCmd = &cobra.Command{
 	Use:   "seed",
 	Short: "Popula o banco com dados iniciais",
 	Run: func(cmd *cobra.Command, args []string) {
-		runSeed()
+		runSeed(cmd)
 	},
 }
 
 func init() {
 	rootCmd.AddCommand(seedCmd)
+	seedCmd.Flags().StringVar(&seedUserName, "name", "Admin", "Nome do usuário e do seu workspace")
+	seedCmd.Flags().StringVar(&seedUserEmail, "email", "admin@example.com", "E-mail do usuário dono dos dados")
+	seedCmd.Flags().StringVar(&seedUserPassword, "password", "", "Senha do usuário, usada apenas se ele ainda não existir")
 }
 
-func runSeed() {
-	_ = godotenv.Load()
-
-	db := connectDatabase()
+func runSeed(cmd *cobra.Command) {
+	db := connectDatabase(loadConfig(cmd).Database)
 	defer db.Close()
 
 	ctx := context.Background()
@@ -42,11 +54,21 @@ func runSeed() {
 		log.Fatalf("erro ao criar payment statuses: %v", err)
 	}
 
-	if err := seedCategories(ctx, db); err != nil {
+	userID, err := seedUser(ctx, db)
+	if err != nil {
+		log.Fatalf("erro ao criar usuário: %v", err)
+	}
+
+	workspaceID, err := seedWorkspace(ctx, db, userID)
+	if err != nil {
+		log.Fatalf("erro ao criar workspace: %v", err)
+	}
+
+	if err := seedCategories(ctx, db, workspaceID); err != nil {
 		log.Fatalf("erro ao criar categories: %v", err)
 	}
 
-	if err := seedDebts(ctx, db, "./static/json/debts.json"); err != nil {
+	if err := seedDebts(ctx, db, workspaceID, "./static/json/debts.json"); err != nil {
 		log.Fatalf("erro ao criar debts: %v", err)
 	}
 
@@ -86,23 +108,77 @@ func seedPaymentStatuses(ctx context.Context, db *postgresql.PostgreSQL) error {
 	return nil
 }
 
-func seedCategories(ctx context.Context, db *postgresql.PostgreSQL) error {
-	categories := []string{
-		"Transporte",
-		"Bebidas e Conveniência",
-		"Restaurantes e Alimentação",
-		"Mercado e Compras",
-		"Assinaturas e Serviços Digitais",
-		"Entretenimento e Eventos",
-		"Farmácias e Saúde",
-		"Vestuário e Cosméticos",
-		"Barbearia e Beleza",
-		"Eletrônicos e Tecnologia",
-		"Ótica e Acessórios",
-	}
-
-	for _, name := range categories {
-		exists, err := db.Client.Category.Query().Where(category.NameEQ(name)).Exist(ctx)
+func seedUser(ctx context.Context, db *postgresql.PostgreSQL) (uuid.UUID, error) {
+	existing, err := db.Client.User.Query().Where(user.EmailEQ(seedUserEmail)).Only(ctx)
+	if err == nil {
+		return existing.ID, nil
+	}
+	if !ent.IsNotFound(err) {
+		return uuid.Nil, err
+	}
+
+	if seedUserPassword == "" {
+		return uuid.Nil, fmt.Errorf("usuário %s não existe: informe --password para criá-lo", seedUserEmail)
+	}
+
+	hash, err := auth.HashPassword(seedUserPassword)
+	if err != nil {
+		return uuid.Nil, err
+	}
+
+	created, err := db.Client.User.
+		Create().
+		SetName(seedUserName).
+		SetEmail(seedUserEmail).
+		SetPasswordHash(hash).
+		Save(ctx)
+	if err != nil {
+		return uuid.Nil, err
+	}
+	fmt.Printf("✅ Criado: %s\n", seedUserEmail)
+	return created.ID, nil
+}
+
+// seedWorkspace retorna o workspace pessoal do usuário, criando-o se necessário
+func seedWorkspace(ctx context.Context, db *postgresql.PostgreSQL, userID uuid.UUID) (uuid.UUID, error) {
+	member, err := db.Client.WorkspaceMember.Query().
+		Where(
+			workspacemember.UserID(userID),
+			workspacemember.RoleEQ(workspacemember.RoleOwner),
+		).
+		Order(ent.Asc(workspacemember.FieldCreatedAt)).
+		First(ctx)
+	if err == nil {
+		return member.WorkspaceID, nil
+	}
+	if !ent.IsNotFound(err) {
+		return uuid.Nil, err
+	}
+
+	created, err := db.Client.Workspace.
+		Create().
+		SetName(seedUserName).
+		Save(ctx)
+	if err != nil {
+		return uuid.Nil, err
+	}
+
+	err = db.Client.WorkspaceMember.
+		Create().
+		SetWorkspaceID(created.ID).
+		SetUserID(userID).
+		SetRole(workspacemember.RoleOwner).
+		Exec(ctx)
+	if err != nil {
+		return uuid.Nil, err
+	}
+	fmt.Printf("✅ Criado workspace: %s\n", created.Name)
+	return created.ID, nil
+}
+
+func seedCategories(ctx context.Context, db *postgresql.PostgreSQL, workspaceID uuid.UUID) error {
+	for _, name := range config.DefaultCategories {
+		exists, err := db.Client.Category.Query().Where(category.NameEQ(name), category.WorkspaceID(workspaceID)).Exist(ctx)
 		if err != nil {
 			return err
 		}
@@ -113,6 +189,7 @@ func seedCategories(ctx context.Context, db *postgresql.PostgreSQL) error {
 		_, err = db.Client.Category.
 			Create().
 			SetName(name).
+			SetWorkspaceID(workspaceID).
 			Save(ctx)
 		if err != nil {
 			return err
@@ -122,7 +199,7 @@ func seedCategories(ctx context.Context, db *postgresql.PostgreSQL) error {
 	return nil
 }
 
-func seedDebts(ctx context.Context, db *postgresql.PostgreSQL, jsonPath string) error {
+func seedDebts(ctx context.Context, db *postgresql.PostgreSQL, workspaceID uuid.UUID, jsonPath string) error {
 	data, err := os.ReadFile(jsonPath)
 	if err != nil {
 		return fmt.Errorf("erro ao ler arquivo JSON: %w", err)
@@ -140,6 +217,7 @@ func seedDebts(ctx context.Context, db *postgresql.PostgreSQL, jsonPath string)
 			SetAmount(d.Amount).
 			SetPurchaseDate(d.PurchaseDate).
 			SetDueDate(d.DueDate).
+			SetWorkspaceID(workspaceID).
 			// SetInvoiceID e SetCategoryID são opcionais
 			Save(ctx)
 		if err != nil {
@@ -0,0 +1,95 @@
+package cmd
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"strings"
+
+	"backend-go/pkg/ent"
+	"backend-go/pkg/ent/user"
+	"backend-go/pkg/ent/workspace"
+	"backend-go/pkg/ent/workspacemember"
+
+	"github.com/google/uuid"
+	"github.com/spf13/cobra"
+)
+
+// Workspace criado pela migration legacy_workspace para os dados de antes dos
+// workspaces
+var legacyWorkspaceID = uuid.MustParse("00000000-0000-0000-0000-000000000001")
+
+var legacyOwnerEmail string
+
+var workspaceCmd = &cobra.Command{
+	Use:   "workspace",
+	Short: "Administra os workspaces",
+}
+
+var workspaceClaimLegacyCmd = &cobra.Command{
+	Use:   "claim-legacy",
+	Short: "Torna um usuário dono do workspace com os dados de antes dos workspaces",
+	Long: `Torna o usuário informado dono do workspace "Legado", criado pela migration
+para os débitos, faturas e categorias cadastrados antes dos workspaces. A
+migration já o entrega ao usuário mais antigo; este comando é necessário quando
+não havia usuários ao migrar ou quando os dados devem ir para outra pessoa.`,
+	Run: func(cmd *cobra.Command, args []string) {
+		runClaimLegacyWorkspace(cmd)
+	},
+}
+
+func init() {
+	rootCmd.AddCommand(workspaceCmd)
+	workspaceCmd.AddCommand(workspaceClaimLegacyCmd)
+	workspaceClaimLegacyCmd.Flags().StringVar(&legacyOwnerEmail, "email", "", "E-mail do usuário que será dono do workspace")
+	_ = workspaceClaimLegacyCmd.MarkFlagRequired("email")
+}
+
+func runClaimLegacyWorkspace(cmd *cobra.Command) {
+	db := connectDatabase(loadConfig(cmd).Database)
+	defer db.Close()
+
+	ctx := context.Background()
+
+	exists, err := db.Client.Workspace.Query().Where(workspace.ID(legacyWorkspaceID)).Exist(ctx)
+	if err != nil {
+		log.Fatalf("erro ao buscar workspace: %v", err)
+	}
+	if !exists {
+		fmt.Println("Nenhum workspace legado: não havia dados sem workspace ao migrar")
+		return
+	}
+
+	owner, err := db.Client.User.Query().
+		Where(user.EmailEQ(strings.ToLower(strings.TrimSpace(legacyOwnerEmail)))).
+		Only(ctx)
+	if err != nil {
+		if ent.IsNotFound(err) {
+			log.Fatalf("usuário %s não encontrado", legacyOwnerEmail)
+		}
+		log.Fatalf("erro ao buscar usuário: %v", err)
+	}
+
+	member, err := db.Client.WorkspaceMember.Query().
+		Where(
+			workspacemember.WorkspaceID(legacyWorkspaceID),
+			workspacemember.UserID(owner.ID),
+		).
+		Only(ctx)
+	switch {
+	case err == nil:
+		err = member.Update().SetRole(workspacemember.RoleOwner).Exec(ctx)
+	case ent.IsNotFound(err):
+		err = db.Client.WorkspaceMember.
+			Create().
+			SetWorkspaceID(legacyWorkspaceID).
+			SetUserID(owner.ID).
+			SetRole(workspacemember.RoleOwner).
+			Exec(ctx)
+	}
+	if err != nil {
+		log.Fatalf("erro ao salvar membro: %v", err)
+	}
+
+	fmt.Printf("✅ %s é dono do workspace legado (%s)\n", owner.Email, legacyWorkspaceID)
+}
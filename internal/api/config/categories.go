@@ -15,6 +15,21 @@ const (
 	CategoryOptical       = "Ótica e Acessórios"
 )
 
+// Categorias criadas para todo novo usuário
+var DefaultCategories = []string{
+	CategoryTransport,
+	CategoryConvenience,
+	CategoryFood,
+	CategoryMarket,
+	CategorySubscriptions,
+	CategoryEntertainment,
+	CategoryPharmacy,
+	CategoryClothing,
+	CategoryBarbershop,
+	CategoryElectronics,
+	CategoryOptical,
+}
+
 var CategoryMap = map[string]string{
 	// Transporte
 	"Uber - NuPay": CategoryTransport,
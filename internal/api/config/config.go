@@ -0,0 +1,302 @@
+package config
+
+import (
+	"backend-go/pkg/logger"
+	"backend-go/pkg/tracing"
+	"errors"
+	"fmt"
+	"net/url"
+	"slices"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// Config reúne toda a configuração da aplicação. Cada campo pode vir do
+// arquivo (chaves yaml/toml), de uma variável de ambiente (tag env) ou de uma
+// flag da linha de comando (tag flag), nessa ordem de precedência crescente.
+// Campos com a tag secret são omitidos pelo Redacted.
+type Config struct {
+	Server   ServerConfig   `yaml:"server" toml:"server"`
+	Database DatabaseConfig `yaml:"database" toml:"database"`
+	Queue    QueueConfig    `yaml:"queue" toml:"queue"`
+	CORS     CORSConfig     `yaml:"cors" toml:"cors"`
+	Auth     AuthConfig     `yaml:"auth" toml:"auth"`
+	Storage  StorageConfig  `yaml:"storage" toml:"storage"`
+	Trash    TrashConfig    `yaml:"trash" toml:"trash"`
+	Metrics  MetricsConfig  `yaml:"metrics" toml:"metrics"`
+	Log      LogConfig      `yaml:"log" toml:"log"`
+	Tracing  TracingConfig  `yaml:"tracing" toml:"tracing"`
+}
+
+type ServerConfig struct {
+	Port  int  `yaml:"port" toml:"port" env:"API_PORT" flag:"port"`
+	Debug bool `yaml:"debug" toml:"debug" env:"API_DEBUG" flag:"debug"`
+
+	ReadTimeout       time.Duration `yaml:"read_timeout" toml:"read_timeout" env:"API_READ_TIMEOUT"`
+	ReadHeaderTimeout time.Duration `yaml:"read_header_timeout" toml:"read_header_timeout" env:"API_READ_HEADER_TIMEOUT"`
+	WriteTimeout      time.Duration `yaml:"write_timeout" toml:"write_timeout" env:"API_WRITE_TIMEOUT"`
+	IdleTimeout       time.Duration `yaml:"idle_timeout" toml:"idle_timeout" env:"API_IDLE_TIMEOUT"`
+	// Prazo para as requisições em andamento terminarem ao encerrar a API
+	ShutdownTimeout time.Duration `yaml:"shutdown_timeout" toml:"shutdown_timeout" env:"API_SHUTDOWN_TIMEOUT"`
+	// Espera entre o sinal e o fim das novas conexões, com o /readyz já
+	// falhando, para que o balanceador tire a instância de rotação
+	ShutdownDelay time.Duration `yaml:"shutdown_delay" toml:"shutdown_delay" env:"API_SHUTDOWN_DELAY"`
+}
+
+type DatabaseConfig struct {
+	Host     string `yaml:"host" toml:"host" env:"DB_HOST"`
+	Port     int    `yaml:"port" toml:"port" env:"DB_PORT"`
+	User     string `yaml:"user" toml:"user" env:"DB_USER"`
+	Password string `yaml:"password" toml:"password" env:"DB_PASSWORD" secret:"true"`
+	Name     string `yaml:"name" toml:"name" env:"DB_NAME"`
+	// Banco vazio usado pelo migrate down para reconstruir o schema
+	DevName string `yaml:"dev_name" toml:"dev_name" env:"DB_DEV_NAME"`
+	SSLMode string `yaml:"sslmode" toml:"sslmode" env:"DB_SSLMODE"`
+
+	MaxOpenConns    int           `yaml:"max_open_conns" toml:"max_open_conns" env:"DB_MAX_OPEN_CONNS"`
+	MaxIdleConns    int           `yaml:"max_idle_conns" toml:"max_idle_conns" env:"DB_MAX_IDLE_CONNS"`
+	ConnMaxLifetime time.Duration `yaml:"conn_max_lifetime" toml:"conn_max_lifetime" env:"DB_CONN_MAX_LIFETIME"`
+	ConnMaxIdleTime time.Duration `yaml:"conn_max_idle_time" toml:"conn_max_idle_time" env:"DB_CONN_MAX_IDLE_TIME"`
+
+	// Inicia a API mesmo com migrations pendentes, apenas com um aviso
+	AllowPendingMigrations bool `yaml:"allow_pending_migrations" toml:"allow_pending_migrations" env:"MIGRATIONS_ALLOW_PENDING" flag:"allow-pending-migrations"`
+}
+
+type QueueConfig struct {
+	URI      string `yaml:"uri" toml:"uri" env:"AMQP_URI" secret:"true"`
+	Name     string `yaml:"name" toml:"name" env:"QUEUE_NAME"`
+	Prefetch int    `yaml:"prefetch" toml:"prefetch" env:"QUEUE_PREFETCH"`
+}
+
+type CORSConfig struct {
+	Enabled bool `yaml:"enabled" toml:"enabled" env:"CORS_ENABLED" flag:"cors"`
+	// Origens aceitas; "*" aceita qualquer uma
+	AllowedOrigins []string `yaml:"allowed_origins" toml:"allowed_origins" env:"CORS_ALLOWED_ORIGINS"`
+}
+
+// Validade máxima do refresh token
+const MaxRefreshTTL = 7 * 24 * time.Hour
+
+type AuthConfig struct {
+	JWTSecret string        `yaml:"jwt_secret" toml:"jwt_secret" env:"JWT_SECRET" secret:"true"`
+	AccessTTL time.Duration `yaml:"access_ttl" toml:"access_ttl" env:"JWT_ACCESS_TTL"`
+	// Os refresh tokens não podem ser revogados, então valem no máximo
+	// MaxRefreshTTL
+	RefreshTTL time.Duration `yaml:"refresh_ttl" toml:"refresh_ttl" env:"JWT_REFRESH_TTL"`
+}
+
+type StorageConfig struct {
+	// "local" ou "s3"
+	Driver            string   `yaml:"driver" toml:"driver" env:"STORAGE_DRIVER"`
+	Path              string   `yaml:"path" toml:"path" env:"STORAGE_PATH"`
+	MaxAttachmentSize int64    `yaml:"max_attachment_size" toml:"max_attachment_size" env:"ATTACHMENT_MAX_SIZE"`
+	S3                S3Config `yaml:"s3" toml:"s3"`
+}
+
+type S3Config struct {
+	Endpoint  string `yaml:"endpoint" toml:"endpoint" env:"S3_ENDPOINT"`
+	AccessKey string `yaml:"access_key" toml:"access_key" env:"S3_ACCESS_KEY"`
+	SecretKey string `yaml:"secret_key" toml:"secret_key" env:"S3_SECRET_KEY" secret:"true"`
+	Bucket    string `yaml:"bucket" toml:"bucket" env:"S3_BUCKET"`
+	Region    string `yaml:"region" toml:"region" env:"S3_REGION"`
+	UseSSL    bool   `yaml:"use_ssl" toml:"use_ssl" env:"S3_USE_SSL"`
+}
+
+type TrashConfig struct {
+	Retention time.Duration `yaml:"retention" toml:"retention" env:"TRASH_RETENTION" flag:"retention"`
+}
+
+type MetricsConfig struct {
+	// Expõe o /metrics no formato do Prometheus
+	Enabled bool `yaml:"enabled" toml:"enabled" env:"METRICS_ENABLED"`
+}
+
+type LogConfig struct {
+	// debug, info, warn ou error
+	Level string `yaml:"level" toml:"level" env:"LOG_LEVEL"`
+	// json ou text
+	Format string `yaml:"format" toml:"format" env:"LOG_FORMAT"`
+}
+
+// TracingConfig usa os nomes de variáveis do OpenTelemetry
+type TracingConfig struct {
+	// none, otlp ou stdout
+	Exporter string `yaml:"exporter" toml:"exporter" env:"OTEL_TRACES_EXPORTER"`
+	// Coletor OTLP/HTTP, como localhost:4318 ou https://coletor:4318
+	Endpoint    string  `yaml:"endpoint" toml:"endpoint" env:"OTEL_EXPORTER_OTLP_ENDPOINT"`
+	Insecure    bool    `yaml:"insecure" toml:"insecure" env:"OTEL_EXPORTER_OTLP_INSECURE"`
+	ServiceName string  `yaml:"service_name" toml:"service_name" env:"OTEL_SERVICE_NAME"`
+	SampleRatio float64 `yaml:"sample_ratio" toml:"sample_ratio" env:"OTEL_TRACES_SAMPLER_ARG"`
+}
+
+// Default retorna a configuração usada quando nada é informado
+func Default() *Config {
+	return &Config{
+		Server: ServerConfig{
+			Port:              8080,
+			ReadTimeout:       30 * time.Second,
+			ReadHeaderTimeout: 5 * time.Second,
+			WriteTimeout:      60 * time.Second,
+			IdleTimeout:       120 * time.Second,
+			ShutdownTimeout:   20 * time.Second,
+		},
+		Database: DatabaseConfig{
+			Host:            "localhost",
+			Port:            5432,
+			SSLMode:         "disable",
+			MaxOpenConns:    25,
+			MaxIdleConns:    5,
+			ConnMaxLifetime: 30 * time.Minute,
+			ConnMaxIdleTime: 5 * time.Minute,
+		},
+		Queue: QueueConfig{Prefetch: 1},
+		CORS:  CORSConfig{AllowedOrigins: []string{"*"}},
+		Auth: AuthConfig{
+			AccessTTL:  15 * time.Minute,
+			RefreshTTL: 24 * time.Hour,
+		},
+		Storage: StorageConfig{
+			Driver:            "local",
+			Path:              "./data/attachments",
+			MaxAttachmentSize: 10 << 20,
+		},
+		Trash:   TrashConfig{Retention: 30 * 24 * time.Hour},
+		Metrics: MetricsConfig{Enabled: true},
+		Log:     LogConfig{Level: "info", Format: logger.FormatJSON},
+		Tracing: TracingConfig{
+			Exporter:    tracing.ExporterNone,
+			ServiceName: "backend-go",
+			SampleRatio: 1,
+		},
+	}
+}
+
+// DSN monta a conexão com o banco name (Name ou DevName)
+func (c DatabaseConfig) DSN(name string) string {
+	user := url.User(c.User)
+	if c.Password != "" {
+		user = url.UserPassword(c.User, c.Password)
+	}
+
+	u := url.URL{
+		Scheme:   "postgres",
+		User:     user,
+		Host:     c.Host + ":" + strconv.Itoa(c.Port),
+		Path:     "/" + name,
+		RawQuery: url.Values{"sslmode": {c.SSLMode}}.Encode(),
+	}
+	return u.String()
+}
+
+var sslModes = []string{"disable", "allow", "prefer", "require", "verify-ca", "verify-full"}
+
+// Validate verifica os valores usados por todos os comandos: o banco, os
+// formatos e os limites. Os campos exigidos só pelo servidor são verificados
+// pelo ValidateServer.
+func (c *Config) Validate() error {
+	v := &validator{}
+
+	v.check(c.Server.Port > 0 && c.Server.Port <= 65535, "server.port", "porta inválida")
+	v.check(c.Server.ReadTimeout >= 0, "server.read_timeout", "não pode ser negativo")
+	v.check(c.Server.ReadHeaderTimeout >= 0, "server.read_header_timeout", "não pode ser negativo")
+	v.check(c.Server.WriteTimeout >= 0, "server.write_timeout", "não pode ser negativo")
+	v.check(c.Server.IdleTimeout >= 0, "server.idle_timeout", "não pode ser negativo")
+	v.check(c.Server.ShutdownTimeout > 0, "server.shutdown_timeout", "deve ser maior que zero")
+	v.check(c.Server.ShutdownDelay >= 0, "server.shutdown_delay", "não pode ser negativo")
+
+	v.required(c.Database.Host, "database.host")
+	v.required(c.Database.User, "database.user")
+	v.required(c.Database.Name, "database.name")
+	v.check(c.Database.Port > 0 && c.Database.Port <= 65535, "database.port", "porta inválida")
+	v.check(slices.Contains(sslModes, c.Database.SSLMode), "database.sslmode",
+		fmt.Sprintf("valor inválido %q (use %s)", c.Database.SSLMode, strings.Join(sslModes, ", ")))
+	v.check(c.Database.MaxOpenConns >= 0, "database.max_open_conns", "não pode ser negativo")
+	v.check(c.Database.MaxIdleConns >= 0, "database.max_idle_conns", "não pode ser negativo")
+	v.check(c.Database.MaxOpenConns == 0 || c.Database.MaxIdleConns <= c.Database.MaxOpenConns,
+		"database.max_idle_conns", "não pode ser maior que max_open_conns")
+
+	if c.Queue.URI != "" {
+		u, err := url.Parse(c.Queue.URI)
+		v.check(err == nil && (u.Scheme == "amqp" || u.Scheme == "amqps"), "queue.uri", "esperado amqp:// ou amqps://")
+	}
+	v.check(c.Queue.Prefetch > 0, "queue.prefetch", "deve ser maior que zero")
+
+	for _, origin := range c.CORS.AllowedOrigins {
+		if origin == "*" {
+			continue
+		}
+		u, err := url.Parse(origin)
+		v.check(err == nil && u.Scheme != "" && u.Host != "", "cors.allowed_origins", fmt.Sprintf("origem inválida %q", origin))
+	}
+
+	v.check(c.Auth.AccessTTL > 0, "auth.access_ttl", "deve ser maior que zero")
+	v.check(c.Auth.RefreshTTL > c.Auth.AccessTTL, "auth.refresh_ttl", "deve ser maior que access_ttl")
+	v.check(c.Auth.RefreshTTL <= MaxRefreshTTL, "auth.refresh_ttl", fmt.Sprintf("deve ser no máximo %s", MaxRefreshTTL))
+
+	switch c.Storage.Driver {
+	case "local":
+		v.required(c.Storage.Path, "storage.path")
+	case "s3":
+		v.required(c.Storage.S3.Endpoint, "storage.s3.endpoint")
+		v.required(c.Storage.S3.Bucket, "storage.s3.bucket")
+	default:
+		v.check(false, "storage.driver", fmt.Sprintf("valor inválido %q (use local ou s3)", c.Storage.Driver))
+	}
+	v.check(c.Storage.MaxAttachmentSize > 0, "storage.max_attachment_size", "deve ser maior que zero")
+
+	v.check(c.Trash.Retention > 0, "trash.retention", "deve ser maior que zero")
+
+	_, err := logger.ParseLevel(c.Log.Level)
+	v.check(err == nil, "log.level", fmt.Sprintf("valor inválido %q (use debug, info, warn ou error)", c.Log.Level))
+	v.check(c.Log.Format == logger.FormatJSON || c.Log.Format == logger.FormatText, "log.format",
+		fmt.Sprintf("valor inválido %q (use json ou text)", c.Log.Format))
+
+	switch c.Tracing.Exporter {
+	case tracing.ExporterNone, tracing.ExporterStdout:
+	case tracing.ExporterOTLP:
+		v.required(c.Tracing.ServiceName, "tracing.service_name")
+	default:
+		v.check(false, "tracing.exporter", fmt.Sprintf("valor inválido %q (use none, otlp ou stdout)", c.Tracing.Exporter))
+	}
+	v.check(c.Tracing.SampleRatio >= 0 && c.Tracing.SampleRatio <= 1, "tracing.sample_ratio", "deve estar entre 0 e 1")
+
+	return v.err()
+}
+
+// ValidateServer inclui no Validate os campos exigidos pela API
+func (c *Config) ValidateServer() error {
+	v := &validator{}
+	if err := c.Validate(); err != nil {
+		v.errs = append(v.errs, err)
+	}
+	v.required(c.Queue.URI, "queue.uri")
+	v.required(c.Queue.Name, "queue.name")
+	v.required(c.Auth.JWTSecret, "auth.jwt_secret")
+	return v.err()
+}
+
+// validator acumula os erros para que todos sejam mostrados de uma vez
+type validator struct {
+	errs []error
+}
+
+// check registra msg para a chave key, junto com a variável de ambiente
+// correspondente, se ok for falso
+func (v *validator) check(ok bool, key, msg string) {
+	if ok {
+		return
+	}
+	if env := envNames[key]; env != "" {
+		key = fmt.Sprintf("%s (%s)", key, env)
+	}
+	v.errs = append(v.errs, fmt.Errorf("%s: %s", key, msg))
+}
+
+func (v *validator) required(value, key string) {
+	v.check(value != "", key, "obrigatório")
+}
+
+func (v *validator) err() error {
+	return errors.Join(v.errs...)
+}
@@ -0,0 +1,204 @@
+package config
+
+import (
+	"bytes"
+	"fmt"
+	"net/url"
+	"os"
+	"path/filepath"
+	"reflect"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/pelletier/go-toml/v2"
+	"github.com/spf13/pflag"
+	"gopkg.in/yaml.v3"
+)
+
+// Variável de ambiente com o caminho do arquivo, quando --config não é usado
+const FileEnv = "CONFIG_FILE"
+
+const redacted = "******"
+
+// envNames relaciona as chaves do arquivo (database.host) às variáveis de
+// ambiente, para as mensagens de validação
+var envNames = map[string]string{}
+
+func init() {
+	walk(reflect.ValueOf(Default()).Elem(), "", func(key string, field reflect.StructField, _ reflect.Value) error {
+		if env := field.Tag.Get("env"); env != "" {
+			envNames[key] = env
+		}
+		return nil
+	})
+}
+
+// Load monta a configuração a partir dos valores padrão, do arquivo path
+// (YAML ou TOML, pela extensão; opcional), das variáveis de ambiente e das
+// flags alteradas em flags (pode ser nil), nessa ordem de precedência
+func Load(path string, flags *pflag.FlagSet) (*Config, error) {
+	cfg := Default()
+
+	if path != "" {
+		if err := loadFile(cfg, path); err != nil {
+			return nil, err
+		}
+	}
+
+	err := walk(reflect.ValueOf(cfg).Elem(), "", func(key string, field reflect.StructField, value reflect.Value) error {
+		if env := field.Tag.Get("env"); env != "" {
+			if raw, ok := os.LookupEnv(env); ok && raw != "" {
+				if err := setValue(value, raw); err != nil {
+					return fmt.Errorf("%s (%s): %w", key, env, err)
+				}
+			}
+		}
+
+		if name := field.Tag.Get("flag"); name != "" && flags != nil {
+			if flag := flags.Lookup(name); flag != nil && flag.Changed {
+				if err := setValue(value, flag.Value.String()); err != nil {
+					return fmt.Errorf("%s (--%s): %w", key, name, err)
+				}
+			}
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	return cfg, nil
+}
+
+func loadFile(cfg *Config, path string) error {
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return fmt.Errorf("erro ao ler o arquivo de configuração: %w", err)
+	}
+
+	switch ext := strings.ToLower(filepath.Ext(path)); ext {
+	case ".yaml", ".yml":
+		decoder := yaml.NewDecoder(bytes.NewReader(data))
+		decoder.KnownFields(true)
+		err = decoder.Decode(cfg)
+	case ".toml":
+		err = decodeTOML(cfg, data)
+	default:
+		return fmt.Errorf("formato de configuração desconhecido %q (use .yaml, .yml ou .toml)", ext)
+	}
+	if err != nil {
+		return fmt.Errorf("erro ao ler %s: %w", path, err)
+	}
+	return nil
+}
+
+// decodeTOML lê o TOML como um mapa e o decodifica como YAML, pois o go-toml
+// não converte textos como "15m" para time.Duration
+func decodeTOML(cfg *Config, data []byte) error {
+	var values map[string]any
+	if err := toml.Unmarshal(data, &values); err != nil {
+		return err
+	}
+
+	converted, err := yaml.Marshal(values)
+	if err != nil {
+		return err
+	}
+
+	decoder := yaml.NewDecoder(bytes.NewReader(converted))
+	decoder.KnownFields(true)
+	return decoder.Decode(cfg)
+}
+
+// walk chama fn para cada campo que não é uma struct, com a chave no formato
+// do arquivo (database.host)
+func walk(v reflect.Value, prefix string, fn func(key string, field reflect.StructField, value reflect.Value) error) error {
+	t := v.Type()
+	for i := 0; i < t.NumField(); i++ {
+		field := t.Field(i)
+		key := field.Tag.Get("yaml")
+		if prefix != "" {
+			key = prefix + "." + key
+		}
+
+		value := v.Field(i)
+		if value.Kind() == reflect.Struct {
+			if err := walk(value, key, fn); err != nil {
+				return err
+			}
+			continue
+		}
+		if err := fn(key, field, value); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// setValue converte o texto de uma variável de ambiente ou flag para o tipo do
+// campo. Listas são separadas por vírgula.
+func setValue(value reflect.Value, raw string) error {
+	if value.Type() == reflect.TypeOf(time.Duration(0)) {
+		d, err := time.ParseDuration(raw)
+		if err != nil {
+			return fmt.Errorf("duração inválida %q", raw)
+		}
+		value.SetInt(int64(d))
+		return nil
+	}
+
+	switch value.Kind() {
+	case reflect.String:
+		value.SetString(raw)
+	case reflect.Int, reflect.Int64:
+		n, err := strconv.ParseInt(raw, 10, 64)
+		if err != nil {
+			return fmt.Errorf("número inválido %q", raw)
+		}
+		value.SetInt(n)
+	case reflect.Float64:
+		f, err := strconv.ParseFloat(raw, 64)
+		if err != nil {
+			return fmt.Errorf("número inválido %q", raw)
+		}
+		value.SetFloat(f)
+	case reflect.Bool:
+		b, err := strconv.ParseBool(raw)
+		if err != nil {
+			return fmt.Errorf("booleano inválido %q", raw)
+		}
+		value.SetBool(b)
+	case reflect.Slice:
+		var items []string
+		for _, item := range strings.Split(raw, ",") {
+			if item = strings.TrimSpace(item); item != "" {
+				items = append(items, item)
+			}
+		}
+		value.Set(reflect.ValueOf(items))
+	default:
+		return fmt.Errorf("tipo não suportado %s", value.Type())
+	}
+	return nil
+}
+
+// Redacted retorna uma cópia com os segredos escondidos. Em URLs, como a do
+// RabbitMQ, apenas a senha é escondida.
+func (c *Config) Redacted() *Config {
+	cp := *c
+	_ = walk(reflect.ValueOf(&cp).Elem(), "", func(_ string, field reflect.StructField, value reflect.Value) error {
+		if field.Tag.Get("secret") == "true" && value.String() != "" {
+			value.SetString(redact(value.String()))
+		}
+		return nil
+	})
+	return &cp
+}
+
+func redact(secret string) string {
+	u, err := url.Parse(secret)
+	if err != nil || u.Scheme == "" || u.User == nil {
+		return redacted
+	}
+	return u.Redacted()
+}
@@ -1,6 +1,7 @@
 package errs
 
 import (
+	"backend-go/pkg/etag"
 	"backend-go/pkg/utils"
 	"errors"
 	"fmt"
@@ -10,6 +11,8 @@ import (
 type ErrorResponse struct {
 	Message string `json:"message"`
 	Detail  string `json:"details,omitempty"`
+	// ID da requisição, o mesmo do header X-Request-ID e dos logs
+	RequestID string `json:"request_id,omitempty"`
 }
 
 const (
@@ -19,21 +22,27 @@ const (
 	NotFound            = "Recurso não encontrado"
 	Conflict            = "Conflito nos dados"
 	UnprocessableEntity = "Entidade não processável"
+	PreconditionFailed  = "O recurso foi alterado desde a última leitura"
+	PayloadTooLarge     = "Conteúdo muito grande"
+	UnsupportedMedia    = "Tipo de conteúdo não suportado"
 	TooManyRequests     = "Muitas requisições, tente novamente mais tarde"
 	InternalServerError = "Erro interno no servidor"
 	ServiceUnavailable  = "Serviço temporariamente indisponível"
 )
 
 var ErrorMessages = map[int]string{
-	http.StatusBadRequest:          BadRequest,
-	http.StatusUnauthorized:        Unauthorized,
-	http.StatusForbidden:           Forbidden,
-	http.StatusNotFound:            NotFound,
-	http.StatusConflict:            Conflict,
-	http.StatusUnprocessableEntity: UnprocessableEntity,
-	http.StatusTooManyRequests:     TooManyRequests,
-	http.StatusInternalServerError: InternalServerError,
-	http.StatusServiceUnavailable:  ServiceUnavailable,
+	http.StatusBadRequest:            BadRequest,
+	http.StatusUnauthorized:          Unauthorized,
+	http.StatusForbidden:             Forbidden,
+	http.StatusNotFound:              NotFound,
+	http.StatusConflict:              Conflict,
+	http.StatusUnprocessableEntity:   UnprocessableEntity,
+	http.StatusPreconditionFailed:    PreconditionFailed,
+	http.StatusRequestEntityTooLarge: PayloadTooLarge,
+	http.StatusUnsupportedMediaType:  UnsupportedMedia,
+	http.StatusTooManyRequests:       TooManyRequests,
+	http.StatusInternalServerError:   InternalServerError,
+	http.StatusServiceUnavailable:    ServiceUnavailable,
 }
 
 var (
@@ -43,6 +52,9 @@ var (
 	ErrNotFound           = errors.New(utils.SanitizeString(NotFound))
 	ErrConflict           = errors.New(utils.SanitizeString(Conflict))
 	ErrUnprocessable      = errors.New(utils.SanitizeString(UnprocessableEntity))
+	ErrPreconditionFailed = etag.ErrPreconditionFailed
+	ErrPayloadTooLarge    = errors.New(utils.SanitizeString(PayloadTooLarge))
+	ErrUnsupportedMedia   = errors.New(utils.SanitizeString(UnsupportedMedia))
 	ErrTooManyRequests    = errors.New(utils.SanitizeString(TooManyRequests))
 	ErrInternalServer     = errors.New(utils.SanitizeString(InternalServerError))
 	ErrServiceUnavailable = errors.New(utils.SanitizeString(ServiceUnavailable))
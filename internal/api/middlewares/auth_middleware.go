@@ -0,0 +1,64 @@
+package middlewares
+
+import (
+	"backend-go/internal/api/errs"
+	"backend-go/internal/api/v1/services"
+	"backend-go/pkg/auth"
+	"errors"
+	"net/http"
+	"strings"
+
+	"github.com/gin-gonic/gin"
+)
+
+// AuthMiddleware exige um token de acesso ou uma chave de API válidos no
+// header Authorization e coloca o usuário autenticado no contexto da requisição.
+// Chaves de API também restringem a requisição aos seus escopos.
+// Deve ser registrado depois do ErrorMiddleware, que responde o erro.
+func AuthMiddleware(tokens *auth.TokenManager, apiKeys *services.APIKeyService) gin.HandlerFunc {
+	return func(c *gin.Context) {
+		scheme, token, found := strings.Cut(c.GetHeader("Authorization"), " ")
+		if !found || token == "" {
+			abortUnauthorized(c)
+			return
+		}
+
+		ctx := c.Request.Context()
+		switch {
+		case strings.EqualFold(scheme, "Bearer"):
+			userID, err := tokens.ParseToken(token, auth.AccessToken)
+			if err != nil {
+				abortUnauthorized(c)
+				return
+			}
+			ctx = auth.WithUserID(ctx, userID)
+		case strings.EqualFold(scheme, "ApiKey"):
+			key, err := apiKeys.Authenticate(ctx, token)
+			if err != nil {
+				if !errors.Is(err, errs.ErrUnauthorized) {
+					c.Error(errs.NewAPIError(http.StatusInternalServerError, err))
+					c.Abort()
+					return
+				}
+				abortUnauthorized(c)
+				return
+			}
+			ctx = auth.WithScopes(auth.WithUserID(ctx, key.UserID), key.Scopes)
+		default:
+			abortUnauthorized(c)
+			return
+		}
+
+		userID, _ := auth.UserIDFromContext(ctx)
+		c.Set("user_id", userID)
+		c.Request = c.Request.WithContext(ctx)
+
+		c.Next()
+	}
+}
+
+func abortUnauthorized(c *gin.Context) {
+	c.Header("WWW-Authenticate", `Bearer, ApiKey`)
+	c.Error(errs.NewAPIError(http.StatusUnauthorized, errs.ErrUnauthorized))
+	c.Abort()
+}
@@ -2,15 +2,27 @@ package middlewares
 
 import (
 	"net/http"
+	"slices"
 
 	"github.com/gin-gonic/gin"
 )
 
-func CORSMiddleware() gin.HandlerFunc {
+// CORSMiddleware libera as origens em allowedOrigins; "*" libera qualquer uma
+func CORSMiddleware(allowedOrigins []string) gin.HandlerFunc {
+	allowAll := slices.Contains(allowedOrigins, "*")
+
 	return func(c *gin.Context) {
-		c.Header("Access-Control-Allow-Origin", "*")
-		c.Header("Access-Control-Allow-Methods", "GET, POST, PUT, DELETE, OPTIONS")
-		c.Header("Access-Control-Allow-Headers", "Origin, Content-Type, Accept, Authorization")
+		if allowAll {
+			c.Header("Access-Control-Allow-Origin", "*")
+		} else {
+			// A resposta depende da origem, então caches não podem reaproveitá-la
+			c.Header("Vary", "Origin")
+			if origin := c.GetHeader("Origin"); slices.Contains(allowedOrigins, origin) {
+				c.Header("Access-Control-Allow-Origin", origin)
+			}
+		}
+		c.Header("Access-Control-Allow-Methods", "GET, POST, PUT, PATCH, DELETE, OPTIONS")
+		c.Header("Access-Control-Allow-Headers", "Origin, Content-Type, Accept, Authorization, X-Workspace-ID, X-Request-ID, If-Match, If-None-Match")
 
 		// Se for uma requisição OPTIONS, responde diretamente
 		// TODO: esta com erro
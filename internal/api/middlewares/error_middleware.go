@@ -2,7 +2,8 @@ package middlewares
 
 import (
 	"backend-go/internal/api/errs"
-	"log"
+	"backend-go/pkg/requestid"
+	"log/slog"
 	"net/http"
 
 	"github.com/gin-gonic/gin"
@@ -18,27 +19,39 @@ func ErrorMiddleware() gin.HandlerFunc {
 
 func handleError(c *gin.Context) {
 	if len(c.Errors) > 0 {
-		for _, err := range c.Errors {
-			log.Printf("[ERROR] %s", err.Err)
-		}
+		ctx := c.Request.Context()
+		requestID, _ := requestid.FromContext(ctx)
 
 		err := c.Errors.Last().Err
-
+		statusCode := http.StatusInternalServerError
 		if apiErr, ok := err.(*errs.APIError); ok {
-			statusCode := apiErr.StatusCode
+			statusCode = apiErr.StatusCode
+		}
+
+		level := slog.LevelError
+		if statusCode < http.StatusInternalServerError {
+			level = slog.LevelWarn
+		}
+		for _, e := range c.Errors {
+			slog.Log(ctx, level, "erro na requisição", "error", e.Err, "status", statusCode)
+		}
+
+		if _, ok := err.(*errs.APIError); ok {
 			message, exists := errs.ErrorMessages[statusCode]
 			if !exists {
 				message = errs.InternalServerError
 			}
 
 			c.JSON(statusCode, errs.ErrorResponse{
-				Message: message,
-				Detail:  err.Error(),
+				Message:   message,
+				Detail:    err.Error(),
+				RequestID: requestID,
 			})
 		} else {
 			c.JSON(http.StatusInternalServerError, errs.ErrorResponse{
-				Message: "Ocorreu um erro inesperado",
-				Detail:  err.Error(),
+				Message:   "Ocorreu um erro inesperado",
+				Detail:    err.Error(),
+				RequestID: requestID,
 			})
 		}
 	}
@@ -0,0 +1,52 @@
+package middlewares
+
+import (
+	"log/slog"
+	"net/http"
+	"time"
+
+	"github.com/gin-gonic/gin"
+)
+
+// Rotas chamadas com frequência por orquestradores e pelo Prometheus, logadas
+// apenas no nível debug e sem traces
+var quietRoutes = map[string]bool{
+	"/healthz": true,
+	"/readyz":  true,
+	"/metrics": true,
+}
+
+// TraceFilter deixa de fora dos traces as rotas de quietRoutes
+func TraceFilter(r *http.Request) bool {
+	return !quietRoutes[r.URL.Path]
+}
+
+// LoggerMiddleware registra uma linha por requisição no slog, com o
+// request_id do contexto. Deve vir depois do RequestIDMiddleware.
+func LoggerMiddleware() gin.HandlerFunc {
+	return func(c *gin.Context) {
+		start := time.Now()
+		c.Next()
+
+		status := c.Writer.Status()
+		level := slog.LevelInfo
+		switch {
+		case status >= http.StatusInternalServerError:
+			level = slog.LevelError
+		case status >= http.StatusBadRequest:
+			level = slog.LevelWarn
+		case quietRoutes[c.FullPath()]:
+			level = slog.LevelDebug
+		}
+
+		slog.Default().LogAttrs(c.Request.Context(), level, "requisição",
+			slog.String("method", c.Request.Method),
+			slog.String("path", c.Request.URL.Path),
+			slog.String("route", c.FullPath()),
+			slog.Int("status", status),
+			slog.Float64("latency_ms", float64(time.Since(start).Microseconds())/1000),
+			slog.Int("size", c.Writer.Size()),
+			slog.String("client_ip", c.ClientIP()),
+		)
+	}
+}
@@ -0,0 +1,30 @@
+package middlewares
+
+import (
+	"backend-go/pkg/requestid"
+
+	"github.com/gin-gonic/gin"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/trace"
+)
+
+// RequestIDMiddleware reaproveita o X-Request-ID enviado pelo cliente, ou gera
+// um novo, e o devolve na resposta. O ID fica no contexto da requisição para
+// ser gravado no histórico de alterações.
+func RequestIDMiddleware() gin.HandlerFunc {
+	return func(c *gin.Context) {
+		id := c.GetHeader(requestid.Header)
+		if !requestid.Valid(id) {
+			id = requestid.New()
+		}
+
+		c.Set("request_id", id)
+		c.Header(requestid.Header, id)
+		c.Request = c.Request.WithContext(requestid.WithRequestID(c.Request.Context(), id))
+
+		// Permite achar o trace a partir do ID devolvido ao cliente
+		trace.SpanFromContext(c.Request.Context()).SetAttributes(attribute.String("request.id", id))
+
+		c.Next()
+	}
+}
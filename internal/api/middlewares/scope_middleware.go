@@ -0,0 +1,30 @@
+package middlewares
+
+import (
+	"backend-go/internal/api/errs"
+	"backend-go/pkg/auth"
+	"fmt"
+	"net/http"
+
+	"github.com/gin-gonic/gin"
+)
+
+// ScopeMiddleware exige que chaves de API tenham o escopo do recurso:
+// "<recurso>:read" para leituras e "<recurso>:write" para os demais métodos.
+// Requisições autenticadas com JWT não são afetadas.
+func ScopeMiddleware(resource string) gin.HandlerFunc {
+	return func(c *gin.Context) {
+		scope := resource + ":write"
+		if isReadOnlyMethod(c.Request.Method) {
+			scope = resource + ":read"
+		}
+
+		if !auth.HasScope(c.Request.Context(), scope) {
+			c.Error(errs.NewAPIError(http.StatusForbidden, fmt.Errorf("%w: escopo %s necessário", errs.ErrForbidden, scope)))
+			c.Abort()
+			return
+		}
+
+		c.Next()
+	}
+}
@@ -2,6 +2,10 @@ package middlewares
 
 import (
 	"backend-go/internal/api/errs"
+	"backend-go/pkg/mergepatch"
+	"bytes"
+	"encoding/json"
+	"io"
 	"net/http"
 	"strings"
 
@@ -11,9 +15,10 @@ import (
 
 func UUIDMiddleware() gin.HandlerFunc {
 	return func(c *gin.Context) {
-		// Valida parâmetros de consulta da URL.
+		// Valida parâmetros de consulta da URL. Os filtros filter[campo][operador]
+		// aceitam outros valores, como null=true, e são validados pelo handler.
 		for key, values := range c.Request.URL.Query() {
-			if strings.Contains(key, "id") {
+			if strings.Contains(key, "id") && !strings.HasPrefix(key, "filter[") {
 				for _, value := range values {
 					_, err := uuid.Parse(value)
 					if err != nil {
@@ -45,9 +50,21 @@ func validateBodyUUIDs(c *gin.Context) error {
 		return nil
 	}
 
+	// Uploads (multipart) e outros formatos não são validados aqui
+	if c.ContentType() != "application/json" && c.ContentType() != mergepatch.ContentType {
+		return nil
+	}
+
+	data, err := io.ReadAll(c.Request.Body)
+	if err != nil {
+		return err
+	}
+	// Restaura o corpo para que o handler consiga fazer o bind novamente
+	c.Request.Body = io.NopCloser(bytes.NewReader(data))
+
 	// Faz o bind do JSON para um mapa
 	var body map[string]interface{}
-	if err := c.ShouldBindJSON(&body); err != nil {
+	if err := json.Unmarshal(data, &body); err != nil {
 		return err
 	}
 
@@ -66,10 +83,17 @@ func validateUUIDsRecursive(data map[string]interface{}) error {
 			}
 		case []any:
 			for _, item := range v {
-				if strItem, ok := item.(string); ok {
-					_, err := uuid.Parse(strItem)
-					if err != nil {
-						return errs.InvalidParam(key, err)
+				switch i := item.(type) {
+				case string:
+					// Apenas listas de IDs são validadas, ex: escopos não são UUIDs
+					if strings.Contains(key, "id") {
+						if _, err := uuid.Parse(i); err != nil {
+							return errs.InvalidParam(key, err)
+						}
+					}
+				case map[string]any:
+					if err := validateUUIDsRecursive(i); err != nil {
+						return err
 					}
 				}
 			}
@@ -0,0 +1,63 @@
+package middlewares
+
+import (
+	"backend-go/internal/api/errs"
+	"backend-go/internal/api/v1/services"
+	"backend-go/pkg/auth"
+	"backend-go/pkg/utils"
+	"errors"
+	"net/http"
+
+	"github.com/gin-gonic/gin"
+)
+
+// WorkspaceMiddleware define o workspace ativo a partir do header X-Workspace-ID
+// (ou o workspace pessoal do usuário) e bloqueia escritas de quem é apenas viewer.
+// Deve ser registrado depois do AuthMiddleware.
+func WorkspaceMiddleware(service *services.WorkspaceService) gin.HandlerFunc {
+	return func(c *gin.Context) {
+		ctx := c.Request.Context()
+
+		userID, ok := auth.UserIDFromContext(ctx)
+		if !ok {
+			c.Error(errs.NewAPIError(http.StatusUnauthorized, errs.ErrUnauthorized))
+			c.Abort()
+			return
+		}
+
+		workspaceID, err := utils.ToUUIDPointer(c.GetHeader("X-Workspace-ID"))
+		if err != nil {
+			c.Error(errs.NewAPIError(http.StatusBadRequest, errs.InvalidParam("X-Workspace-ID", err)))
+			c.Abort()
+			return
+		}
+
+		member, err := service.ResolveMember(ctx, userID, workspaceID)
+		if err != nil {
+			if errors.Is(err, errs.ErrForbidden) || errors.Is(err, errs.ErrNotFound) {
+				c.Error(errs.NewAPIError(http.StatusForbidden, err))
+				c.Abort()
+				return
+			}
+			c.Error(errs.NewAPIError(http.StatusInternalServerError, err))
+			c.Abort()
+			return
+		}
+
+		if !isReadOnlyMethod(c.Request.Method) && !auth.CanWrite(member.Role) {
+			c.Error(errs.NewAPIError(http.StatusForbidden, errs.ErrForbidden))
+			c.Abort()
+			return
+		}
+
+		workspace := auth.Workspace{ID: member.WorkspaceID, Role: member.Role}
+		c.Set("workspace_id", workspace.ID)
+		c.Request = c.Request.WithContext(auth.WithWorkspace(ctx, workspace))
+
+		c.Next()
+	}
+}
+
+func isReadOnlyMethod(method string) bool {
+	return method == http.MethodGet || method == http.MethodHead || method == http.MethodOptions
+}
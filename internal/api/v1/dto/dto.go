@@ -2,6 +2,115 @@ package dto
 
 import "github.com/google/uuid"
 
+// Auth
+type RegisterRequest struct {
+	Name     string `json:"name"`
+	Email    string `json:"email"`
+	Password string `json:"password"`
+}
+
+type LoginRequest struct {
+	Email    string `json:"email"`
+	Password string `json:"password"`
+}
+
+type RefreshRequest struct {
+	RefreshToken string `json:"refresh_token"`
+}
+
+type TokenResponse struct {
+	// Token de acesso (JWT)
+	AccessToken string `json:"access_token"`
+	// Token usado para renovar o token de acesso
+	RefreshToken string `json:"refresh_token"`
+	// Tipo do token, sempre Bearer
+	TokenType string `json:"token_type"`
+	// Validade do token de acesso em segundos
+	ExpiresIn int `json:"expires_in"`
+}
+
+type UserResponse struct {
+	// ID único do usuário
+	ID uuid.UUID `json:"id"`
+	// Nome do usuário
+	Name string `json:"name"`
+	// E-mail do usuário
+	Email string `json:"email"`
+	// Data de criação do usuário
+	CreatedAt string `json:"created_at"`
+}
+
+// API keys
+type APIKeyRequest struct {
+	// Nome para identificar a chave
+	Name string `json:"name"`
+	// Escopos concedidos, ex: debts:write, reports:read
+	Scopes []string `json:"scopes"`
+	// Data de expiração opcional no formato YYYY-MM-DD
+	ExpiresAt string `json:"expires_at"`
+}
+
+type APIKeyResponse struct {
+	// ID único da chave
+	ID uuid.UUID `json:"id"`
+	// Nome da chave
+	Name string `json:"name"`
+	// Início da chave, para identificá-la
+	Prefix string `json:"prefix"`
+	// Escopos concedidos
+	Scopes []string `json:"scopes"`
+	// Último uso da chave
+	LastUsedAt *string `json:"last_used_at"`
+	// Data de expiração da chave
+	ExpiresAt *string `json:"expires_at"`
+	// Data em que a chave foi revogada
+	RevokedAt *string `json:"revoked_at"`
+	// Data de criação da chave
+	CreatedAt string `json:"created_at"`
+}
+
+type APIKeyCreatedResponse struct {
+	APIKeyResponse
+	// Chave completa; só é exibida na criação
+	Key string `json:"key"`
+}
+
+// Workspaces
+type WorkspaceRequest struct {
+	Name string `json:"name"`
+}
+
+type WorkspaceResponse struct {
+	// ID único do workspace
+	ID uuid.UUID `json:"id"`
+	// Nome do workspace
+	Name string `json:"name"`
+	// Papel do usuário autenticado no workspace (owner, editor ou viewer)
+	Role string `json:"role"`
+	// Data de criação do workspace
+	CreatedAt string `json:"created_at"`
+}
+
+type WorkspaceMemberRequest struct {
+	// E-mail de um usuário já cadastrado
+	Email string `json:"email"`
+	// Papel do membro: owner, editor ou viewer
+	Role string `json:"role"`
+}
+
+type WorkspaceMemberResponse struct {
+	// ID do usuário
+	UserID uuid.UUID `json:"user_id"`
+	// Nome do usuário
+	Name string `json:"name"`
+	// E-mail do usuário
+	Email string `json:"email"`
+	// Papel do membro no workspace
+	Role string `json:"role"`
+	// Data de entrada no workspace
+	CreatedAt string `json:"created_at"`
+}
+
 // Debts
 type DebtRequest struct {
 	InvoiceID    string `json:"invoice_id"`
@@ -9,6 +118,19 @@ type DebtRequest struct {
 	DueDate      string `json:"due_date"`
 	Title        string `json:"title"`
 	Amount       string `json:"amount"`
+	// Nomes das tags do débito; tags inexistentes são criadas. Quando omitido
+	// na atualização, as tags atuais são mantidas.
+	Tags []string `json:"tags"`
+	// Tipo: purchase (padrão), refund, fee, interest ou iof. Valores negativos
+	// sem tipo são tratados como estorno.
+	Kind string `json:"kind"`
+	// ID do débito original, apenas para estornos
+	RefundOfID string `json:"refund_of_id"`
+	// ID da categoria; quando vazio, a categoria é identificada pelo título
+	CategoryID string `json:"category_id"`
+	// ID do status; quando vazio, o débito é criado como pendente e a
+	// atualização mantém o status atual
+	StatusID string `json:"status_id"`
 }
 
 type DebtResponse struct {
@@ -35,10 +157,29 @@ type DebtResponse struct {
 	StatusID *uuid.UUID `json:"status_id"`
 	// Nome do status
 	Status *string `json:"status"`
+	// Nomes das tags do débito
+	Tags []string `json:"tags"`
+	// Tipo do débito: purchase, refund, fee, interest ou iof
+	Kind string `json:"kind"`
+	// ID do débito original, quando é um estorno
+	RefundOfID *uuid.UUID `json:"refund_of_id"`
 	// Data de criação do débito
 	CreatedAt string `json:"created_at"`
 	// Data da última atualização do débito
 	UpdatedAt string `json:"updated_at"`
+	// Data em que o débito foi para a lixeira
+	DeletedAt *string `json:"deleted_at,omitempty"`
+	// Versão do débito, devolvida também no ETag e usada no If-Match
+	Version int `json:"version"`
+	// Objetos relacionados pedidos em expand
+	Expanded *DebtExpanded `json:"expanded,omitempty"`
+}
+
+// DebtExpanded traz os objetos completos dos relacionamentos do débito
+type DebtExpanded struct {
+	Invoice  *InvoiceResponse       `json:"invoice,omitempty"`
+	Category *CategoryResponse      `json:"category,omitempty"`
+	Status   *PaymentStatusResponse `json:"status,omitempty"`
 }
 
 type DebtFilters struct {
@@ -50,6 +191,160 @@ type DebtFilters struct {
 	MaxAmount  *float64  `form:"max_amount"`
 	StartDate  *string   `form:"start_date"`
 	EndDate    *string   `form:"end_date"`
+	Tag        *[]string `form:"tag"`
+	Kind       *[]string `form:"kind"`
+}
+
+// Debt shares
+type DebtShareRequest struct {
+	// ID da pessoa que divide o débito
+	PersonID string `json:"person_id"`
+	// Valor da parte; informe este campo ou percentage
+	Amount *float64 `json:"amount"`
+	// Percentual do débito, entre 0 e 100; informe este campo ou amount
+	Percentage *float64 `json:"percentage"`
+	// Indica se a pessoa já pagou a sua parte
+	Settled bool `json:"settled"`
+}
+
+type DebtSharesRequest struct {
+	// Divisões do débito; substituem as existentes. A soma deve ser igual ao valor do débito.
+	Shares []DebtShareRequest `json:"shares"`
+}
+
+type DebtShareResponse struct {
+	// ID único da divisão
+	ID uuid.UUID `json:"id"`
+	// ID da pessoa
+	PersonID uuid.UUID `json:"person_id"`
+	// Nome da pessoa
+	Person string `json:"person"`
+	// Valor da parte
+	Amount float64 `json:"amount"`
+	// Percentual do débito, quando a divisão foi feita em percentual
+	Percentage *float64 `json:"percentage"`
+	// Indica se a pessoa já pagou a sua parte
+	Settled bool `json:"settled"`
+}
+
+// Bulk
+type DebtBulkOperation struct {
+	// Operação: create, update ou delete
+	Op string `json:"op"`
+	// ID do débito, obrigatório em update e delete
+	ID string `json:"id"`
+	// Versão esperada do débito em update e delete, como no If-Match
+	Version *int `json:"version"`
+	// Dados do débito em create e update
+	Debt *DebtRequest `json:"debt"`
+}
+
+type DebtBulkRequest struct {
+	// all_or_nothing (padrão): nada é salvo se alguma operação falhar;
+	// best_effort: as operações válidas são salvas mesmo que outras falhem
+	Mode string `json:"mode"`
+	// Operações executadas em uma única transação; as criações são feitas
+	// primeiro, juntas, e depois as atualizações e remoções na ordem enviada
+	Operations []DebtBulkOperation `json:"operations"`
+}
+
+type DebtBulkResult struct {
+	// Posição da operação na requisição
+	Index int `json:"index"`
+	// Operação: create, update ou delete
+	Op string `json:"op"`
+	// Status HTTP equivalente ao da operação avulsa; 424 indica uma operação
+	// válida desfeita porque outra falhou no modo all_or_nothing
+	Status int `json:"status"`
+	// Motivo da falha
+	Error string `json:"error,omitempty"`
+	// Débito criado ou atualizado
+	Debt *DebtResponse `json:"debt,omitempty"`
+}
+
+type DebtBulkResponse struct {
+	// Modo usado: all_or_nothing ou best_effort
+	Mode string `json:"mode"`
+	// Quantidade de operações salvas
+	Succeeded int `json:"succeeded"`
+	// Quantidade de operações que falharam ou foram desfeitas
+	Failed int `json:"failed"`
+	// Resultado de cada operação, na ordem da requisição
+	Results []DebtBulkResult `json:"results"`
+}
+
+// Audit
+type AuditEntryResponse struct {
+	// ID único da entrada do histórico
+	ID uuid.UUID `json:"id"`
+	// Ação registrada: create, update, delete ou restore
+	Action string `json:"action"`
+	// ID do usuário que fez a alteração
+	ActorID *uuid.UUID `json:"actor_id"`
+	// Indica se a alteração foi feita com uma chave de API
+	ViaAPIKey bool `json:"via_api_key"`
+	// ID da requisição (X-Request-ID) que fez a alteração
+	RequestID *string `json:"request_id"`
+	// Valores anteriores dos campos alterados
+	OldValues map[string]any `json:"old_values"`
+	// Novos valores dos campos alterados
+	NewValues map[string]any `json:"new_values"`
+	// Data da alteração
+	CreatedAt string `json:"created_at"`
+}
+
+// Imports
+type ImportCommitRequest struct {
+	PreviewToken string `json:"preview_token"`
+}
+
+type ImportPreviewItem struct {
+	// Linha do arquivo (o cabeçalho é a linha 1)
+	Line int `json:"line"`
+	// Título do débito
+	Title string `json:"title"`
+	// Valor do débito
+	Amount float64 `json:"amount"`
+	// Data da compra no formato YYYY-MM-DD
+	PurchaseDate string `json:"purchase_date"`
+	// Data de vencimento no formato YYYY-MM-DD
+	DueDate string `json:"due_date"`
+	// ID da categoria atribuída
+	CategoryID *uuid.UUID `json:"category_id"`
+	// Nome da categoria atribuída
+	Category *string `json:"category"`
+	// ID da fatura associada
+	InvoiceID *uuid.UUID `json:"invoice_id"`
+	// Título da fatura associada
+	InvoiceTitle *string `json:"invoice_title"`
+	// Indica se o débito já existe no banco ou no próprio arquivo
+	Duplicate bool `json:"duplicate"`
+	// Indica se a linha será ignorada ao confirmar a importação
+	Skipped bool `json:"skipped"`
+	// Avisos e erros encontrados na linha
+	Warnings []string `json:"warnings"`
+}
+
+type ImportPreviewResponse struct {
+	// Token usado para confirmar a importação
+	PreviewToken string `json:"preview_token"`
+	// Data de expiração do token
+	ExpiresAt string `json:"expires_at"`
+	// Quantidade de linhas lidas
+	Total int `json:"total"`
+	// Quantidade de débitos que serão criados
+	ToCreate int `json:"to_create"`
+	// Quantidade de linhas ignoradas
+	Skipped int `json:"skipped"`
+	// Débitos propostos
+	Items []ImportPreviewItem `json:"items"`
+}
+
+type ImportResponse struct {
+	// Quantidade de débitos criados
+	Created int `json:"created"`
+	// Débitos criados
+	Debts []DebtResponse `json:"debts"`
 }
 
 // Invoices
@@ -58,6 +353,8 @@ type InvoiceRequest struct {
 	Amount    string `json:"amount"`
 	IssueDate string `json:"issue_date"`
 	DueDate   string `json:"due_date"`
+	// ID do status; vazio deixa a fatura sem status
+	StatusID string `json:"status_id"`
 }
 
 type InvoiceResponse struct {
@@ -76,10 +373,16 @@ type InvoiceResponse struct {
 	StatusID *uuid.UUID `json:"status_id"`
 	// Nome do status
 	Status *string `json:"status"`
+	// Soma dos débitos da fatura, descontando os estornos
+	DebtsTotal float64 `json:"debts_total"`
 	// Data de criação da fatura
 	CreatedAt string `json:"created_at"`
 	// Data da última atualização da fatura
 	UpdatedAt string `json:"updated_at"`
+	// Data em que a fatura foi para a lixeira
+	DeletedAt *string `json:"deleted_at,omitempty"`
+	// Versão da fatura, devolvida também no ETag e usada no If-Match
+	Version int `json:"version"`
 }
 
 type InvoiceFilters struct {
@@ -103,14 +406,111 @@ type CategoryResponse struct {
 	Name string `json:"name"`
 	// Descrião da categoria
 	Description *string `json:"description"`
+	// Data em que a categoria foi para a lixeira
+	DeletedAt *string `json:"deleted_at,omitempty"`
+	// Versão da categoria, devolvida também no ETag e usada no If-Match
+	Version int `json:"version"`
 }
 
-// PaymentStatus
-type PaymentStatusRequest struct {
-	Name        string `json:"name"`
-	Description string `json:"description"`
+// Attachments
+type AttachmentResponse struct {
+	// ID único do anexo
+	ID uuid.UUID `json:"id"`
+	// ID do débito, quando o anexo pertence a um débito
+	DebtID *uuid.UUID `json:"debt_id"`
+	// ID da fatura, quando o anexo pertence a uma fatura
+	InvoiceID *uuid.UUID `json:"invoice_id"`
+	// Nome original do arquivo
+	FileName string `json:"file_name"`
+	// Tipo do conteúdo, ex: application/pdf
+	ContentType string `json:"content_type"`
+	// Tamanho em bytes
+	Size int64 `json:"size"`
+	// Data do envio
+	CreatedAt string `json:"created_at"`
 }
 
+// Tags
+type TagRequest struct {
+	Name string `json:"name"`
+}
+
+type TagResponse struct {
+	// ID único da tag
+	ID uuid.UUID `json:"id"`
+	// Nome da tag
+	Name string `json:"name"`
+	// Versão da tag, devolvida também no ETag e usada no If-Match
+	Version int `json:"version"`
+}
+
+// Reports
+type ReportFilters struct {
+	StartDate *string `form:"start_date"`
+	EndDate   *string `form:"end_date"`
+}
+
+type ReportItem struct {
+	// ID da categoria ou tag; nulo para débitos sem categoria ou sem tag
+	ID *uuid.UUID `json:"id"`
+	// Nome da categoria ou tag
+	Name string `json:"name"`
+	// Soma dos débitos
+	Total float64 `json:"total"`
+	// Quantidade de débitos
+	Count int `json:"count"`
+}
+
+// People
+type PersonRequest struct {
+	Name  string `json:"name"`
+	Email string `json:"email"`
+}
+
+type PersonResponse struct {
+	// ID único da pessoa
+	ID uuid.UUID `json:"id"`
+	// Nome da pessoa
+	Name string `json:"name"`
+	// E-mail da pessoa
+	Email *string `json:"email"`
+	// Data em que a pessoa foi para a lixeira
+	DeletedAt *string `json:"deleted_at,omitempty"`
+	// Versão da pessoa, devolvida também no ETag e usada no If-Match
+	Version int `json:"version"`
+}
+
+type PersonBalanceItem struct {
+	// ID da divisão
+	ShareID uuid.UUID `json:"share_id"`
+	// ID do débito
+	DebtID uuid.UUID `json:"debt_id"`
+	// Título do débito
+	Title string `json:"title"`
+	// Data da compra no formato YYYY-MM-DD
+	PurchaseDate string `json:"purchase_date"`
+	// Valor da parte da pessoa
+	Amount float64 `json:"amount"`
+	// Indica se a parte já foi paga
+	Settled bool `json:"settled"`
+}
+
+type PersonBalanceResponse struct {
+	// ID da pessoa
+	PersonID uuid.UUID `json:"person_id"`
+	// Nome da pessoa
+	Name string `json:"name"`
+	// Soma de todas as partes da pessoa
+	Total float64 `json:"total"`
+	// Soma das partes já pagas
+	Settled float64 `json:"settled"`
+	// Quanto a pessoa ainda deve
+	Owed float64 `json:"owed"`
+	// Partes da pessoa em cada débito, das compras mais recentes para as mais antigas
+	Items []PersonBalanceItem `json:"items"`
+}
+
+// PaymentStatus
 type PaymentStatusResponse struct {
 	// ID único do status
 	ID uuid.UUID `json:"id"`
@@ -118,4 +518,24 @@ type PaymentStatusResponse struct {
 	Name string `json:"name"`
 	// Descrião do status
 	Description *string `json:"description"`
+	// Versão do status, devolvida também no ETag e usada no If-Match
+	Version int `json:"version"`
+}
+
+// Health
+type ComponentHealth struct {
+	// "up" ou "down"
+	Status string `json:"status"`
+	// Tempo da verificação em milissegundos
+	LatencyMs int64 `json:"latency_ms"`
+	// Motivo da falha, quando down
+	Error string `json:"error,omitempty"`
+}
+
+type HealthResponse struct {
+	// "up" se todos os componentes responderem; "down" caso contrário ou
+	// durante o encerramento
+	Status string `json:"status"`
+	// Situação de cada dependência, como database e queue
+	Components map[string]ComponentHealth `json:"components,omitempty"`
 }
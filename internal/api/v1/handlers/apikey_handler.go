@@ -0,0 +1,116 @@
+package handlers
+
+import (
+	"backend-go/internal/api/errs"
+	"backend-go/internal/api/v1/dto"
+	"backend-go/internal/api/v1/services"
+	"backend-go/pkg/utils"
+	"errors"
+	"net/http"
+
+	"github.com/gin-gonic/gin"
+)
+
+type APIKeyHandler struct {
+	Service *services.APIKeyService
+}
+
+func NewAPIKeyHandler(service *services.APIKeyService) *APIKeyHandler {
+	return &APIKeyHandler{Service: service}
+}
+
+// @Summary Listar chaves de API
+// @Description Retorna as chaves do usuário autenticado, inclusive as revogadas. A chave completa nunca é retornada.
+// @Tags API Keys
+// @Produce json
+// @Success 200 {array} dto.APIKeyResponse
+// @Failure 403 {object} errs.ErrorResponse "Chaves de API não podem gerenciar chaves"
+// @Failure 500 {object} errs.ErrorResponse "Erro interno"
+// @Router /api_keys [get]
+func (h *APIKeyHandler) ListAPIKeysHandler(c *gin.Context) {
+	ctx := c.Request.Context()
+
+	data, err := h.Service.ListAPIKeys(ctx)
+	if err != nil {
+		if errors.Is(err, errs.ErrForbidden) {
+			c.Error(errs.NewAPIError(http.StatusForbidden, err))
+			return
+		}
+		c.Error(errs.NewAPIError(http.StatusInternalServerError, err))
+		return
+	}
+
+	c.JSON(http.StatusOK, data)
+}
+
+// @Summary Criar chave de API
+// @Description Cria uma chave com os escopos informados. A chave completa só é exibida nesta resposta e deve ser enviada no header "Authorization: ApiKey <chave>".
+// @Tags API Keys
+// @Accept json
+// @Produce json
+// @Param api_key body dto.APIKeyRequest true "Nome, escopos e expiração da chave"
+// @Success 201 {object} dto.APIKeyCreatedResponse
+// @Failure 400 {object} errs.ErrorResponse "Requisição inválida"
+// @Failure 403 {object} errs.ErrorResponse "Chaves de API não podem gerenciar chaves"
+// @Failure 500 {object} errs.ErrorResponse "Erro interno"
+// @Router /api_keys [post]
+func (h *APIKeyHandler) CreateAPIKeyHandler(c *gin.Context) {
+	ctx := c.Request.Context()
+	var req dto.APIKeyRequest
+
+	if err := c.ShouldBindJSON(&req); err != nil {
+		c.Error(errs.NewAPIError(http.StatusBadRequest, err))
+		return
+	}
+
+	input, err := h.Service.ParseAPIKey(req)
+	if err != nil {
+		c.Error(errs.NewAPIError(http.StatusBadRequest, err))
+		return
+	}
+
+	data, err := h.Service.CreateAPIKey(ctx, input)
+	if err != nil {
+		if errors.Is(err, errs.ErrForbidden) {
+			c.Error(errs.NewAPIError(http.StatusForbidden, err))
+			return
+		}
+		c.Error(errs.NewAPIError(http.StatusInternalServerError, err))
+		return
+	}
+
+	c.JSON(http.StatusCreated, data)
+}
+
+// @Summary Revogar chave de API
+// @Description A chave deixa de ser aceita imediatamente, mas continua listada
+// @Tags API Keys
+// @Param id path string true "ID da chave"
+// @Success 204
+// @Failure 400 {object} errs.ErrorResponse "ID inválido"
+// @Failure 403 {object} errs.ErrorResponse "Chaves de API não podem gerenciar chaves"
+// @Failure 404 {object} errs.ErrorResponse "Chave não encontrada ou já revogada"
+// @Failure 500 {object} errs.ErrorResponse "Erro interno"
+// @Router /api_keys/{id} [delete]
+func (h *APIKeyHandler) RevokeAPIKeyHandler(c *gin.Context) {
+	ctx := c.Request.Context()
+	id, err := utils.ToUUIDPointer(c.Param("id"))
+	if err != nil || id == nil {
+		c.Error(errs.NewAPIError(http.StatusBadRequest, err))
+		return
+	}
+
+	if err := h.Service.RevokeAPIKey(ctx, *id); err != nil {
+		switch {
+		case errors.Is(err, errs.ErrForbidden):
+			c.Error(errs.NewAPIError(http.StatusForbidden, err))
+		case errors.Is(err, errs.ErrNotFound):
+			c.Error(errs.NewAPIError(http.StatusNotFound, errs.ResorceNotFound("api_key", id.String())))
+		default:
+			c.Error(errs.NewAPIError(http.StatusInternalServerError, err))
+		}
+		return
+	}
+
+	c.JSON(http.StatusNoContent, nil)
+}
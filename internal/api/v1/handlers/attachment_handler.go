@@ -0,0 +1,228 @@
+package handlers
+
+import (
+	"backend-go/internal/api/errs"
+	"backend-go/internal/api/v1/repository/models"
+	"backend-go/internal/api/v1/services"
+	"backend-go/pkg/utils"
+	"errors"
+	"mime"
+	"net/http"
+
+	"github.com/gin-gonic/gin"
+	"github.com/google/uuid"
+)
+
+type AttachmentHandler struct {
+	Service *services.AttachmentService
+}
+
+func NewAttachmentHandler(service *services.AttachmentService) *AttachmentHandler {
+	return &AttachmentHandler{Service: service}
+}
+
+// @Summary Anexar arquivo ao débito
+// @Description Envia um comprovante (PDF, JPEG, PNG ou WebP) para o débito. O tipo é detectado pelo conteúdo.
+// @Tags Anexos
+// @Accept multipart/form-data
+// @Produce json
+// @Param id path string true "ID do débito"
+// @Param file formData file true "Arquivo"
+// @Success 201 {object} dto.AttachmentResponse
+// @Failure 400 {object} errs.ErrorResponse "Arquivo inválido"
+// @Failure 404 {object} errs.ErrorResponse "Débito não encontrado"
+// @Failure 413 {object} errs.ErrorResponse "Arquivo maior que o limite"
+// @Failure 415 {object} errs.ErrorResponse "Tipo de arquivo não aceito"
+// @Failure 500 {object} errs.ErrorResponse "Erro interno"
+// @Router /debts/{id}/attachments [post]
+func (h *AttachmentHandler) UploadDebtAttachmentHandler(c *gin.Context) {
+	id, err := utils.ToUUIDPointer(c.Param("id"))
+	if err != nil || id == nil {
+		c.Error(errs.NewAPIError(http.StatusBadRequest, err))
+		return
+	}
+
+	h.upload(c, models.Attachment{DebtID: id})
+}
+
+// @Summary Anexar arquivo à fatura
+// @Description Envia o PDF ou uma imagem da fatura. O tipo é detectado pelo conteúdo.
+// @Tags Anexos
+// @Accept multipart/form-data
+// @Produce json
+// @Param id path string true "ID da fatura"
+// @Param file formData file true "Arquivo"
+// @Success 201 {object} dto.AttachmentResponse
+// @Failure 400 {object} errs.ErrorResponse "Arquivo inválido"
+// @Failure 404 {object} errs.ErrorResponse "Fatura não encontrada"
+// @Failure 413 {object} errs.ErrorResponse "Arquivo maior que o limite"
+// @Failure 415 {object} errs.ErrorResponse "Tipo de arquivo não aceito"
+// @Failure 500 {object} errs.ErrorResponse "Erro interno"
+// @Router /invoices/{id}/attachments [post]
+func (h *AttachmentHandler) UploadInvoiceAttachmentHandler(c *gin.Context) {
+	id, err := utils.ToUUIDPointer(c.Param("id"))
+	if err != nil || id == nil {
+		c.Error(errs.NewAPIError(http.StatusBadRequest, err))
+		return
+	}
+
+	h.upload(c, models.Attachment{InvoiceID: id})
+}
+
+// @Summary Listar anexos do débito
+// @Tags Anexos
+// @Produce json
+// @Param id path string true "ID do débito"
+// @Success 200 {array} dto.AttachmentResponse
+// @Failure 400 {object} errs.ErrorResponse "ID inválido"
+// @Failure 404 {object} errs.ErrorResponse "Débito não encontrado"
+// @Failure 500 {object} errs.ErrorResponse "Erro interno"
+// @Router /debts/{id}/attachments [get]
+func (h *AttachmentHandler) ListDebtAttachmentsHandler(c *gin.Context) {
+	id, err := utils.ToUUIDPointer(c.Param("id"))
+	if err != nil || id == nil {
+		c.Error(errs.NewAPIError(http.StatusBadRequest, err))
+		return
+	}
+
+	h.list(c, id, nil)
+}
+
+// @Summary Listar anexos da fatura
+// @Tags Anexos
+// @Produce json
+// @Param id path string true "ID da fatura"
+// @Success 200 {array} dto.AttachmentResponse
+// @Failure 400 {object} errs.ErrorResponse "ID inválido"
+// @Failure 404 {object} errs.ErrorResponse "Fatura não encontrada"
+// @Failure 500 {object} errs.ErrorResponse "Erro interno"
+// @Router /invoices/{id}/attachments [get]
+func (h *AttachmentHandler) ListInvoiceAttachmentsHandler(c *gin.Context) {
+	id, err := utils.ToUUIDPointer(c.Param("id"))
+	if err != nil || id == nil {
+		c.Error(errs.NewAPIError(http.StatusBadRequest, err))
+		return
+	}
+
+	h.list(c, nil, id)
+}
+
+// @Summary Baixar anexo
+// @Description Retorna o conteúdo do arquivo com o seu tipo original
+// @Tags Anexos
+// @Produce application/octet-stream
+// @Param id path string true "ID do anexo"
+// @Success 200 {file} file
+// @Failure 400 {object} errs.ErrorResponse "ID inválido"
+// @Failure 404 {object} errs.ErrorResponse "Anexo não encontrado"
+// @Failure 500 {object} errs.ErrorResponse "Erro interno"
+// @Router /attachments/{id}/download [get]
+func (h *AttachmentHandler) DownloadAttachmentHandler(c *gin.Context) {
+	ctx := c.Request.Context()
+	id, err := utils.ToUUIDPointer(c.Param("id"))
+	if err != nil || id == nil {
+		c.Error(errs.NewAPIError(http.StatusBadRequest, err))
+		return
+	}
+
+	attachment, body, err := h.Service.OpenAttachment(ctx, *id)
+	if err != nil {
+		if errors.Is(err, errs.ErrNotFound) {
+			c.Error(errs.NewAPIError(http.StatusNotFound, err))
+			return
+		}
+		c.Error(errs.NewAPIError(http.StatusInternalServerError, err))
+		return
+	}
+	defer body.Close()
+
+	disposition := mime.FormatMediaType("attachment", map[string]string{"filename": attachment.FileName})
+	c.DataFromReader(http.StatusOK, attachment.Size, attachment.ContentType, body, map[string]string{
+		"Content-Disposition": disposition,
+	})
+}
+
+// @Summary Remover anexo
+// @Tags Anexos
+// @Param id path string true "ID do anexo"
+// @Success 204 "Registro deletado com sucesso"
+// @Failure 400 {object} errs.ErrorResponse "ID inválido"
+// @Failure 404 {object} errs.ErrorResponse "Anexo não encontrado"
+// @Failure 500 {object} errs.ErrorResponse "Erro interno"
+// @Router /attachments/{id} [delete]
+func (h *AttachmentHandler) DeleteAttachmentHandler(c *gin.Context) {
+	ctx := c.Request.Context()
+	id, err := utils.ToUUIDPointer(c.Param("id"))
+	if err != nil || id == nil {
+		c.Error(errs.NewAPIError(http.StatusBadRequest, err))
+		return
+	}
+
+	if err := h.Service.DeleteAttachmentByID(ctx, *id); err != nil {
+		if errors.Is(err, errs.ErrNotFound) {
+			c.Error(errs.NewAPIError(http.StatusNotFound, err))
+			return
+		}
+		c.Error(errs.NewAPIError(http.StatusInternalServerError, err))
+		return
+	}
+
+	c.JSON(http.StatusNoContent, nil)
+}
+
+func (h *AttachmentHandler) upload(c *gin.Context, input models.Attachment) {
+	ctx := c.Request.Context()
+
+	// Limita o corpo da requisição para não receber arquivos enormes; a folga
+	// cobre os cabeçalhos do multipart
+	c.Request.Body = http.MaxBytesReader(c.Writer, c.Request.Body, h.Service.MaxSize+1<<20)
+
+	file, header, err := c.Request.FormFile("file")
+	if err != nil {
+		var maxErr *http.MaxBytesError
+		if errors.As(err, &maxErr) {
+			c.Error(errs.NewAPIError(http.StatusRequestEntityTooLarge, errs.InvalidParam("file", errs.ErrPayloadTooLarge)))
+			return
+		}
+		c.Error(errs.NewAPIError(http.StatusBadRequest, errs.InvalidParam("file", err)))
+		return
+	}
+	defer file.Close()
+
+	input.FileName = header.Filename
+	data, err := h.Service.UploadAttachment(ctx, input, file, header.Size)
+	if err != nil {
+		switch {
+		case errors.Is(err, errs.ErrNotFound):
+			c.Error(errs.NewAPIError(http.StatusNotFound, err))
+		case errors.Is(err, errs.ErrPayloadTooLarge):
+			c.Error(errs.NewAPIError(http.StatusRequestEntityTooLarge, err))
+		case errors.Is(err, errs.ErrUnsupportedMedia):
+			c.Error(errs.NewAPIError(http.StatusUnsupportedMediaType, err))
+		case errors.Is(err, errs.ErrBadRequest):
+			c.Error(errs.NewAPIError(http.StatusBadRequest, err))
+		default:
+			c.Error(errs.NewAPIError(http.StatusInternalServerError, err))
+		}
+		return
+	}
+
+	c.Header("Location", "/api/v1/attachments/"+data.ID.String()+"/download")
+	c.JSON(http.StatusCreated, data)
+}
+
+func (h *AttachmentHandler) list(c *gin.Context, debtID, invoiceID *uuid.UUID) {
+	ctx := c.Request.Context()
+
+	data, err := h.Service.ListAttachments(ctx, debtID, invoiceID)
+	if err != nil {
+		if errors.Is(err, errs.ErrNotFound) {
+			c.Error(errs.NewAPIError(http.StatusNotFound, err))
+			return
+		}
+		c.Error(errs.NewAPIError(http.StatusInternalServerError, err))
+		return
+	}
+
+	c.JSON(http.StatusOK, data)
+}
@@ -0,0 +1,155 @@
+package handlers
+
+import (
+	"backend-go/internal/api/errs"
+	"backend-go/internal/api/v1/dto"
+	"backend-go/internal/api/v1/services"
+	"backend-go/pkg/auth"
+	"errors"
+	"net/http"
+
+	"github.com/gin-gonic/gin"
+)
+
+type AuthHandler struct {
+	Service *services.AuthService
+}
+
+func NewAuthHandler(service *services.AuthService) *AuthHandler {
+	return &AuthHandler{Service: service}
+}
+
+// @Summary Cadastrar usuário
+// @Description Cria um novo usuário com as categorias padrão
+// @Tags Autenticação
+// @Accept json
+// @Produce json
+// @Param user body dto.RegisterRequest true "Dados do usuário"
+// @Success 201 {object} dto.UserResponse
+// @Failure 400 {object} errs.ErrorResponse "Requisição inválida"
+// @Failure 409 {object} errs.ErrorResponse "E-mail já cadastrado"
+// @Failure 500 {object} errs.ErrorResponse "Erro interno"
+// @Router /auth/register [post]
+func (h *AuthHandler) RegisterHandler(c *gin.Context) {
+	ctx := c.Request.Context()
+	var req dto.RegisterRequest
+
+	if err := c.ShouldBindJSON(&req); err != nil {
+		c.Error(errs.NewAPIError(http.StatusBadRequest, err))
+		return
+	}
+
+	input, err := h.Service.ParseUser(req)
+	if err != nil {
+		c.Error(errs.NewAPIError(http.StatusBadRequest, err))
+		return
+	}
+
+	data, err := h.Service.Register(ctx, input)
+	if err != nil {
+		if errors.Is(err, errs.ErrConflict) {
+			c.Error(errs.NewAPIError(http.StatusConflict, err))
+			return
+		}
+		c.Error(errs.NewAPIError(http.StatusInternalServerError, err))
+		return
+	}
+
+	c.JSON(http.StatusCreated, data)
+}
+
+// @Summary Login
+// @Description Valida as credenciais e retorna o token de acesso e o refresh token
+// @Tags Autenticação
+// @Accept json
+// @Produce json
+// @Param credentials body dto.LoginRequest true "Credenciais"
+// @Success 200 {object} dto.TokenResponse
+// @Failure 400 {object} errs.ErrorResponse "Requisição inválida"
+// @Failure 401 {object} errs.ErrorResponse "Credenciais inválidas"
+// @Failure 500 {object} errs.ErrorResponse "Erro interno"
+// @Router /auth/login [post]
+func (h *AuthHandler) LoginHandler(c *gin.Context) {
+	ctx := c.Request.Context()
+	var req dto.LoginRequest
+
+	if err := c.ShouldBindJSON(&req); err != nil {
+		c.Error(errs.NewAPIError(http.StatusBadRequest, err))
+		return
+	}
+
+	data, err := h.Service.Login(ctx, req)
+	if err != nil {
+		if errors.Is(err, errs.ErrUnauthorized) {
+			c.Error(errs.NewAPIError(http.StatusUnauthorized, err))
+			return
+		}
+		c.Error(errs.NewAPIError(http.StatusInternalServerError, err))
+		return
+	}
+
+	c.JSON(http.StatusOK, data)
+}
+
+// @Summary Renovar tokens
+// @Description Emite um novo par de tokens a partir de um refresh token válido. O refresh token usado não é invalidado e vale até expirar.
+// @Tags Autenticação
+// @Accept json
+// @Produce json
+// @Param token body dto.RefreshRequest true "Refresh token"
+// @Success 200 {object} dto.TokenResponse
+// @Failure 400 {object} errs.ErrorResponse "Requisição inválida"
+// @Failure 401 {object} errs.ErrorResponse "Token inválido ou expirado"
+// @Failure 500 {object} errs.ErrorResponse "Erro interno"
+// @Router /auth/refresh [post]
+func (h *AuthHandler) RefreshHandler(c *gin.Context) {
+	ctx := c.Request.Context()
+	var req dto.RefreshRequest
+
+	if err := c.ShouldBindJSON(&req); err != nil {
+		c.Error(errs.NewAPIError(http.StatusBadRequest, err))
+		return
+	}
+
+	data, err := h.Service.Refresh(ctx, req)
+	if err != nil {
+		if errors.Is(err, errs.ErrUnauthorized) {
+			c.Error(errs.NewAPIError(http.StatusUnauthorized, err))
+			return
+		}
+		c.Error(errs.NewAPIError(http.StatusInternalServerError, err))
+		return
+	}
+
+	c.JSON(http.StatusOK, data)
+}
+
+// @Summary Usuário autenticado
+// @Description Retorna os dados do usuário dono do token
+// @Tags Autenticação
+// @Produce json
+// @Success 200 {object} dto.UserResponse
+// @Failure 401 {object} errs.ErrorResponse "Não autorizado"
+// @Failure 500 {object} errs.ErrorResponse "Erro interno"
+// @Router /auth/me [get]
+func (h *AuthHandler) MeHandler(c *gin.Context) {
+	ctx := c.Request.Context()
+
+	userID, ok := auth.UserIDFromContext(ctx)
+	if !ok {
+		c.Error(errs.NewAPIError(http.StatusUnauthorized, errs.ErrUnauthorized))
+		return
+	}
+
+	data, err := h.Service.GetUserByID(ctx, userID)
+	if err != nil {
+		if errors.Is(err, errs.ErrNotFound) {
+			c.Error(errs.NewAPIError(http.StatusUnauthorized, errs.ErrUnauthorized))
+			return
+		}
+		c.Error(errs.NewAPIError(http.StatusInternalServerError, err))
+		return
+	}
+
+	c.JSON(http.StatusOK, data)
+}
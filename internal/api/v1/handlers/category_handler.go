@@ -62,6 +62,11 @@ func (h *CategoryHandler) GetCategoryByIDHandler(c *gin.Context) {
 		return
 	}
 
+	if notModified(c, data.Version) {
+		c.Status(http.StatusNotModified)
+		return
+	}
+
 	c.JSON(http.StatusOK, data)
 }
 
@@ -105,6 +110,22 @@ func (h *CategoryHandler) UpdateCategoryHandler(c *gin.Context) {
 		return
 	}
 
+	current, err := h.Service.GetCategoryByID(ctx, *id)
+	if err != nil {
+		if errors.Is(err, errs.ErrNotFound) {
+			c.Error(errs.NewAPIError(http.StatusNotFound, err))
+			return
+		}
+		c.Error(errs.NewAPIError(http.StatusInternalServerError, err))
+		return
+	}
+
+	ctx, err = ifMatch(c, current.Version)
+	if err != nil {
+		c.Error(err)
+		return
+	}
+
 	var req dto.CategoryRequest
 	if err := c.ShouldBindJSON(&req); err != nil {
 		c.Error(errs.NewAPIError(http.StatusBadRequest, err))
@@ -116,13 +137,82 @@ func (h *CategoryHandler) UpdateCategoryHandler(c *gin.Context) {
 		c.Error(errs.NewAPIError(http.StatusBadRequest, err))
 		return
 	}
+	input.ID = *id
 
 	data, err := h.Service.UpdateCategory(ctx, input)
 	if err != nil {
+		switch {
+		case errors.Is(err, errs.ErrNotFound):
+			c.Error(errs.NewAPIError(http.StatusNotFound, err))
+		case errors.Is(err, errs.ErrPreconditionFailed):
+			c.Error(errs.NewAPIError(http.StatusPreconditionFailed, err))
+		default:
+			c.Error(errs.NewAPIError(http.StatusInternalServerError, err))
+		}
+		return
+	}
+
+	setETag(c, data.Version)
+	c.JSON(http.StatusOK, data)
+}
+
+func (h *CategoryHandler) PatchCategoryHandler(c *gin.Context) {
+	ctx := c.Request.Context()
+	id, err := utils.ToUUIDPointer(c.Param("id"))
+	if err != nil || id == nil {
+		c.Error(errs.NewAPIError(http.StatusBadRequest, err))
+		return
+	}
+
+	patch, err := readMergePatch(c)
+	if err != nil {
+		c.Error(err)
+		return
+	}
+
+	current, err := h.Service.GetCategoryByID(ctx, *id)
+	if err != nil {
+		if errors.Is(err, errs.ErrNotFound) {
+			c.Error(errs.NewAPIError(http.StatusNotFound, err))
+			return
+		}
 		c.Error(errs.NewAPIError(http.StatusInternalServerError, err))
 		return
 	}
 
+	ctx, err = ifMatch(c, current.Version)
+	if err != nil {
+		c.Error(err)
+		return
+	}
+
+	req, err := h.Service.ApplyCategoryPatch(*current, patch)
+	if err != nil {
+		c.Error(errs.NewAPIError(http.StatusBadRequest, err))
+		return
+	}
+
+	input, err := h.Service.ParseCategory(req)
+	if err != nil {
+		c.Error(errs.NewAPIError(http.StatusBadRequest, err))
+		return
+	}
+	input.ID = *id
+
+	data, err := h.Service.UpdateCategory(ctx, input)
+	if err != nil {
+		switch {
+		case errors.Is(err, errs.ErrNotFound):
+			c.Error(errs.NewAPIError(http.StatusNotFound, err))
+		case errors.Is(err, errs.ErrPreconditionFailed):
+			c.Error(errs.NewAPIError(http.StatusPreconditionFailed, err))
+		default:
+			c.Error(errs.NewAPIError(http.StatusInternalServerError, err))
+		}
+		return
+	}
+
+	setETag(c, data.Version)
 	c.JSON(http.StatusOK, data)
 }
 
@@ -134,11 +224,77 @@ func (h *CategoryHandler) DeleteCategoryHandler(c *gin.Context) {
 		return
 	}
 
-	err = h.Service.DeleteCategoryByID(ctx, *id)
+	if hasIfMatch(c) {
+		current, err := h.Service.GetCategoryByID(ctx, *id)
+		if err != nil {
+			if errors.Is(err, errs.ErrNotFound) {
+				c.Error(errs.NewAPIError(http.StatusNotFound, err))
+				return
+			}
+			c.Error(errs.NewAPIError(http.StatusInternalServerError, err))
+			return
+		}
+
+		if ctx, err = ifMatch(c, current.Version); err != nil {
+			c.Error(err)
+			return
+		}
+	}
+
+	if err := h.Service.DeleteCategoryByID(ctx, *id); err != nil {
+		switch {
+		case errors.Is(err, errs.ErrNotFound):
+			c.Error(errs.NewAPIError(http.StatusNotFound, err))
+		case errors.Is(err, errs.ErrPreconditionFailed):
+			c.Error(errs.NewAPIError(http.StatusPreconditionFailed, err))
+		case errors.Is(err, errs.ErrConflict):
+			c.Error(errs.NewAPIError(http.StatusConflict, err))
+		default:
+			c.Error(errs.NewAPIError(http.StatusInternalServerError, err))
+		}
+		return
+	}
+
+	c.JSON(http.StatusNoContent, nil)
+}
+
+func (h *CategoryHandler) ListDeletedCategoriesHandler(c *gin.Context) {
+	ctx := c.Request.Context()
+
+	pgn, err := newTrashPagination(c)
+	if err != nil {
+		c.Error(errs.NewAPIError(http.StatusBadRequest, err))
+		return
+	}
+
+	data, total, err := h.Service.ListDeletedCategories(ctx, pgn)
 	if err != nil {
 		c.Error(errs.NewAPIError(http.StatusInternalServerError, err))
 		return
 	}
 
-	c.JSON(http.StatusNoContent, nil)
+	pgn.SetPaginationHeaders(c, total)
+
+	c.JSON(http.StatusOK, data)
+}
+
+func (h *CategoryHandler) RestoreCategoryHandler(c *gin.Context) {
+	ctx := c.Request.Context()
+	id, err := utils.ToUUIDPointer(c.Param("id"))
+	if err != nil || id == nil {
+		c.Error(errs.NewAPIError(http.StatusBadRequest, err))
+		return
+	}
+
+	data, err := h.Service.RestoreCategoryByID(ctx, *id)
+	if err != nil {
+		if errors.Is(err, errs.ErrNotFound) {
+			c.Error(errs.NewAPIError(http.StatusNotFound, err))
+			return
+		}
+		c.Error(errs.NewAPIError(http.StatusInternalServerError, err))
+		return
+	}
+
+	c.JSON(http.StatusOK, data)
 }
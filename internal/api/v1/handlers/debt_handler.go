@@ -4,6 +4,8 @@ import (
 	"backend-go/internal/api/errs"
 	"backend-go/internal/api/v1/dto"
 	"backend-go/internal/api/v1/services"
+	"backend-go/pkg/fieldset"
+	"backend-go/pkg/filter"
 	"backend-go/pkg/pagination"
 	"backend-go/pkg/utils"
 	"errors"
@@ -28,6 +30,7 @@ func NewDebtHandler(service *services.DebtService) *DebtHandler {
 // @Param debt body dto.DebtRequest true "Dados do débito"
 // @Success 201 {object} models.Debt
 // @Failure 400 {object} errs.ErrorResponse "Requisição inválida"
+// @Failure 422 {object} errs.ErrorResponse "Fatura, categoria ou débito original não encontrado, ou estornos acima do valor original"
 // @Failure 500 {object} errs.ErrorResponse "Erro interno"
 // @Router /debts [post]
 func (h *DebtHandler) CreateDebtHandler(c *gin.Context) {
@@ -47,6 +50,10 @@ func (h *DebtHandler) CreateDebtHandler(c *gin.Context) {
 
 	newDebt, err := h.Service.CreateDebt(ctx, input)
 	if err != nil {
+		if errors.Is(err, errs.ErrUnprocessable) {
+			c.Error(errs.NewAPIError(http.StatusUnprocessableEntity, err))
+			return
+		}
 		c.Error(errs.NewAPIError(http.StatusInternalServerError, err))
 		return
 	}
@@ -54,6 +61,47 @@ func (h *DebtHandler) CreateDebtHandler(c *gin.Context) {
 	c.JSON(http.StatusCreated, newDebt)
 }
 
+// @Summary Criar, atualizar e remover débitos em lote
+// @Description Executa as operações em uma única transação. No modo all_or_nothing (padrão), nada é salvo se alguma operação falhar e a resposta é 422; no best_effort, as operações válidas são salvas. O resultado de cada operação traz o status HTTP equivalente.
+// @Tags Débitos
+// @Accept json
+// @Produce json
+// @Param operations body dto.DebtBulkRequest true "Operações do lote"
+// @Success 200 {object} dto.DebtBulkResponse
+// @Failure 400 {object} errs.ErrorResponse "Requisição inválida"
+// @Failure 413 {object} errs.ErrorResponse "Operações acima do limite"
+// @Failure 422 {object} dto.DebtBulkResponse "Alguma operação falhou no modo all_or_nothing"
+// @Failure 500 {object} errs.ErrorResponse "Erro interno"
+// @Router /debts/bulk [post]
+func (h *DebtHandler) BulkDebtsHandler(c *gin.Context) {
+	ctx := c.Request.Context()
+
+	var req dto.DebtBulkRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		c.Error(errs.NewAPIError(http.StatusBadRequest, err))
+		return
+	}
+
+	data, err := h.Service.BulkDebts(ctx, req)
+	if err != nil {
+		switch {
+		case errors.Is(err, errs.ErrPayloadTooLarge):
+			c.Error(errs.NewAPIError(http.StatusRequestEntityTooLarge, err))
+		case errors.Is(err, errs.ErrBadRequest):
+			c.Error(errs.NewAPIError(http.StatusBadRequest, err))
+		default:
+			c.Error(errs.NewAPIError(http.StatusInternalServerError, err))
+		}
+		return
+	}
+
+	status := http.StatusOK
+	if data.Mode == services.BulkModeAllOrNothing && data.Failed > 0 {
+		status = http.StatusUnprocessableEntity
+	}
+	c.JSON(status, data)
+}
+
 // @Summary Buscar débito por ID
 // @Description Retorna um débito pelo ID fornecido na URL
 // @Tags Débitos
@@ -64,6 +112,9 @@ func (h *DebtHandler) CreateDebtHandler(c *gin.Context) {
 // @Failure 400 {object} errs.ErrorResponse "ID inválido"
 // @Failure 404 {object} errs.ErrorResponse "Registro não encontrado"
 // @Failure 500 {object} errs.ErrorResponse "Erro interno"
+// @Param If-None-Match header string false "ETag de uma leitura anterior"
+// @Header 200 {string} ETag "Versão do registro"
+// @Success 304 "Registro não foi alterado"
 // @Router /debts/{id} [get]
 func (h *DebtHandler) GetDebtByIDHandler(c *gin.Context) {
 	ctx := c.Request.Context()
@@ -84,6 +135,11 @@ func (h *DebtHandler) GetDebtByIDHandler(c *gin.Context) {
 		return
 	}
 
+	if notModified(c, data.Version) {
+		c.Status(http.StatusNotModified)
+		return
+	}
+
 	c.JSON(http.StatusOK, data)
 }
 
@@ -100,9 +156,17 @@ func (h *DebtHandler) GetDebtByIDHandler(c *gin.Context) {
 // @Param start_date query string false "Filtrar por data de início (YYYY-MM-DD)"
 // @Param end_date query string false "Filtrar por data de término (YYYY-MM-DD)"
 // @Param invoice_id query string false "Filtrar por ID da fatura (UUID)"
+// @Param tag query string false "Filtrar por nome da tag; pode ser repetido"
+// @Param kind query string false "Filtrar por tipo (purchase, refund, fee, interest, iof); pode ser repetido"
+// @Param search query string false "Busca textual, sem diferenciar acentos, no título e nos nomes da categoria, do status e da fatura. Sem order_by, ordena pela relevância"
+// @Param filter query string false "Filtros no formato filter[campo][operador]=valor (ex: filter[due_date][gte]=2026-01-01, filter[category_id][null]=true, filter[title][ncontains]=uber). Operadores: eq, ne, gt, gte, lt, lte, in, nin, contains, ncontains e null"
+// @Param fields query string false "Campos da resposta separados por vírgula (ex: title,amount); o id é sempre devolvido"
+// @Param expand query string false "Relacionamentos devolvidos completos em expanded: invoice, category e status"
 // @Param page query int false "Número da página"
 // @Param page_size query int false "Tamanho da página"
-// @Param order_by query string false "Ordenação dos resultados (ex: amount, due_date)"
+// @Param order_by query string false "Campos de ordenação separados por vírgula; o prefixo - ordena de forma decrescente (ex: -due_date,title). Aceita também category, status e invoice_title"
+// @Param cursor query string false "Cursor da próxima página; vazio inicia a paginação por cursor"
+// @Param total query bool false "Contar o total de registros"
 // @Success 200 {array} dto.DebtsResponse "Lista de débitos"
 // @Failure 400 {object} errs.ErrorResponse "Parâmetros inválidos"
 // @Failure 500 {object} errs.ErrorResponse "Erro interno"
@@ -133,14 +197,51 @@ func (h *DebtHandler) ListDebtsHandler(c *gin.Context) {
 		"status_id":     true,
 		"created_at":    true,
 		"updated_at":    true,
+		"invoice_title": true,
+		"category":      true,
+		"status":        true,
+	}
+
+	if err := pgn.ValidateOrderBy("-purchase_date", validColumns); err != nil {
+		c.Error(errs.NewAPIError(http.StatusBadRequest, err))
+		return
+	}
+
+	validFilters := filter.Fields{
+		"id":            filter.UUID,
+		"title":         filter.String,
+		"kind":          filter.String,
+		"amount":        filter.Number,
+		"purchase_date": filter.Date,
+		"due_date":      filter.Date,
+		"invoice_id":    filter.UUID,
+		"category_id":   filter.UUID,
+		"status_id":     filter.UUID,
+		"refund_of_id":  filter.UUID,
+		"tag":           filter.String,
+		"created_at":    filter.Date,
+		"updated_at":    filter.Date,
+	}
+
+	conds, err := filter.Parse(c.Request.URL.Query(), validFilters)
+	if err != nil {
+		c.Error(errs.NewAPIError(http.StatusBadRequest, err))
+		return
+	}
+
+	validExpand := map[string]bool{
+		"invoice":  true,
+		"category": true,
+		"status":   true,
 	}
 
-	if err := pgn.ValidateOrderBy("purchase_date", validColumns); err != nil {
+	fs, err := fieldset.Parse(c, dto.DebtResponse{}, validExpand)
+	if err != nil {
 		c.Error(errs.NewAPIError(http.StatusBadRequest, err))
 		return
 	}
 
-	response, total, err := h.Service.ListDebts(ctx, flt, pgn)
+	response, total, err := h.Service.ListDebts(ctx, flt, conds, pgn, fs)
 
 	if err != nil {
 		c.Error(errs.NewAPIError(http.StatusInternalServerError, err))
@@ -149,7 +250,12 @@ func (h *DebtHandler) ListDebtsHandler(c *gin.Context) {
 
 	pgn.SetPaginationHeaders(c, total)
 
-	c.JSON(http.StatusOK, response)
+	body, err := fs.Apply(response)
+	if err != nil {
+		c.Error(errs.NewAPIError(http.StatusInternalServerError, err))
+		return
+	}
+	c.JSON(http.StatusOK, body)
 }
 
 // @Summary Atualizar um débito
@@ -162,7 +268,10 @@ func (h *DebtHandler) ListDebtsHandler(c *gin.Context) {
 // @Success 200 {object} models.Debt
 // @Failure 400 {object} errs.ErrorResponse "Requisição inválida ou ID inválido"
 // @Failure 404 {object} errs.ErrorResponse "Registro não encontrado"
+// @Failure 422 {object} errs.ErrorResponse "Fatura, categoria ou débito original não encontrado, valor diferente da soma das divisões ou estornos acima do valor original"
 // @Failure 500 {object} errs.ErrorResponse "Erro interno"
+// @Param If-Match header string false "ETag da versão que está sendo alterada"
+// @Failure 412 {object} errs.ErrorResponse "Registro alterado por outra requisição"
 // @Router /debts/{id} [put]
 func (h *DebtHandler) UpdateDebtHandler(c *gin.Context) {
 	ctx := c.Request.Context()
@@ -172,7 +281,7 @@ func (h *DebtHandler) UpdateDebtHandler(c *gin.Context) {
 		return
 	}
 
-	_, err = h.Service.GetDebtByID(ctx, *id)
+	current, err := h.Service.GetDebtByID(ctx, *id)
 	if err != nil {
 		if errors.Is(err, errs.ErrNotFound) {
 			c.Error(errs.NewAPIError(http.StatusNotFound, err))
@@ -182,6 +291,12 @@ func (h *DebtHandler) UpdateDebtHandler(c *gin.Context) {
 		return
 	}
 
+	ctx, err = ifMatch(c, current.Version)
+	if err != nil {
+		c.Error(err)
+		return
+	}
+
 	var req dto.DebtRequest
 	if err := c.ShouldBindJSON(&req); err != nil {
 		c.Error(errs.NewAPIError(http.StatusBadRequest, err))
@@ -193,18 +308,102 @@ func (h *DebtHandler) UpdateDebtHandler(c *gin.Context) {
 		c.Error(errs.NewAPIError(http.StatusBadRequest, err))
 		return
 	}
+	input.ID = *id
 
 	data, err := h.Service.UpdateDebt(ctx, input)
 	if err != nil {
+		switch {
+		case errors.Is(err, errs.ErrNotFound):
+			c.Error(errs.NewAPIError(http.StatusNotFound, err))
+		case errors.Is(err, errs.ErrPreconditionFailed):
+			c.Error(errs.NewAPIError(http.StatusPreconditionFailed, err))
+		case errors.Is(err, errs.ErrUnprocessable):
+			c.Error(errs.NewAPIError(http.StatusUnprocessableEntity, err))
+		default:
+			c.Error(errs.NewAPIError(http.StatusInternalServerError, err))
+		}
+		return
+	}
+
+	setETag(c, data.Version)
+	c.JSON(http.StatusOK, data)
+}
+
+// @Summary Atualizar parte de um débito
+// @Description Aplica um JSON Merge Patch (RFC 7386) ao débito: campos omitidos são mantidos e campos com null são limpos. status_id não aceita null e a categoria não é preenchida automaticamente
+// @Tags Débitos
+// @Accept json
+// @Accept application/merge-patch+json
+// @Produce json
+// @Param id path string true "ID do débito"
+// @Param debt body dto.DebtRequest true "Campos alterados do débito"
+// @Success 200 {object} dto.DebtResponse
+// @Failure 400 {object} errs.ErrorResponse "Patch inválido ou ID inválido"
+// @Failure 404 {object} errs.ErrorResponse "Registro não encontrado"
+// @Failure 415 {object} errs.ErrorResponse "Tipo de conteúdo não suportado"
+// @Failure 422 {object} errs.ErrorResponse "Fatura, categoria, status ou débito original não encontrado, valor diferente da soma das divisões ou estornos acima do valor original"
+// @Failure 500 {object} errs.ErrorResponse "Erro interno"
+// @Param If-Match header string false "ETag da versão que está sendo alterada"
+// @Failure 412 {object} errs.ErrorResponse "Registro alterado por outra requisição"
+// @Router /debts/{id} [patch]
+func (h *DebtHandler) PatchDebtHandler(c *gin.Context) {
+	ctx := c.Request.Context()
+	id, err := utils.ToUUIDPointer(c.Param("id"))
+	if err != nil || id == nil {
+		c.Error(errs.NewAPIError(http.StatusBadRequest, err))
+		return
+	}
+
+	patch, err := readMergePatch(c)
+	if err != nil {
+		c.Error(err)
+		return
+	}
+
+	current, err := h.Service.GetDebtByID(ctx, *id)
+	if err != nil {
+		if errors.Is(err, errs.ErrNotFound) {
+			c.Error(errs.NewAPIError(http.StatusNotFound, err))
+			return
+		}
 		c.Error(errs.NewAPIError(http.StatusInternalServerError, err))
 		return
 	}
 
+	ctx, err = ifMatch(c, current.Version)
+	if err != nil {
+		c.Error(err)
+		return
+	}
+
+	input, err := h.Service.ApplyDebtPatch(*current, patch)
+	if err != nil {
+		c.Error(errs.NewAPIError(http.StatusBadRequest, err))
+		return
+	}
+	input.ID = *id
+
+	data, err := h.Service.UpdateDebt(ctx, input)
+	if err != nil {
+		switch {
+		case errors.Is(err, errs.ErrNotFound):
+			c.Error(errs.NewAPIError(http.StatusNotFound, err))
+		case errors.Is(err, errs.ErrPreconditionFailed):
+			c.Error(errs.NewAPIError(http.StatusPreconditionFailed, err))
+		case errors.Is(err, errs.ErrUnprocessable):
+			c.Error(errs.NewAPIError(http.StatusUnprocessableEntity, err))
+		default:
+			c.Error(errs.NewAPIError(http.StatusInternalServerError, err))
+		}
+		return
+	}
+
+	setETag(c, data.Version)
 	c.JSON(http.StatusOK, data)
 }
 
 // @Summary Deletar um débito
-// @Description Remove um débito pelo ID fornecido
+// @Description Move o débito para a lixeira; ele pode ser restaurado até ser apagado pelo purge
 // @Tags Débitos
 // @Accept json
 // @Produce json
@@ -213,6 +412,8 @@ func (h *DebtHandler) UpdateDebtHandler(c *gin.Context) {
 // @Failure 400 {object} errs.ErrorResponse "ID inválido"
 // @Failure 404 {object} errs.ErrorResponse "Registro não encontrado"
 // @Failure 500 {object} errs.ErrorResponse "Erro interno"
+// @Param If-Match header string false "ETag da versão que está sendo alterada"
+// @Failure 412 {object} errs.ErrorResponse "Registro alterado por outra requisição"
 // @Router /debts/{id} [delete]
 func (h *DebtHandler) DeleteDebtHandler(c *gin.Context) {
 	ctx := c.Request.Context()
@@ -222,16 +423,138 @@ func (h *DebtHandler) DeleteDebtHandler(c *gin.Context) {
 		return
 	}
 
-	err = h.Service.DeleteDebtByID(ctx, *id)
+	if hasIfMatch(c) {
+		current, err := h.Service.GetDebtByID(ctx, *id)
+		if err != nil {
+			if errors.Is(err, errs.ErrNotFound) {
+				c.Error(errs.NewAPIError(http.StatusNotFound, err))
+				return
+			}
+			c.Error(errs.NewAPIError(http.StatusInternalServerError, err))
+			return
+		}
+
+		if ctx, err = ifMatch(c, current.Version); err != nil {
+			c.Error(err)
+			return
+		}
+	}
+
+	if err := h.Service.DeleteDebtByID(ctx, *id); err != nil {
+		switch {
+		case errors.Is(err, errs.ErrNotFound):
+			c.Error(errs.NewAPIError(http.StatusNotFound, err))
+		case errors.Is(err, errs.ErrPreconditionFailed):
+			c.Error(errs.NewAPIError(http.StatusPreconditionFailed, err))
+		default:
+			c.Error(errs.NewAPIError(http.StatusInternalServerError, err))
+		}
+		return
+	}
+
+	c.JSON(http.StatusNoContent, nil)
+}
+
+// @Summary Listar débitos da lixeira
+// @Description Retorna os débitos removidos, da remoção mais recente para a mais antiga
+// @Tags Débitos
+// @Produce json
+// @Param page query int false "Número da página"
+// @Param page_size query int false "Tamanho da página"
+// @Param cursor query string false "Cursor da próxima página; vazio inicia a paginação por cursor"
+// @Param total query bool false "Contar o total de registros"
+// @Success 200 {array} dto.DebtResponse
+// @Failure 400 {object} errs.ErrorResponse "Parâmetros inválidos"
+// @Failure 500 {object} errs.ErrorResponse "Erro interno"
+// @Router /debts/trash [get]
+func (h *DebtHandler) ListDeletedDebtsHandler(c *gin.Context) {
+	ctx := c.Request.Context()
+
+	pgn, err := newTrashPagination(c)
+	if err != nil {
+		c.Error(errs.NewAPIError(http.StatusBadRequest, err))
+		return
+	}
+
+	data, total, err := h.Service.ListDeletedDebts(ctx, pgn)
+	if err != nil {
+		c.Error(errs.NewAPIError(http.StatusInternalServerError, err))
+		return
+	}
+
+	pgn.SetPaginationHeaders(c, total)
+
+	c.JSON(http.StatusOK, data)
+}
+
+// @Summary Restaurar um débito
+// @Description Tira o débito da lixeira
+// @Tags Débitos
+// @Produce json
+// @Param id path string true "ID do débito"
+// @Success 200 {object} dto.DebtResponse
+// @Failure 400 {object} errs.ErrorResponse "ID inválido"
+// @Failure 404 {object} errs.ErrorResponse "Registro não encontrado na lixeira"
+// @Failure 500 {object} errs.ErrorResponse "Erro interno"
+// @Router /debts/{id}/restore [post]
+func (h *DebtHandler) RestoreDebtHandler(c *gin.Context) {
+	ctx := c.Request.Context()
+	id, err := utils.ToUUIDPointer(c.Param("id"))
+	if err != nil || id == nil {
+		c.Error(errs.NewAPIError(http.StatusBadRequest, err))
+		return
+	}
+
+	data, err := h.Service.RestoreDebtByID(ctx, *id)
 	if err != nil {
 		if errors.Is(err, errs.ErrNotFound) {
 			c.Error(errs.NewAPIError(http.StatusNotFound, err))
 			return
 		}
+		c.Error(errs.NewAPIError(http.StatusInternalServerError, err))
+		return
+	}
+
+	c.JSON(http.StatusOK, data)
+}
+
+// @Summary Histórico do débito
+// @Description Retorna quem alterou o débito, em qual requisição e os valores antes e depois de cada alteração, da mais recente para a mais antiga
+// @Tags Débitos
+// @Produce json
+// @Param id path string true "ID do débito"
+// @Param page query int false "Número da página"
+// @Param page_size query int false "Tamanho da página"
+// @Success 200 {array} dto.AuditEntryResponse
+// @Failure 400 {object} errs.ErrorResponse "ID inválido"
+// @Failure 404 {object} errs.ErrorResponse "Débito não encontrado"
+// @Failure 500 {object} errs.ErrorResponse "Erro interno"
+// @Router /debts/{id}/history [get]
+func (h *DebtHandler) ListDebtHistoryHandler(c *gin.Context) {
+	ctx := c.Request.Context()
+	id, err := utils.ToUUIDPointer(c.Param("id"))
+	if err != nil || id == nil {
+		c.Error(errs.NewAPIError(http.StatusBadRequest, err))
+		return
+	}
+
+	pgn, err := pagination.NewPagination(c)
+	if err != nil {
+		c.Error(errs.NewAPIError(http.StatusBadRequest, err))
+		return
+	}
 
+	data, total, err := h.Service.ListDebtHistory(ctx, *id, pgn)
+	if err != nil {
+		if errors.Is(err, errs.ErrNotFound) {
+			c.Error(errs.NewAPIError(http.StatusNotFound, err))
+			return
+		}
 		c.Error(errs.NewAPIError(http.StatusInternalServerError, err))
 		return
 	}
 
-	c.JSON(http.StatusNoContent, nil)
+	pgn.SetPaginationHeaders(c, total)
+
+	c.JSON(http.StatusOK, data)
 }
@@ -0,0 +1,87 @@
+package handlers
+
+import (
+	"backend-go/internal/api/errs"
+	"backend-go/internal/api/v1/dto"
+	"backend-go/pkg/utils"
+	"errors"
+	"net/http"
+
+	"github.com/gin-gonic/gin"
+)
+
+// @Summary Listar divisões do débito
+// @Description Retorna as pessoas com quem o débito foi dividido e a parte de cada uma
+// @Tags Débitos
+// @Produce json
+// @Param id path string true "ID do débito"
+// @Success 200 {array} dto.DebtShareResponse
+// @Failure 400 {object} errs.ErrorResponse "ID inválido"
+// @Failure 404 {object} errs.ErrorResponse "Débito não encontrado"
+// @Failure 500 {object} errs.ErrorResponse "Erro interno"
+// @Router /debts/{id}/shares [get]
+func (h *DebtHandler) ListDebtSharesHandler(c *gin.Context) {
+	ctx := c.Request.Context()
+	id, err := utils.ToUUIDPointer(c.Param("id"))
+	if err != nil || id == nil {
+		c.Error(errs.NewAPIError(http.StatusBadRequest, err))
+		return
+	}
+
+	data, err := h.Service.ListDebtShares(ctx, *id)
+	if err != nil {
+		if errors.Is(err, errs.ErrNotFound) {
+			c.Error(errs.NewAPIError(http.StatusNotFound, err))
+			return
+		}
+		c.Error(errs.NewAPIError(http.StatusInternalServerError, err))
+		return
+	}
+
+	c.JSON(http.StatusOK, data)
+}
+
+// @Summary Dividir débito
+// @Description Substitui as divisões do débito. Cada divisão informa o valor ou o percentual da pessoa, e a soma deve ser igual ao valor do débito. Envie uma lista vazia para remover a divisão.
+// @Tags Débitos
+// @Accept json
+// @Produce json
+// @Param id path string true "ID do débito"
+// @Param shares body dto.DebtSharesRequest true "Divisões do débito"
+// @Success 200 {array} dto.DebtShareResponse
+// @Failure 400 {object} errs.ErrorResponse "Requisição inválida"
+// @Failure 404 {object} errs.ErrorResponse "Débito não encontrado"
+// @Failure 422 {object} errs.ErrorResponse "Pessoa não encontrada ou soma diferente do valor do débito"
+// @Failure 500 {object} errs.ErrorResponse "Erro interno"
+// @Router /debts/{id}/shares [put]
+func (h *DebtHandler) ReplaceDebtSharesHandler(c *gin.Context) {
+	ctx := c.Request.Context()
+	id, err := utils.ToUUIDPointer(c.Param("id"))
+	if err != nil || id == nil {
+		c.Error(errs.NewAPIError(http.StatusBadRequest, err))
+		return
+	}
+
+	var req dto.DebtSharesRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		c.Error(errs.NewAPIError(http.StatusBadRequest, err))
+		return
+	}
+
+	data, err := h.Service.ReplaceDebtShares(ctx, *id, req.Shares)
+	if err != nil {
+		switch {
+		case errors.Is(err, errs.ErrNotFound):
+			c.Error(errs.NewAPIError(http.StatusNotFound, err))
+		case errors.Is(err, errs.ErrUnprocessable):
+			c.Error(errs.NewAPIError(http.StatusUnprocessableEntity, err))
+		case errors.Is(err, errs.ErrBadRequest):
+			c.Error(errs.NewAPIError(http.StatusBadRequest, err))
+		default:
+			c.Error(errs.NewAPIError(http.StatusInternalServerError, err))
+		}
+		return
+	}
+
+	c.JSON(http.StatusOK, data)
+}
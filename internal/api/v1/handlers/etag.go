@@ -0,0 +1,45 @@
+package handlers
+
+import (
+	"backend-go/internal/api/errs"
+	"backend-go/pkg/etag"
+	"context"
+	"net/http"
+	"strings"
+
+	"github.com/gin-gonic/gin"
+)
+
+// setETag devolve a versão do recurso no cabeçalho ETag
+func setETag(c *gin.Context, version int) {
+	c.Header("ETag", etag.Format(version))
+}
+
+// notModified define o ETag e indica se o If-None-Match corresponde à versão
+// atual, caso em que a resposta deve ser 304 sem corpo
+func notModified(c *gin.Context, version int) bool {
+	setETag(c, version)
+
+	header := c.GetHeader("If-None-Match")
+	return header != "" && etag.MatchWeak(header, version)
+}
+
+func hasIfMatch(c *gin.Context) bool {
+	return c.GetHeader("If-Match") != ""
+}
+
+// ifMatch compara o If-Match com a versão atual do recurso. Retorna 412 se
+// a versão é outra e um contexto que faz a alteração falhar caso o registro
+// mude entre a leitura e a gravação.
+func ifMatch(c *gin.Context, version int) (context.Context, error) {
+	ctx := c.Request.Context()
+
+	header := c.GetHeader("If-Match")
+	if header == "" || strings.TrimSpace(header) == "*" {
+		return ctx, nil
+	}
+	if !etag.Match(header, version) {
+		return nil, errs.NewAPIError(http.StatusPreconditionFailed, errs.ErrPreconditionFailed)
+	}
+	return etag.WithExpectedVersion(ctx, version), nil
+}
@@ -0,0 +1,35 @@
+package handlers
+
+import (
+	"backend-go/internal/api/v1/dto"
+	"backend-go/internal/api/v1/services"
+	"net/http"
+
+	"github.com/gin-gonic/gin"
+)
+
+type HealthHandler struct {
+	Service *services.HealthService
+}
+
+func NewHealthHandler(service *services.HealthService) *HealthHandler {
+	return &HealthHandler{Service: service}
+}
+
+// LivenessHandler indica apenas que o processo está de pé, sem consultar
+// dependências, para que uma falha no banco não reinicie a API
+func (h *HealthHandler) LivenessHandler(c *gin.Context) {
+	c.JSON(http.StatusOK, dto.HealthResponse{Status: services.HealthUp})
+}
+
+// ReadinessHandler responde 503 se o banco ou a fila não responderem, ou
+// durante o encerramento
+func (h *HealthHandler) ReadinessHandler(c *gin.Context) {
+	resp := h.Service.Readiness(c.Request.Context())
+
+	status := http.StatusOK
+	if resp.Status != services.HealthUp {
+		status = http.StatusServiceUnavailable
+	}
+	c.JSON(status, resp)
+}
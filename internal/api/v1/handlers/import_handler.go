@@ -0,0 +1,121 @@
+package handlers
+
+import (
+	"backend-go/internal/api/errs"
+	"backend-go/internal/api/v1/dto"
+	"backend-go/internal/api/v1/services"
+	"errors"
+	"net/http"
+
+	"github.com/gin-gonic/gin"
+)
+
+type ImportHandler struct {
+	Service *services.ImportService
+}
+
+func NewImportHandler(service *services.ImportService) *ImportHandler {
+	return &ImportHandler{Service: service}
+}
+
+// @Summary Pré-visualizar importação de extrato
+// @Description Processa o arquivo CSV (até 5 MB e 1000 linhas) sem salvar nada e retorna os débitos propostos, com categoria, fatura e avisos. A pré-visualização fica na memória da instância que a criou.
+// @Tags Importação
+// @Accept multipart/form-data
+// @Produce json
+// @Param file formData file true "Arquivo CSV com as colunas title, amount, purchase_date, due_date, invoice_id (opcional) e kind (opcional)"
+// @Param invoice_id formData string false "Fatura usada nas linhas sem invoice_id"
+// @Success 200 {object} dto.ImportPreviewResponse
+// @Failure 400 {object} errs.ErrorResponse "Arquivo inválido"
+// @Failure 413 {object} errs.ErrorResponse "Arquivo muito grande ou com linhas demais"
+// @Failure 429 {object} errs.ErrorResponse "Muitas importações em andamento"
+// @Failure 500 {object} errs.ErrorResponse "Erro interno"
+// @Router /imports/preview [post]
+func (h *ImportHandler) PreviewImportHandler(c *gin.Context) {
+	ctx := c.Request.Context()
+
+	// A folga cobre os cabeçalhos do multipart
+	c.Request.Body = http.MaxBytesReader(c.Writer, c.Request.Body, services.MaxImportFileSize+1<<20)
+
+	file, header, err := c.Request.FormFile("file")
+	if err != nil {
+		var maxErr *http.MaxBytesError
+		if errors.As(err, &maxErr) {
+			c.Error(errs.NewAPIError(http.StatusRequestEntityTooLarge, errs.InvalidParam("file", errs.ErrPayloadTooLarge)))
+			return
+		}
+		c.Error(errs.NewAPIError(http.StatusBadRequest, errs.InvalidParam("file", err)))
+		return
+	}
+	defer file.Close()
+
+	if header.Size > services.MaxImportFileSize {
+		c.Error(errs.NewAPIError(http.StatusRequestEntityTooLarge, errs.InvalidParam("file", errs.ErrPayloadTooLarge)))
+		return
+	}
+
+	reqs, err := h.Service.ReadCSV(file, c.PostForm("invoice_id"))
+	if err != nil {
+		if errors.Is(err, errs.ErrPayloadTooLarge) {
+			c.Error(errs.NewAPIError(http.StatusRequestEntityTooLarge, err))
+			return
+		}
+		c.Error(errs.NewAPIError(http.StatusBadRequest, err))
+		return
+	}
+
+	data, err := h.Service.PreviewImport(ctx, reqs)
+	if err != nil {
+		if errors.Is(err, errs.ErrTooManyRequests) {
+			c.Error(errs.NewAPIError(http.StatusTooManyRequests, err))
+			return
+		}
+		c.Error(errs.NewAPIError(http.StatusInternalServerError, err))
+		return
+	}
+
+	c.JSON(http.StatusOK, data)
+}
+
+// @Summary Confirmar importação de extrato
+// @Description Cria exatamente os débitos aprovados na pré-visualização identificada pelo token
+// @Tags Importação
+// @Accept json
+// @Produce json
+// @Param import body dto.ImportCommitRequest true "Token da pré-visualização"
+// @Success 201 {object} dto.ImportResponse
+// @Failure 400 {object} errs.ErrorResponse "Requisição inválida"
+// @Failure 404 {object} errs.ErrorResponse "Pré-visualização não encontrada ou expirada"
+// @Failure 422 {object} errs.ErrorResponse "Débito inválido, como estornos acima do valor original"
+// @Failure 500 {object} errs.ErrorResponse "Erro interno"
+// @Router /imports [post]
+func (h *ImportHandler) CommitImportHandler(c *gin.Context) {
+	ctx := c.Request.Context()
+	var req dto.ImportCommitRequest
+
+	if err := c.ShouldBindJSON(&req); err != nil {
+		c.Error(errs.NewAPIError(http.StatusBadRequest, err))
+		return
+	}
+
+	if req.PreviewToken == "" {
+		c.Error(errs.NewAPIError(http.StatusBadRequest, errs.InvalidParam("preview_token", errs.ErrBadRequest)))
+		return
+	}
+
+	data, err := h.Service.CommitImport(ctx, req.PreviewToken)
+	if err != nil {
+		if errors.Is(err, errs.ErrNotFound) {
+			c.Error(errs.NewAPIError(http.StatusNotFound, err))
+			return
+		}
+		if errors.Is(err, errs.ErrUnprocessable) {
+			c.Error(errs.NewAPIError(http.StatusUnprocessableEntity, err))
+			return
+		}
+		c.Error(errs.NewAPIError(http.StatusInternalServerError, err))
+		return
+	}
+
+	c.JSON(http.StatusCreated, data)
+}
@@ -4,6 +4,7 @@ import (
 	"backend-go/internal/api/errs"
 	"backend-go/internal/api/v1/dto"
 	"backend-go/internal/api/v1/services"
+	"backend-go/pkg/filter"
 	"backend-go/pkg/pagination"
 	"backend-go/pkg/utils"
 	"errors"
@@ -47,6 +48,10 @@ func (h *InvoiceHandler) CreateInvoiceHandler(c *gin.Context) {
 
 	data, err := h.Service.CreateInvoice(ctx, input)
 	if err != nil {
+		if errors.Is(err, errs.ErrUnprocessable) {
+			c.Error(errs.NewAPIError(http.StatusUnprocessableEntity, err))
+			return
+		}
 		c.Error(errs.NewAPIError(http.StatusBadRequest, err))
 		return
 	}
@@ -64,6 +69,9 @@ func (h *InvoiceHandler) CreateInvoiceHandler(c *gin.Context) {
 // @Failure 400 {object} errs.ErrorResponse "ID inválido"
 // @Failure 404 {object} errs.ErrorResponse "Registro não encontrado"
 // @Failure 500 {object} errs.ErrorResponse "Erro interno"
+// @Param If-None-Match header string false "ETag de uma leitura anterior"
+// @Header 200 {string} ETag "Versão do registro"
+// @Success 304 "Registro não foi alterado"
 // @Router /invoices/{id} [get]
 func (h *InvoiceHandler) GetInvoiceByIDHandler(c *gin.Context) {
 	ctx := c.Request.Context()
@@ -83,6 +91,11 @@ func (h *InvoiceHandler) GetInvoiceByIDHandler(c *gin.Context) {
 		return
 	}
 
+	if notModified(c, data.Version) {
+		c.Status(http.StatusNotModified)
+		return
+	}
+
 	c.JSON(http.StatusOK, data)
 }
 
@@ -97,9 +110,13 @@ func (h *InvoiceHandler) GetInvoiceByIDHandler(c *gin.Context) {
 // @Param max_amount query number false "Valor máximo da fatura"
 // @Param start_date query string false "Data inicial para filtrar (YYYY-MM-DD)"
 // @Param end_date query string false "Data final para filtrar (YYYY-MM-DD)"
+// @Param search query string false "Busca textual, sem diferenciar acentos, no título e no nome do status. Sem order_by, ordena pela relevância"
+// @Param filter query string false "Filtros no formato filter[campo][operador]=valor (ex: filter[due_date][lt]=2026-01-01, filter[status_id][null]=true). Operadores: eq, ne, gt, gte, lt, lte, in, nin, contains, ncontains e null"
 // @Param page query integer false "Número da página"
 // @Param page_size query integer false "Tamanho da página"
-// @Param order_by query string false "Campo de ordenação (ex: title, amount)"
+// @Param order_by query string false "Campos de ordenação separados por vírgula; o prefixo - ordena de forma decrescente (ex: -amount,title). Aceita também status"
+// @Param cursor query string false "Cursor da próxima página; vazio inicia a paginação por cursor"
+// @Param total query bool false "Contar o total de registros"
 // @Success 200 {array} dto.InvoiceResponse "Lista de faturas"
 // @Failure 400 {object} errs.ErrorResponse "Parâmetros inválidos"
 // @Failure 500 {object} errs.ErrorResponse "Erro interno"
@@ -128,14 +145,32 @@ func (h *InvoiceHandler) ListInvoicesHandler(c *gin.Context) {
 		"status_id":  true,
 		"created_at": true,
 		"updated_at": true,
+		"status":     true,
 	}
 
-	if err := pgn.ValidateOrderBy("issue_date", validColumns); err != nil {
+	if err := pgn.ValidateOrderBy("-issue_date", validColumns); err != nil {
 		c.Error(errs.NewAPIError(http.StatusBadRequest, err))
 		return
 	}
 
-	response, total, err := h.Service.ListInvoices(ctx,flt, pgn)
+	validFilters := filter.Fields{
+		"id":         filter.UUID,
+		"title":      filter.String,
+		"amount":     filter.Number,
+		"issue_date": filter.Date,
+		"due_date":   filter.Date,
+		"status_id":  filter.UUID,
+		"created_at": filter.Date,
+		"updated_at": filter.Date,
+	}
+
+	conds, err := filter.Parse(c.Request.URL.Query(), validFilters)
+	if err != nil {
+		c.Error(errs.NewAPIError(http.StatusBadRequest, err))
+		return
+	}
+
+	response, total, err := h.Service.ListInvoices(ctx, flt, conds, pgn)
 
 	if err != nil {
 		c.Error(errs.NewAPIError(http.StatusInternalServerError, err))
@@ -158,6 +193,8 @@ func (h *InvoiceHandler) ListInvoicesHandler(c *gin.Context) {
 // @Failure 400 {object} errs.ErrorResponse "Requisição inválida ou ID inválido"
 // @Failure 404 {object} errs.ErrorResponse "Registro não encontrado"
 // @Failure 500 {object} errs.ErrorResponse "Erro interno"
+// @Param If-Match header string false "ETag da versão que está sendo alterada"
+// @Failure 412 {object} errs.ErrorResponse "Registro alterado por outra requisição"
 // @Router /invoices/{id} [put]
 func (h *InvoiceHandler) UpdateInvoiceHandler(c *gin.Context) {
 	ctx := c.Request.Context()
@@ -167,6 +204,22 @@ func (h *InvoiceHandler) UpdateInvoiceHandler(c *gin.Context) {
 		return
 	}
 
+	current, err := h.Service.GetInvoiceByID(ctx, *id)
+	if err != nil {
+		if errors.Is(err, errs.ErrNotFound) {
+			c.Error(errs.NewAPIError(http.StatusNotFound, err))
+			return
+		}
+		c.Error(errs.NewAPIError(http.StatusInternalServerError, err))
+		return
+	}
+
+	ctx, err = ifMatch(c, current.Version)
+	if err != nil {
+		c.Error(err)
+		return
+	}
+
 	var req dto.InvoiceRequest
 	if err := c.ShouldBindJSON(&req); err != nil {
 		c.Error(errs.NewAPIError(http.StatusBadRequest, err))
@@ -178,18 +231,108 @@ func (h *InvoiceHandler) UpdateInvoiceHandler(c *gin.Context) {
 		c.Error(errs.NewAPIError(http.StatusBadRequest, err))
 		return
 	}
+	input.ID = *id
 
 	data, err := h.Service.UpdateInvoice(ctx, input)
 	if err != nil {
+		switch {
+		case errors.Is(err, errs.ErrNotFound):
+			c.Error(errs.NewAPIError(http.StatusNotFound, err))
+		case errors.Is(err, errs.ErrPreconditionFailed):
+			c.Error(errs.NewAPIError(http.StatusPreconditionFailed, err))
+		case errors.Is(err, errs.ErrUnprocessable):
+			c.Error(errs.NewAPIError(http.StatusUnprocessableEntity, err))
+		default:
+			c.Error(errs.NewAPIError(http.StatusInternalServerError, err))
+		}
+		return
+	}
+
+	setETag(c, data.Version)
+	c.JSON(http.StatusOK, data)
+}
+
+// @Summary Atualizar parte de uma fatura
+// @Description Aplica um JSON Merge Patch (RFC 7386) à fatura: campos omitidos são mantidos e campos com null são limpos
+// @Tags Faturas
+// @Accept json
+// @Accept application/merge-patch+json
+// @Produce json
+// @Param id path string true "ID da fatura"
+// @Param invoice body dto.InvoiceRequest true "Campos alterados da fatura"
+// @Success 200 {object} dto.InvoiceResponse
+// @Failure 400 {object} errs.ErrorResponse "Patch inválido ou ID inválido"
+// @Failure 404 {object} errs.ErrorResponse "Registro não encontrado"
+// @Failure 415 {object} errs.ErrorResponse "Tipo de conteúdo não suportado"
+// @Failure 422 {object} errs.ErrorResponse "Status não encontrado"
+// @Failure 500 {object} errs.ErrorResponse "Erro interno"
+// @Param If-Match header string false "ETag da versão que está sendo alterada"
+// @Failure 412 {object} errs.ErrorResponse "Registro alterado por outra requisição"
+// @Router /invoices/{id} [patch]
+func (h *InvoiceHandler) PatchInvoiceHandler(c *gin.Context) {
+	ctx := c.Request.Context()
+	id, err := utils.ToUUIDPointer(c.Param("id"))
+	if err != nil || id == nil {
+		c.Error(errs.NewAPIError(http.StatusBadRequest, err))
+		return
+	}
+
+	patch, err := readMergePatch(c)
+	if err != nil {
+		c.Error(err)
+		return
+	}
+
+	current, err := h.Service.GetInvoiceByID(ctx, *id)
+	if err != nil {
+		if errors.Is(err, errs.ErrNotFound) {
+			c.Error(errs.NewAPIError(http.StatusNotFound, err))
+			return
+		}
 		c.Error(errs.NewAPIError(http.StatusInternalServerError, err))
 		return
 	}
 
+	ctx, err = ifMatch(c, current.Version)
+	if err != nil {
+		c.Error(err)
+		return
+	}
+
+	req, err := h.Service.ApplyInvoicePatch(*current, patch)
+	if err != nil {
+		c.Error(errs.NewAPIError(http.StatusBadRequest, err))
+		return
+	}
+
+	input, err := h.Service.ParseInvoice(req)
+	if err != nil {
+		c.Error(errs.NewAPIError(http.StatusBadRequest, err))
+		return
+	}
+	input.ID = *id
+
+	data, err := h.Service.UpdateInvoice(ctx, input)
+	if err != nil {
+		switch {
+		case errors.Is(err, errs.ErrNotFound):
+			c.Error(errs.NewAPIError(http.StatusNotFound, err))
+		case errors.Is(err, errs.ErrPreconditionFailed):
+			c.Error(errs.NewAPIError(http.StatusPreconditionFailed, err))
+		case errors.Is(err, errs.ErrUnprocessable):
+			c.Error(errs.NewAPIError(http.StatusUnprocessableEntity, err))
+		default:
+			c.Error(errs.NewAPIError(http.StatusInternalServerError, err))
+		}
+		return
+	}
+
+	setETag(c, data.Version)
 	c.JSON(http.StatusOK, data)
 }
 
 // @Summary Deletar uma fatura
-// @Description Remove uma fatura pelo ID fornecido
+// @Description Move a fatura para a lixeira. Faturas com débitos fora da lixeira não podem ser removidas
 // @Tags Faturas
 // @Accept json
 // @Produce json
@@ -197,7 +340,10 @@ func (h *InvoiceHandler) UpdateInvoiceHandler(c *gin.Context) {
 // @Success 204 "Registro deletado com sucesso"
 // @Failure 400 {object} errs.ErrorResponse "ID inválido"
 // @Failure 404 {object} errs.ErrorResponse "Registro não encontrado"
+// @Failure 409 {object} errs.ErrorResponse "Fatura possui débitos"
 // @Failure 500 {object} errs.ErrorResponse "Erro interno"
+// @Param If-Match header string false "ETag da versão que está sendo alterada"
+// @Failure 412 {object} errs.ErrorResponse "Registro alterado por outra requisição"
 // @Router /invoices/{id} [delete]
 func (h *InvoiceHandler) DeleteInvoiceHandler(c *gin.Context) {
 	ctx := c.Request.Context()
@@ -207,11 +353,99 @@ func (h *InvoiceHandler) DeleteInvoiceHandler(c *gin.Context) {
 		return
 	}
 
-	err = h.Service.DeleteInvoiceByID(ctx, *id)
+	if hasIfMatch(c) {
+		current, err := h.Service.GetInvoiceByID(ctx, *id)
+		if err != nil {
+			if errors.Is(err, errs.ErrNotFound) {
+				c.Error(errs.NewAPIError(http.StatusNotFound, err))
+				return
+			}
+			c.Error(errs.NewAPIError(http.StatusInternalServerError, err))
+			return
+		}
+
+		if ctx, err = ifMatch(c, current.Version); err != nil {
+			c.Error(err)
+			return
+		}
+	}
+
+	if err := h.Service.DeleteInvoiceByID(ctx, *id); err != nil {
+		switch {
+		case errors.Is(err, errs.ErrNotFound):
+			c.Error(errs.NewAPIError(http.StatusNotFound, err))
+		case errors.Is(err, errs.ErrPreconditionFailed):
+			c.Error(errs.NewAPIError(http.StatusPreconditionFailed, err))
+		case errors.Is(err, errs.ErrConflict):
+			c.Error(errs.NewAPIError(http.StatusConflict, err))
+		default:
+			c.Error(errs.NewAPIError(http.StatusInternalServerError, err))
+		}
+		return
+	}
+
+	c.JSON(http.StatusNoContent, nil)
+}
+
+// @Summary Listar faturas da lixeira
+// @Description Retorna as faturas removidas, da remoção mais recente para a mais antiga
+// @Tags Faturas
+// @Produce json
+// @Param page query int false "Número da página"
+// @Param page_size query int false "Tamanho da página"
+// @Param cursor query string false "Cursor da próxima página; vazio inicia a paginação por cursor"
+// @Param total query bool false "Contar o total de registros"
+// @Success 200 {array} dto.InvoiceResponse
+// @Failure 400 {object} errs.ErrorResponse "Parâmetros inválidos"
+// @Failure 500 {object} errs.ErrorResponse "Erro interno"
+// @Router /invoices/trash [get]
+func (h *InvoiceHandler) ListDeletedInvoicesHandler(c *gin.Context) {
+	ctx := c.Request.Context()
+
+	pgn, err := newTrashPagination(c)
+	if err != nil {
+		c.Error(errs.NewAPIError(http.StatusBadRequest, err))
+		return
+	}
+
+	data, total, err := h.Service.ListDeletedInvoices(ctx, pgn)
 	if err != nil {
 		c.Error(errs.NewAPIError(http.StatusInternalServerError, err))
 		return
 	}
 
-	c.JSON(http.StatusNoContent, nil)
+	pgn.SetPaginationHeaders(c, total)
+
+	c.JSON(http.StatusOK, data)
+}
+
+// @Summary Restaurar uma fatura
+// @Description Tira a fatura da lixeira
+// @Tags Faturas
+// @Produce json
+// @Param id path string true "ID da fatura"
+// @Success 200 {object} dto.InvoiceResponse
+// @Failure 400 {object} errs.ErrorResponse "ID inválido"
+// @Failure 404 {object} errs.ErrorResponse "Registro não encontrado na lixeira"
+// @Failure 500 {object} errs.ErrorResponse "Erro interno"
+// @Router /invoices/{id}/restore [post]
+func (h *InvoiceHandler) RestoreInvoiceHandler(c *gin.Context) {
+	ctx := c.Request.Context()
+	id, err := utils.ToUUIDPointer(c.Param("id"))
+	if err != nil || id == nil {
+		c.Error(errs.NewAPIError(http.StatusBadRequest, err))
+		return
+	}
+
+	data, err := h.Service.RestoreInvoiceByID(ctx, *id)
+	if err != nil {
+		if errors.Is(err, errs.ErrNotFound) {
+			c.Error(errs.NewAPIError(http.StatusNotFound, err))
+			return
+		}
+		c.Error(errs.NewAPIError(http.StatusInternalServerError, err))
+		return
+	}
+
+	c.JSON(http.StatusOK, data)
 }
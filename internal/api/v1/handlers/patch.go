@@ -0,0 +1,28 @@
+package handlers
+
+import (
+	"backend-go/internal/api/errs"
+	"backend-go/pkg/mergepatch"
+	"fmt"
+	"io"
+	"net/http"
+
+	"github.com/gin-gonic/gin"
+)
+
+// readMergePatch lê o corpo de uma requisição PATCH. Aceita
+// application/merge-patch+json e, por conveniência, application/json.
+func readMergePatch(c *gin.Context) ([]byte, error) {
+	switch c.ContentType() {
+	case mergepatch.ContentType, "application/json":
+	default:
+		return nil, errs.NewAPIError(http.StatusUnsupportedMediaType,
+			fmt.Errorf("%w: use %s", errs.ErrUnsupportedMedia, mergepatch.ContentType))
+	}
+
+	patch, err := io.ReadAll(c.Request.Body)
+	if err != nil {
+		return nil, errs.NewAPIError(http.StatusBadRequest, err)
+	}
+	return patch, nil
+}
@@ -2,7 +2,6 @@ package handlers
 
 import (
 	"backend-go/internal/api/errs"
-	"backend-go/internal/api/v1/dto"
 	"backend-go/internal/api/v1/services"
 	"backend-go/pkg/pagination"
 	"backend-go/pkg/utils"
@@ -20,30 +19,6 @@ func NewPaymentStatusHandler(service *services.PaymentStatusService) *PaymentSta
 	return &PaymentStatusHandler{Service: service}
 }
 
-func (h *PaymentStatusHandler) CreatePaymentStatusHandler(c *gin.Context) {
-	ctx := c.Request.Context()
-	var req dto.PaymentStatusRequest
-
-	if err := c.ShouldBindJSON(&req); err != nil {
-		c.Error(errs.NewAPIError(http.StatusBadRequest, err))
-		return
-	}
-
-	input, err := h.Service.ParsePaymentStatus(req)
-	if err != nil {
-		c.Error(errs.NewAPIError(http.StatusBadRequest, err))
-		return
-	}
-
-	data, err := h.Service.CreatePaymentStatus(ctx, input)
-	if err != nil {
-		c.Error(errs.NewAPIError(http.StatusBadRequest, err))
-		return
-	}
-
-	c.JSON(http.StatusCreated, data)
-}
-
 func (h *PaymentStatusHandler) GetPaymentStatusByIDHandler(c *gin.Context) {
 	ctx := c.Request.Context()
 	id, err := utils.ToUUIDPointer(c.Param("id"))
@@ -62,6 +37,11 @@ func (h *PaymentStatusHandler) GetPaymentStatusByIDHandler(c *gin.Context) {
 		return
 	}
 
+	if notModified(c, data.Version) {
+		c.Status(http.StatusNotModified)
+		return
+	}
+
 	c.JSON(http.StatusOK, data)
 }
 
@@ -96,49 +76,3 @@ func (h *PaymentStatusHandler) ListPaymentStatussHandler(c *gin.Context) {
 
 	c.JSON(http.StatusOK, response)
 }
-
-func (h *PaymentStatusHandler) UpdatePaymentStatusHandler(c *gin.Context) {
-	ctx := c.Request.Context()
-	id, err := utils.ToUUIDPointer(c.Param("id"))
-	if err != nil || id == nil {
-		c.Error(errs.NewAPIError(http.StatusBadRequest, err))
-		return
-	}
-
-	var req dto.PaymentStatusRequest
-	if err := c.ShouldBindJSON(&req); err != nil {
-		c.Error(errs.NewAPIError(http.StatusBadRequest, err))
-		return
-	}
-
-	input, err := h.Service.ParsePaymentStatus(req)
-	if err != nil {
-		c.Error(errs.NewAPIError(http.StatusBadRequest, err))
-		return
-	}
-
-	data, err := h.Service.UpdatePaymentStatus(ctx, input)
-	if err != nil {
-		c.Error(errs.NewAPIError(http.StatusInternalServerError, err))
-		return
-	}
-
-	c.JSON(http.StatusOK, data)
-}
-
-func (h *PaymentStatusHandler) DeletePaymentStatusHandler(c *gin.Context) {
-	ctx := c.Request.Context()
-	id, err := utils.ToUUIDPointer(c.Param("id"))
-	if err != nil || id == nil {
-		c.Error(errs.NewAPIError(http.StatusBadRequest, err))
-		return
-	}
-
-	err = h.Service.DeletePaymentStatusByID(ctx, *id)
-	if err != nil {
-		c.Error(errs.NewAPIError(http.StatusInternalServerError, err))
-		return
-	}
-
-	c.JSON(http.StatusNoContent, nil)
-}
@@ -0,0 +1,427 @@
+package handlers
+
+import (
+	"backend-go/internal/api/errs"
+	"backend-go/internal/api/v1/dto"
+	"backend-go/internal/api/v1/services"
+	"backend-go/pkg/pagination"
+	"backend-go/pkg/utils"
+	"errors"
+	"net/http"
+
+	"github.com/gin-gonic/gin"
+)
+
+type PersonHandler struct {
+	Service *services.PersonService
+}
+
+func NewPersonHandler(service *services.PersonService) *PersonHandler {
+	return &PersonHandler{Service: service}
+}
+
+// @Summary Cadastrar pessoa
+// @Description Cadastra uma pessoa com quem os débitos podem ser divididos
+// @Tags Pessoas
+// @Accept json
+// @Produce json
+// @Param person body dto.PersonRequest true "Dados da pessoa"
+// @Success 201 {object} dto.PersonResponse
+// @Failure 400 {object} errs.ErrorResponse "Requisição inválida"
+// @Failure 500 {object} errs.ErrorResponse "Erro interno"
+// @Router /people [post]
+func (h *PersonHandler) CreatePersonHandler(c *gin.Context) {
+	ctx := c.Request.Context()
+	var req dto.PersonRequest
+
+	if err := c.ShouldBindJSON(&req); err != nil {
+		c.Error(errs.NewAPIError(http.StatusBadRequest, err))
+		return
+	}
+
+	input, err := h.Service.ParsePerson(req)
+	if err != nil {
+		c.Error(errs.NewAPIError(http.StatusBadRequest, err))
+		return
+	}
+
+	data, err := h.Service.CreatePerson(ctx, input)
+	if err != nil {
+		c.Error(errs.NewAPIError(http.StatusInternalServerError, err))
+		return
+	}
+
+	c.JSON(http.StatusCreated, data)
+}
+
+// @Summary Buscar pessoa por ID
+// @Tags Pessoas
+// @Produce json
+// @Param id path string true "ID da pessoa"
+// @Success 200 {object} dto.PersonResponse
+// @Failure 400 {object} errs.ErrorResponse "ID inválido"
+// @Failure 404 {object} errs.ErrorResponse "Registro não encontrado"
+// @Failure 500 {object} errs.ErrorResponse "Erro interno"
+// @Param If-None-Match header string false "ETag de uma leitura anterior"
+// @Header 200 {string} ETag "Versão do registro"
+// @Success 304 "Registro não foi alterado"
+// @Router /people/{id} [get]
+func (h *PersonHandler) GetPersonByIDHandler(c *gin.Context) {
+	ctx := c.Request.Context()
+	id, err := utils.ToUUIDPointer(c.Param("id"))
+	if err != nil || id == nil {
+		c.Error(errs.NewAPIError(http.StatusBadRequest, err))
+		return
+	}
+
+	data, err := h.Service.GetPersonByID(ctx, *id)
+	if err != nil {
+		if errors.Is(err, errs.ErrNotFound) {
+			c.Error(errs.NewAPIError(http.StatusNotFound, err))
+			return
+		}
+		c.Error(errs.NewAPIError(http.StatusInternalServerError, err))
+		return
+	}
+
+	if notModified(c, data.Version) {
+		c.Status(http.StatusNotModified)
+		return
+	}
+
+	c.JSON(http.StatusOK, data)
+}
+
+// @Summary Saldo da pessoa
+// @Description Mostra quanto a pessoa deve somando as suas partes em todos os débitos, separando o que já foi pago
+// @Tags Pessoas
+// @Produce json
+// @Param id path string true "ID da pessoa"
+// @Success 200 {object} dto.PersonBalanceResponse
+// @Failure 400 {object} errs.ErrorResponse "ID inválido"
+// @Failure 404 {object} errs.ErrorResponse "Registro não encontrado"
+// @Failure 500 {object} errs.ErrorResponse "Erro interno"
+// @Router /people/{id}/balance [get]
+func (h *PersonHandler) GetPersonBalanceHandler(c *gin.Context) {
+	ctx := c.Request.Context()
+	id, err := utils.ToUUIDPointer(c.Param("id"))
+	if err != nil || id == nil {
+		c.Error(errs.NewAPIError(http.StatusBadRequest, err))
+		return
+	}
+
+	data, err := h.Service.GetPersonBalance(ctx, *id)
+	if err != nil {
+		if errors.Is(err, errs.ErrNotFound) {
+			c.Error(errs.NewAPIError(http.StatusNotFound, err))
+			return
+		}
+		c.Error(errs.NewAPIError(http.StatusInternalServerError, err))
+		return
+	}
+
+	c.JSON(http.StatusOK, data)
+}
+
+// @Summary Listar pessoas
+// @Tags Pessoas
+// @Produce json
+// @Param search query string false "Busca por nome ou e-mail"
+// @Param page query int false "Número da página"
+// @Param page_size query int false "Tamanho da página"
+// @Param order_by query string false "Campos de ordenação separados por vírgula; o prefixo - ordena de forma decrescente (ex: -name)"
+// @Param cursor query string false "Cursor da próxima página; vazio inicia a paginação por cursor"
+// @Param total query bool false "Contar o total de registros"
+// @Success 200 {array} dto.PersonResponse
+// @Failure 400 {object} errs.ErrorResponse "Parâmetros inválidos"
+// @Failure 500 {object} errs.ErrorResponse "Erro interno"
+// @Router /people [get]
+func (h *PersonHandler) ListPeopleHandler(c *gin.Context) {
+	ctx := c.Request.Context()
+	pgn, err := pagination.NewPagination(c)
+
+	if err != nil {
+		c.Error(errs.NewAPIError(http.StatusBadRequest, err))
+		return
+	}
+
+	validColumns := map[string]bool{
+		"id":    true,
+		"name":  true,
+		"email": true,
+	}
+
+	if err := pgn.ValidateOrderBy("name", validColumns); err != nil {
+		c.Error(errs.NewAPIError(http.StatusBadRequest, err))
+		return
+	}
+
+	response, total, err := h.Service.ListPeople(ctx, pgn)
+
+	if err != nil {
+		c.Error(errs.NewAPIError(http.StatusInternalServerError, err))
+		return
+	}
+
+	pgn.SetPaginationHeaders(c, total)
+
+	c.JSON(http.StatusOK, response)
+}
+
+// @Summary Atualizar pessoa
+// @Tags Pessoas
+// @Accept json
+// @Produce json
+// @Param id path string true "ID da pessoa"
+// @Param person body dto.PersonRequest true "Dados da pessoa"
+// @Success 200 {object} dto.PersonResponse
+// @Failure 400 {object} errs.ErrorResponse "Requisição inválida ou ID inválido"
+// @Failure 404 {object} errs.ErrorResponse "Registro não encontrado"
+// @Failure 500 {object} errs.ErrorResponse "Erro interno"
+// @Param If-Match header string false "ETag da versão que está sendo alterada"
+// @Failure 412 {object} errs.ErrorResponse "Registro alterado por outra requisição"
+// @Router /people/{id} [put]
+func (h *PersonHandler) UpdatePersonHandler(c *gin.Context) {
+	ctx := c.Request.Context()
+	id, err := utils.ToUUIDPointer(c.Param("id"))
+	if err != nil || id == nil {
+		c.Error(errs.NewAPIError(http.StatusBadRequest, err))
+		return
+	}
+
+	current, err := h.Service.GetPersonByID(ctx, *id)
+	if err != nil {
+		if errors.Is(err, errs.ErrNotFound) {
+			c.Error(errs.NewAPIError(http.StatusNotFound, err))
+			return
+		}
+		c.Error(errs.NewAPIError(http.StatusInternalServerError, err))
+		return
+	}
+
+	ctx, err = ifMatch(c, current.Version)
+	if err != nil {
+		c.Error(err)
+		return
+	}
+
+	var req dto.PersonRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		c.Error(errs.NewAPIError(http.StatusBadRequest, err))
+		return
+	}
+
+	input, err := h.Service.ParsePerson(req)
+	if err != nil {
+		c.Error(errs.NewAPIError(http.StatusBadRequest, err))
+		return
+	}
+	input.ID = *id
+
+	data, err := h.Service.UpdatePerson(ctx, input)
+	if err != nil {
+		switch {
+		case errors.Is(err, errs.ErrNotFound):
+			c.Error(errs.NewAPIError(http.StatusNotFound, err))
+		case errors.Is(err, errs.ErrPreconditionFailed):
+			c.Error(errs.NewAPIError(http.StatusPreconditionFailed, err))
+		default:
+			c.Error(errs.NewAPIError(http.StatusInternalServerError, err))
+		}
+		return
+	}
+
+	setETag(c, data.Version)
+	c.JSON(http.StatusOK, data)
+}
+
+// @Summary Atualizar parte de uma pessoa
+// @Description Aplica um JSON Merge Patch (RFC 7386) à pessoa: campos omitidos são mantidos e email com null é limpo
+// @Tags Pessoas
+// @Accept json
+// @Accept application/merge-patch+json
+// @Produce json
+// @Param id path string true "ID da pessoa"
+// @Param person body dto.PersonRequest true "Campos alterados da pessoa"
+// @Success 200 {object} dto.PersonResponse
+// @Failure 400 {object} errs.ErrorResponse "Patch inválido ou ID inválido"
+// @Failure 404 {object} errs.ErrorResponse "Registro não encontrado"
+// @Failure 415 {object} errs.ErrorResponse "Tipo de conteúdo não suportado"
+// @Failure 500 {object} errs.ErrorResponse "Erro interno"
+// @Param If-Match header string false "ETag da versão que está sendo alterada"
+// @Failure 412 {object} errs.ErrorResponse "Registro alterado por outra requisição"
+// @Router /people/{id} [patch]
+func (h *PersonHandler) PatchPersonHandler(c *gin.Context) {
+	ctx := c.Request.Context()
+	id, err := utils.ToUUIDPointer(c.Param("id"))
+	if err != nil || id == nil {
+		c.Error(errs.NewAPIError(http.StatusBadRequest, err))
+		return
+	}
+
+	patch, err := readMergePatch(c)
+	if err != nil {
+		c.Error(err)
+		return
+	}
+
+	current, err := h.Service.GetPersonByID(ctx, *id)
+	if err != nil {
+		if errors.Is(err, errs.ErrNotFound) {
+			c.Error(errs.NewAPIError(http.StatusNotFound, err))
+			return
+		}
+		c.Error(errs.NewAPIError(http.StatusInternalServerError, err))
+		return
+	}
+
+	ctx, err = ifMatch(c, current.Version)
+	if err != nil {
+		c.Error(err)
+		return
+	}
+
+	req, err := h.Service.ApplyPersonPatch(*current, patch)
+	if err != nil {
+		c.Error(errs.NewAPIError(http.StatusBadRequest, err))
+		return
+	}
+
+	input, err := h.Service.ParsePerson(req)
+	if err != nil {
+		c.Error(errs.NewAPIError(http.StatusBadRequest, err))
+		return
+	}
+	input.ID = *id
+
+	data, err := h.Service.UpdatePerson(ctx, input)
+	if err != nil {
+		switch {
+		case errors.Is(err, errs.ErrNotFound):
+			c.Error(errs.NewAPIError(http.StatusNotFound, err))
+		case errors.Is(err, errs.ErrPreconditionFailed):
+			c.Error(errs.NewAPIError(http.StatusPreconditionFailed, err))
+		default:
+			c.Error(errs.NewAPIError(http.StatusInternalServerError, err))
+		}
+		return
+	}
+
+	setETag(c, data.Version)
+	c.JSON(http.StatusOK, data)
+}
+
+// @Summary Remover pessoa
+// @Description Move para a lixeira uma pessoa que não participa de nenhuma divisão
+// @Tags Pessoas
+// @Param id path string true "ID da pessoa"
+// @Success 204 "Registro deletado com sucesso"
+// @Failure 400 {object} errs.ErrorResponse "ID inválido"
+// @Failure 404 {object} errs.ErrorResponse "Registro não encontrado"
+// @Failure 409 {object} errs.ErrorResponse "Pessoa possui divisões de débitos"
+// @Failure 500 {object} errs.ErrorResponse "Erro interno"
+// @Param If-Match header string false "ETag da versão que está sendo alterada"
+// @Failure 412 {object} errs.ErrorResponse "Registro alterado por outra requisição"
+// @Router /people/{id} [delete]
+func (h *PersonHandler) DeletePersonHandler(c *gin.Context) {
+	ctx := c.Request.Context()
+	id, err := utils.ToUUIDPointer(c.Param("id"))
+	if err != nil || id == nil {
+		c.Error(errs.NewAPIError(http.StatusBadRequest, err))
+		return
+	}
+
+	if hasIfMatch(c) {
+		current, err := h.Service.GetPersonByID(ctx, *id)
+		if err != nil {
+			if errors.Is(err, errs.ErrNotFound) {
+				c.Error(errs.NewAPIError(http.StatusNotFound, err))
+				return
+			}
+			c.Error(errs.NewAPIError(http.StatusInternalServerError, err))
+			return
+		}
+
+		if ctx, err = ifMatch(c, current.Version); err != nil {
+			c.Error(err)
+			return
+		}
+	}
+
+	if err := h.Service.DeletePersonByID(ctx, *id); err != nil {
+		switch {
+		case errors.Is(err, errs.ErrNotFound):
+			c.Error(errs.NewAPIError(http.StatusNotFound, err))
+		case errors.Is(err, errs.ErrPreconditionFailed):
+			c.Error(errs.NewAPIError(http.StatusPreconditionFailed, err))
+		case errors.Is(err, errs.ErrConflict):
+			c.Error(errs.NewAPIError(http.StatusConflict, err))
+		default:
+			c.Error(errs.NewAPIError(http.StatusInternalServerError, err))
+		}
+		return
+	}
+
+	c.JSON(http.StatusNoContent, nil)
+}
+
+// @Summary Listar pessoas da lixeira
+// @Description Retorna as pessoas removidas, da remoção mais recente para a mais antiga
+// @Tags Pessoas
+// @Produce json
+// @Param page query int false "Número da página"
+// @Param page_size query int false "Tamanho da página"
+// @Success 200 {array} dto.PersonResponse
+// @Failure 400 {object} errs.ErrorResponse "Parâmetros inválidos"
+// @Failure 500 {object} errs.ErrorResponse "Erro interno"
+// @Router /people/trash [get]
+func (h *PersonHandler) ListDeletedPeopleHandler(c *gin.Context) {
+	ctx := c.Request.Context()
+
+	pgn, err := newTrashPagination(c)
+	if err != nil {
+		c.Error(errs.NewAPIError(http.StatusBadRequest, err))
+		return
+	}
+
+	data, total, err := h.Service.ListDeletedPeople(ctx, pgn)
+	if err != nil {
+		c.Error(errs.NewAPIError(http.StatusInternalServerError, err))
+		return
+	}
+
+	pgn.SetPaginationHeaders(c, total)
+
+	c.JSON(http.StatusOK, data)
+}
+
+// @Summary Restaurar uma pessoa
+// @Description Tira a pessoa da lixeira
+// @Tags Pessoas
+// @Produce json
+// @Param id path string true "ID da pessoa"
+// @Success 200 {object} dto.PersonResponse
+// @Failure 400 {object} errs.ErrorResponse "ID inválido"
+// @Failure 404 {object} errs.ErrorResponse "Registro não encontrado na lixeira"
+// @Failure 500 {object} errs.ErrorResponse "Erro interno"
+// @Router /people/{id}/restore [post]
+func (h *PersonHandler) RestorePersonHandler(c *gin.Context) {
+	ctx := c.Request.Context()
+	id, err := utils.ToUUIDPointer(c.Param("id"))
+	if err != nil || id == nil {
+		c.Error(errs.NewAPIError(http.StatusBadRequest, err))
+		return
+	}
+
+	data, err := h.Service.RestorePersonByID(ctx, *id)
+	if err != nil {
+		if errors.Is(err, errs.ErrNotFound) {
+			c.Error(errs.NewAPIError(http.StatusNotFound, err))
+			return
+		}
+		c.Error(errs.NewAPIError(http.StatusInternalServerError, err))
+		return
+	}
+
+	c.JSON(http.StatusOK, data)
+}
@@ -0,0 +1,82 @@
+package handlers
+
+import (
+	"backend-go/internal/api/errs"
+	"backend-go/internal/api/v1/dto"
+	"backend-go/internal/api/v1/services"
+	"net/http"
+
+	"github.com/gin-gonic/gin"
+)
+
+type ReportHandler struct {
+	Service *services.ReportService
+}
+
+func NewReportHandler(service *services.ReportService) *ReportHandler {
+	return &ReportHandler{Service: service}
+}
+
+// @Summary Gastos por categoria
+// @Description Soma os débitos do período agrupados por categoria, do maior para o menor total
+// @Tags Relatórios
+// @Produce json
+// @Param start_date query string false "Data de compra inicial (YYYY-MM-DD)"
+// @Param end_date query string false "Data de compra final (YYYY-MM-DD)"
+// @Success 200 {array} dto.ReportItem
+// @Failure 400 {object} errs.ErrorResponse "Parâmetros inválidos"
+// @Failure 500 {object} errs.ErrorResponse "Erro interno"
+// @Router /reports/categories [get]
+func (h *ReportHandler) CategoriesReportHandler(c *gin.Context) {
+	ctx := c.Request.Context()
+	var flt dto.ReportFilters
+	if err := c.ShouldBindQuery(&flt); err != nil {
+		c.Error(errs.NewAPIError(http.StatusBadRequest, err))
+		return
+	}
+
+	if err := h.Service.ValidateFilters(flt); err != nil {
+		c.Error(errs.NewAPIError(http.StatusBadRequest, err))
+		return
+	}
+
+	data, err := h.Service.ReportByCategory(ctx, flt)
+	if err != nil {
+		c.Error(errs.NewAPIError(http.StatusInternalServerError, err))
+		return
+	}
+
+	c.JSON(http.StatusOK, data)
+}
+
+// @Summary Gastos por tag
+// @Description Soma os débitos do período agrupados por tag. Débitos com várias tags entram no total de cada uma.
+// @Tags Relatórios
+// @Produce json
+// @Param start_date query string false "Data de compra inicial (YYYY-MM-DD)"
+// @Param end_date query string false "Data de compra final (YYYY-MM-DD)"
+// @Success 200 {array} dto.ReportItem
+// @Failure 400 {object} errs.ErrorResponse "Parâmetros inválidos"
+// @Failure 500 {object} errs.ErrorResponse "Erro interno"
+// @Router /reports/tags [get]
+func (h *ReportHandler) TagsReportHandler(c *gin.Context) {
+	ctx := c.Request.Context()
+	var flt dto.ReportFilters
+	if err := c.ShouldBindQuery(&flt); err != nil {
+		c.Error(errs.NewAPIError(http.StatusBadRequest, err))
+		return
+	}
+
+	if err := h.Service.ValidateFilters(flt); err != nil {
+		c.Error(errs.NewAPIError(http.StatusBadRequest, err))
+		return
+	}
+
+	data, err := h.Service.ReportByTag(ctx, flt)
+	if err != nil {
+		c.Error(errs.NewAPIError(http.StatusInternalServerError, err))
+		return
+	}
+
+	c.JSON(http.StatusOK, data)
+}
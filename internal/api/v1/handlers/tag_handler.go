@@ -0,0 +1,342 @@
+package handlers
+
+import (
+	"backend-go/internal/api/errs"
+	"backend-go/internal/api/v1/dto"
+	"backend-go/internal/api/v1/services"
+	"backend-go/pkg/pagination"
+	"backend-go/pkg/utils"
+	"errors"
+	"net/http"
+
+	"github.com/gin-gonic/gin"
+)
+
+type TagHandler struct {
+	Service *services.TagService
+}
+
+func NewTagHandler(service *services.TagService) *TagHandler {
+	return &TagHandler{Service: service}
+}
+
+// @Summary Criar tag
+// @Description Cria uma tag no workspace. O nome é salvo em minúsculas.
+// @Tags Tags
+// @Accept json
+// @Produce json
+// @Param tag body dto.TagRequest true "Dados da tag"
+// @Success 201 {object} dto.TagResponse
+// @Failure 400 {object} errs.ErrorResponse "Requisição inválida"
+// @Failure 409 {object} errs.ErrorResponse "Já existe uma tag com esse nome"
+// @Failure 500 {object} errs.ErrorResponse "Erro interno"
+// @Router /tags [post]
+func (h *TagHandler) CreateTagHandler(c *gin.Context) {
+	ctx := c.Request.Context()
+	var req dto.TagRequest
+
+	if err := c.ShouldBindJSON(&req); err != nil {
+		c.Error(errs.NewAPIError(http.StatusBadRequest, err))
+		return
+	}
+
+	input, err := h.Service.ParseTag(req)
+	if err != nil {
+		c.Error(errs.NewAPIError(http.StatusBadRequest, err))
+		return
+	}
+
+	data, err := h.Service.CreateTag(ctx, input)
+	if err != nil {
+		if errors.Is(err, errs.ErrConflict) {
+			c.Error(errs.NewAPIError(http.StatusConflict, err))
+			return
+		}
+		c.Error(errs.NewAPIError(http.StatusInternalServerError, err))
+		return
+	}
+
+	c.JSON(http.StatusCreated, data)
+}
+
+// @Summary Buscar tag por ID
+// @Tags Tags
+// @Produce json
+// @Param id path string true "ID da tag"
+// @Success 200 {object} dto.TagResponse
+// @Failure 400 {object} errs.ErrorResponse "ID inválido"
+// @Failure 404 {object} errs.ErrorResponse "Registro não encontrado"
+// @Failure 500 {object} errs.ErrorResponse "Erro interno"
+// @Param If-None-Match header string false "ETag de uma leitura anterior"
+// @Header 200 {string} ETag "Versão do registro"
+// @Success 304 "Registro não foi alterado"
+// @Router /tags/{id} [get]
+func (h *TagHandler) GetTagByIDHandler(c *gin.Context) {
+	ctx := c.Request.Context()
+	id, err := utils.ToUUIDPointer(c.Param("id"))
+	if err != nil || id == nil {
+		c.Error(errs.NewAPIError(http.StatusBadRequest, err))
+		return
+	}
+
+	data, err := h.Service.GetTagByID(ctx, *id)
+	if err != nil {
+		if errors.Is(err, errs.ErrNotFound) {
+			c.Error(errs.NewAPIError(http.StatusNotFound, err))
+			return
+		}
+		c.Error(errs.NewAPIError(http.StatusInternalServerError, err))
+		return
+	}
+
+	if notModified(c, data.Version) {
+		c.Status(http.StatusNotModified)
+		return
+	}
+
+	c.JSON(http.StatusOK, data)
+}
+
+// @Summary Listar tags
+// @Tags Tags
+// @Produce json
+// @Param search query string false "Busca pelo nome"
+// @Param page query int false "Número da página"
+// @Param page_size query int false "Tamanho da página"
+// @Param order_by query string false "Campos de ordenação separados por vírgula; o prefixo - ordena de forma decrescente (ex: -name)"
+// @Param cursor query string false "Cursor da próxima página; vazio inicia a paginação por cursor"
+// @Param total query bool false "Contar o total de registros"
+// @Success 200 {array} dto.TagResponse
+// @Failure 400 {object} errs.ErrorResponse "Parâmetros inválidos"
+// @Failure 500 {object} errs.ErrorResponse "Erro interno"
+// @Router /tags [get]
+func (h *TagHandler) ListTagsHandler(c *gin.Context) {
+	ctx := c.Request.Context()
+	pgn, err := pagination.NewPagination(c)
+
+	if err != nil {
+		c.Error(errs.NewAPIError(http.StatusBadRequest, err))
+		return
+	}
+
+	validColumns := map[string]bool{
+		"id":   true,
+		"name": true,
+	}
+
+	if err := pgn.ValidateOrderBy("name", validColumns); err != nil {
+		c.Error(errs.NewAPIError(http.StatusBadRequest, err))
+		return
+	}
+
+	response, total, err := h.Service.ListTags(ctx, pgn)
+
+	if err != nil {
+		c.Error(errs.NewAPIError(http.StatusInternalServerError, err))
+		return
+	}
+
+	pgn.SetPaginationHeaders(c, total)
+
+	c.JSON(http.StatusOK, response)
+}
+
+// @Summary Renomear tag
+// @Tags Tags
+// @Accept json
+// @Produce json
+// @Param id path string true "ID da tag"
+// @Param tag body dto.TagRequest true "Dados da tag"
+// @Success 200 {object} dto.TagResponse
+// @Failure 400 {object} errs.ErrorResponse "Requisição inválida ou ID inválido"
+// @Failure 404 {object} errs.ErrorResponse "Registro não encontrado"
+// @Failure 409 {object} errs.ErrorResponse "Já existe uma tag com esse nome"
+// @Failure 500 {object} errs.ErrorResponse "Erro interno"
+// @Param If-Match header string false "ETag da versão que está sendo alterada"
+// @Failure 412 {object} errs.ErrorResponse "Registro alterado por outra requisição"
+// @Router /tags/{id} [put]
+func (h *TagHandler) UpdateTagHandler(c *gin.Context) {
+	ctx := c.Request.Context()
+	id, err := utils.ToUUIDPointer(c.Param("id"))
+	if err != nil || id == nil {
+		c.Error(errs.NewAPIError(http.StatusBadRequest, err))
+		return
+	}
+
+	current, err := h.Service.GetTagByID(ctx, *id)
+	if err != nil {
+		if errors.Is(err, errs.ErrNotFound) {
+			c.Error(errs.NewAPIError(http.StatusNotFound, err))
+			return
+		}
+		c.Error(errs.NewAPIError(http.StatusInternalServerError, err))
+		return
+	}
+
+	ctx, err = ifMatch(c, current.Version)
+	if err != nil {
+		c.Error(err)
+		return
+	}
+
+	var req dto.TagRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		c.Error(errs.NewAPIError(http.StatusBadRequest, err))
+		return
+	}
+
+	input, err := h.Service.ParseTag(req)
+	if err != nil {
+		c.Error(errs.NewAPIError(http.StatusBadRequest, err))
+		return
+	}
+	input.ID = *id
+
+	data, err := h.Service.UpdateTag(ctx, input)
+	if err != nil {
+		switch {
+		case errors.Is(err, errs.ErrNotFound):
+			c.Error(errs.NewAPIError(http.StatusNotFound, err))
+		case errors.Is(err, errs.ErrPreconditionFailed):
+			c.Error(errs.NewAPIError(http.StatusPreconditionFailed, err))
+		case errors.Is(err, errs.ErrConflict):
+			c.Error(errs.NewAPIError(http.StatusConflict, err))
+		default:
+			c.Error(errs.NewAPIError(http.StatusInternalServerError, err))
+		}
+		return
+	}
+
+	setETag(c, data.Version)
+	c.JSON(http.StatusOK, data)
+}
+
+// @Summary Atualizar parte de uma tag
+// @Description Aplica um JSON Merge Patch (RFC 7386) à tag
+// @Tags Tags
+// @Accept json
+// @Accept application/merge-patch+json
+// @Produce json
+// @Param id path string true "ID da tag"
+// @Param tag body dto.TagRequest true "Campos alterados da tag"
+// @Success 200 {object} dto.TagResponse
+// @Failure 400 {object} errs.ErrorResponse "Patch inválido ou ID inválido"
+// @Failure 404 {object} errs.ErrorResponse "Registro não encontrado"
+// @Failure 409 {object} errs.ErrorResponse "Já existe uma tag com esse nome"
+// @Failure 415 {object} errs.ErrorResponse "Tipo de conteúdo não suportado"
+// @Failure 500 {object} errs.ErrorResponse "Erro interno"
+// @Param If-Match header string false "ETag da versão que está sendo alterada"
+// @Failure 412 {object} errs.ErrorResponse "Registro alterado por outra requisição"
+// @Router /tags/{id} [patch]
+func (h *TagHandler) PatchTagHandler(c *gin.Context) {
+	ctx := c.Request.Context()
+	id, err := utils.ToUUIDPointer(c.Param("id"))
+	if err != nil || id == nil {
+		c.Error(errs.NewAPIError(http.StatusBadRequest, err))
+		return
+	}
+
+	patch, err := readMergePatch(c)
+	if err != nil {
+		c.Error(err)
+		return
+	}
+
+	current, err := h.Service.GetTagByID(ctx, *id)
+	if err != nil {
+		if errors.Is(err, errs.ErrNotFound) {
+			c.Error(errs.NewAPIError(http.StatusNotFound, err))
+			return
+		}
+		c.Error(errs.NewAPIError(http.StatusInternalServerError, err))
+		return
+	}
+
+	ctx, err = ifMatch(c, current.Version)
+	if err != nil {
+		c.Error(err)
+		return
+	}
+
+	req, err := h.Service.ApplyTagPatch(*current, patch)
+	if err != nil {
+		c.Error(errs.NewAPIError(http.StatusBadRequest, err))
+		return
+	}
+
+	input, err := h.Service.ParseTag(req)
+	if err != nil {
+		c.Error(errs.NewAPIError(http.StatusBadRequest, err))
+		return
+	}
+	input.ID = *id
+
+	data, err := h.Service.UpdateTag(ctx, input)
+	if err != nil {
+		switch {
+		case errors.Is(err, errs.ErrNotFound):
+			c.Error(errs.NewAPIError(http.StatusNotFound, err))
+		case errors.Is(err, errs.ErrPreconditionFailed):
+			c.Error(errs.NewAPIError(http.StatusPreconditionFailed, err))
+		case errors.Is(err, errs.ErrConflict):
+			c.Error(errs.NewAPIError(http.StatusConflict, err))
+		default:
+			c.Error(errs.NewAPIError(http.StatusInternalServerError, err))
+		}
+		return
+	}
+
+	setETag(c, data.Version)
+	c.JSON(http.StatusOK, data)
+}
+
+// @Summary Remover tag
+// @Description Remove a tag e a retira de todos os débitos
+// @Tags Tags
+// @Param id path string true "ID da tag"
+// @Success 204 "Registro deletado com sucesso"
+// @Failure 400 {object} errs.ErrorResponse "ID inválido"
+// @Failure 404 {object} errs.ErrorResponse "Registro não encontrado"
+// @Failure 500 {object} errs.ErrorResponse "Erro interno"
+// @Param If-Match header string false "ETag da versão que está sendo alterada"
+// @Failure 412 {object} errs.ErrorResponse "Registro alterado por outra requisição"
+// @Router /tags/{id} [delete]
+func (h *TagHandler) DeleteTagHandler(c *gin.Context) {
+	ctx := c.Request.Context()
+	id, err := utils.ToUUIDPointer(c.Param("id"))
+	if err != nil || id == nil {
+		c.Error(errs.NewAPIError(http.StatusBadRequest, err))
+		return
+	}
+
+	if hasIfMatch(c) {
+		current, err := h.Service.GetTagByID(ctx, *id)
+		if err != nil {
+			if errors.Is(err, errs.ErrNotFound) {
+				c.Error(errs.NewAPIError(http.StatusNotFound, err))
+				return
+			}
+			c.Error(errs.NewAPIError(http.StatusInternalServerError, err))
+			return
+		}
+
+		if ctx, err = ifMatch(c, current.Version); err != nil {
+			c.Error(err)
+			return
+		}
+	}
+
+	if err := h.Service.DeleteTagByID(ctx, *id); err != nil {
+		switch {
+		case errors.Is(err, errs.ErrNotFound):
+			c.Error(errs.NewAPIError(http.StatusNotFound, err))
+		case errors.Is(err, errs.ErrPreconditionFailed):
+			c.Error(errs.NewAPIError(http.StatusPreconditionFailed, err))
+		default:
+			c.Error(errs.NewAPIError(http.StatusInternalServerError, err))
+		}
+		return
+	}
+
+	c.JSON(http.StatusNoContent, nil)
+}
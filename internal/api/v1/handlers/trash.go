@@ -0,0 +1,21 @@
+package handlers
+
+import (
+	"backend-go/pkg/pagination"
+
+	"github.com/gin-gonic/gin"
+)
+
+// newTrashPagination lê a paginação das listagens da lixeira, que são
+// ordenadas pela data de remoção, da mais recente para a mais antiga
+func newTrashPagination(c *gin.Context) (*pagination.Pagination, error) {
+	pgn, err := pagination.NewPagination(c)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := pgn.ValidateOrderBy("-deleted_at", map[string]bool{"deleted_at": true}); err != nil {
+		return nil, err
+	}
+	return pgn, nil
+}
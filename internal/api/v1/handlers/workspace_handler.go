@@ -0,0 +1,194 @@
+package handlers
+
+import (
+	"backend-go/internal/api/errs"
+	"backend-go/internal/api/v1/dto"
+	"backend-go/internal/api/v1/services"
+	"backend-go/pkg/utils"
+	"errors"
+	"net/http"
+
+	"github.com/gin-gonic/gin"
+)
+
+type WorkspaceHandler struct {
+	Service *services.WorkspaceService
+}
+
+func NewWorkspaceHandler(service *services.WorkspaceService) *WorkspaceHandler {
+	return &WorkspaceHandler{Service: service}
+}
+
+// @Summary Listar workspaces
+// @Description Retorna os workspaces dos quais o usuário autenticado participa e o seu papel em cada um
+// @Tags Workspaces
+// @Produce json
+// @Success 200 {array} dto.WorkspaceResponse
+// @Failure 500 {object} errs.ErrorResponse "Erro interno"
+// @Router /workspaces [get]
+func (h *WorkspaceHandler) ListWorkspacesHandler(c *gin.Context) {
+	ctx := c.Request.Context()
+
+	data, err := h.Service.ListWorkspaces(ctx)
+	if err != nil {
+		c.Error(errs.NewAPIError(http.StatusInternalServerError, err))
+		return
+	}
+
+	c.JSON(http.StatusOK, data)
+}
+
+// @Summary Criar workspace
+// @Description Cria um workspace compartilhado tendo o usuário autenticado como dono
+// @Tags Workspaces
+// @Accept json
+// @Produce json
+// @Param workspace body dto.WorkspaceRequest true "Dados do workspace"
+// @Success 201 {object} dto.WorkspaceResponse
+// @Failure 400 {object} errs.ErrorResponse "Requisição inválida"
+// @Failure 500 {object} errs.ErrorResponse "Erro interno"
+// @Router /workspaces [post]
+func (h *WorkspaceHandler) CreateWorkspaceHandler(c *gin.Context) {
+	ctx := c.Request.Context()
+	var req dto.WorkspaceRequest
+
+	if err := c.ShouldBindJSON(&req); err != nil {
+		c.Error(errs.NewAPIError(http.StatusBadRequest, err))
+		return
+	}
+
+	input, err := h.Service.ParseWorkspace(req)
+	if err != nil {
+		c.Error(errs.NewAPIError(http.StatusBadRequest, err))
+		return
+	}
+
+	data, err := h.Service.CreateWorkspace(ctx, input)
+	if err != nil {
+		c.Error(errs.NewAPIError(http.StatusInternalServerError, err))
+		return
+	}
+
+	c.JSON(http.StatusCreated, data)
+}
+
+// @Summary Listar membros do workspace
+// @Tags Workspaces
+// @Produce json
+// @Param id path string true "ID do workspace"
+// @Success 200 {array} dto.WorkspaceMemberResponse
+// @Failure 400 {object} errs.ErrorResponse "ID inválido"
+// @Failure 403 {object} errs.ErrorResponse "Usuário não participa do workspace"
+// @Failure 500 {object} errs.ErrorResponse "Erro interno"
+// @Router /workspaces/{id}/members [get]
+func (h *WorkspaceHandler) ListMembersHandler(c *gin.Context) {
+	ctx := c.Request.Context()
+	id, err := utils.ToUUIDPointer(c.Param("id"))
+	if err != nil || id == nil {
+		c.Error(errs.NewAPIError(http.StatusBadRequest, err))
+		return
+	}
+
+	data, err := h.Service.ListMembers(ctx, *id)
+	if err != nil {
+		if errors.Is(err, errs.ErrForbidden) {
+			c.Error(errs.NewAPIError(http.StatusForbidden, err))
+			return
+		}
+		c.Error(errs.NewAPIError(http.StatusInternalServerError, err))
+		return
+	}
+
+	c.JSON(http.StatusOK, data)
+}
+
+// @Summary Convidar membro
+// @Description Adiciona um usuário já cadastrado ao workspace. Apenas donos podem convidar.
+// @Tags Workspaces
+// @Accept json
+// @Produce json
+// @Param id path string true "ID do workspace"
+// @Param member body dto.WorkspaceMemberRequest true "E-mail e papel do membro"
+// @Success 201 {object} dto.WorkspaceMemberResponse
+// @Failure 400 {object} errs.ErrorResponse "Requisição inválida"
+// @Failure 403 {object} errs.ErrorResponse "Apenas donos podem convidar"
+// @Failure 404 {object} errs.ErrorResponse "Usuário não encontrado"
+// @Failure 409 {object} errs.ErrorResponse "Usuário já é membro"
+// @Failure 500 {object} errs.ErrorResponse "Erro interno"
+// @Router /workspaces/{id}/members [post]
+func (h *WorkspaceHandler) AddMemberHandler(c *gin.Context) {
+	ctx := c.Request.Context()
+	id, err := utils.ToUUIDPointer(c.Param("id"))
+	if err != nil || id == nil {
+		c.Error(errs.NewAPIError(http.StatusBadRequest, err))
+		return
+	}
+
+	var req dto.WorkspaceMemberRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		c.Error(errs.NewAPIError(http.StatusBadRequest, err))
+		return
+	}
+
+	data, err := h.Service.AddMember(ctx, *id, req)
+	if err != nil {
+		switch {
+		case errors.Is(err, errs.ErrForbidden):
+			c.Error(errs.NewAPIError(http.StatusForbidden, err))
+		case errors.Is(err, errs.ErrConflict):
+			c.Error(errs.NewAPIError(http.StatusConflict, err))
+		case errors.Is(err, errs.ErrNotFound):
+			c.Error(errs.NewAPIError(http.StatusNotFound, err))
+		default:
+			c.Error(errs.NewAPIError(http.StatusBadRequest, err))
+		}
+		return
+	}
+
+	c.JSON(http.StatusCreated, data)
+}
+
+// @Summary Remover membro
+// @Description Donos removem qualquer membro; os demais só podem remover a si mesmos. O último dono não pode ser removido.
+// @Tags Workspaces
+// @Produce json
+// @Param id path string true "ID do workspace"
+// @Param user_id path string true "ID do usuário"
+// @Success 204 "Membro removido com sucesso"
+// @Failure 400 {object} errs.ErrorResponse "ID inválido"
+// @Failure 403 {object} errs.ErrorResponse "Sem permissão"
+// @Failure 404 {object} errs.ErrorResponse "Membro não encontrado"
+// @Failure 409 {object} errs.ErrorResponse "Último dono do workspace"
+// @Failure 500 {object} errs.ErrorResponse "Erro interno"
+// @Router /workspaces/{id}/members/{user_id} [delete]
+func (h *WorkspaceHandler) RemoveMemberHandler(c *gin.Context) {
+	ctx := c.Request.Context()
+	id, err := utils.ToUUIDPointer(c.Param("id"))
+	if err != nil || id == nil {
+		c.Error(errs.NewAPIError(http.StatusBadRequest, err))
+		return
+	}
+
+	userID, err := utils.ToUUIDPointer(c.Param("user_id"))
+	if err != nil || userID == nil {
+		c.Error(errs.NewAPIError(http.StatusBadRequest, err))
+		return
+	}
+
+	err = h.Service.RemoveMember(ctx, *id, *userID)
+	if err != nil {
+		switch {
+		case errors.Is(err, errs.ErrForbidden):
+			c.Error(errs.NewAPIError(http.StatusForbidden, err))
+		case errors.Is(err, errs.ErrNotFound):
+			c.Error(errs.NewAPIError(http.StatusNotFound, err))
+		case errors.Is(err, errs.ErrConflict):
+			c.Error(errs.NewAPIError(http.StatusConflict, err))
+		default:
+			c.Error(errs.NewAPIError(http.StatusInternalServerError, err))
+		}
+		return
+	}
+
+	c.JSON(http.StatusNoContent, nil)
+}
@@ -1,12 +1,21 @@
 package interfaces
 
-import "github.com/streadway/amqp"
+import (
+	"context"
+
+	"github.com/streadway/amqp"
+)
 
 type MessageQueue interface {
 	GetChannel() (*amqp.Channel, error)
 	GetQueueName() string
 	ConsumeMessages() (<-chan amqp.Delivery, error)
-	SendMessage(body []byte) error
+	// SendMessage publica body levando o X-Request-ID presente em ctx nos headers
+	SendMessage(ctx context.Context, body []byte) error
 	AckMessage(msg amqp.Delivery) error
+	// Ping verifica se a conexão com o broker está ativa
+	Ping(ctx context.Context) error
+	// Depth retorna a quantidade de mensagens aguardando na fila
+	Depth() (int, error)
 	Close()
 }
@@ -2,8 +2,16 @@ package rabbitmq
 
 import (
 	"backend-go/internal/api/v1/queue/interfaces"
+	"backend-go/pkg/metrics"
+	"backend-go/pkg/requestid"
+	"backend-go/pkg/tracing"
+	"context"
+	"time"
 
 	"github.com/streadway/amqp"
+	"go.opentelemetry.io/otel"
+	semconv "go.opentelemetry.io/otel/semconv/v1.26.0"
+	"go.opentelemetry.io/otel/trace"
 )
 
 type RabbitMQ struct {
@@ -54,21 +62,134 @@ func (r *RabbitMQ) ConsumeMessages() (<-chan amqp.Delivery, error) {
 	)
 }
 
-func (r *RabbitMQ) SendMessage(body []byte) error {
-	return r.channel.Publish(
+func (r *RabbitMQ) SendMessage(ctx context.Context, body []byte) (err error) {
+	ctx, span := tracing.Start(ctx, r.queue+" publish", trace.SpanKindProducer,
+		semconv.MessagingSystemRabbitmq,
+		semconv.MessagingDestinationName(r.queue),
+		semconv.MessagingOperationTypePublish,
+	)
+	defer func() { tracing.End(span, err) }()
+
+	headers := amqp.Table{}
+	if id, ok := requestid.FromContext(ctx); ok {
+		headers[requestid.Header] = id
+	}
+	// O consumidor continua o trace a partir destes headers (ContextFromMessage)
+	otel.GetTextMapPropagator().Inject(ctx, headerCarrier(headers))
+
+	err = r.channel.Publish(
 		"",
 		r.queue,
 		false,
 		false,
 		amqp.Publishing{
 			ContentType: "application/json",
-			Body:        body,
+			Headers:     headers,
+			// Usado para medir o atraso no consumo
+			Timestamp: time.Now(),
+			Body:      body,
 		},
 	)
+	metrics.QueueMessages.WithLabelValues(r.queue, "published", result(err)).Inc()
+	return err
 }
 
 func (r *RabbitMQ) AckMessage(msg amqp.Delivery) error {
-	return msg.Ack(false)
+	err := msg.Ack(false)
+	metrics.QueueMessages.WithLabelValues(r.queue, "consumed", result(err)).Inc()
+	if err == nil && !msg.Timestamp.IsZero() {
+		metrics.QueueLag.WithLabelValues(r.queue).Observe(time.Since(msg.Timestamp).Seconds())
+	}
+	return err
+}
+
+// ContextFromMessage devolve ctx com o X-Request-ID e o trace da requisição
+// que publicou msg, para que os logs e spans do consumidor fiquem ligados à
+// requisição original
+func ContextFromMessage(ctx context.Context, msg amqp.Delivery) context.Context {
+	if id, ok := msg.Headers[requestid.Header].(string); ok && requestid.Valid(id) {
+		ctx = requestid.WithRequestID(ctx, id)
+	}
+	if msg.Headers != nil {
+		ctx = otel.GetTextMapPropagator().Extract(ctx, headerCarrier(msg.Headers))
+	}
+	return ctx
+}
+
+// StartConsumeSpan inicia o span do processamento de msg, filho do span que a
+// publicou. O consumidor encerra o span com tracing.End após o ack.
+func (r *RabbitMQ) StartConsumeSpan(ctx context.Context, msg amqp.Delivery) (context.Context, trace.Span) {
+	return tracing.Start(ContextFromMessage(ctx, msg), r.queue+" process", trace.SpanKindConsumer,
+		semconv.MessagingSystemRabbitmq,
+		semconv.MessagingDestinationName(r.queue),
+		semconv.MessagingOperationTypeDeliver,
+	)
+}
+
+// headerCarrier lê e grava o contexto de trace nos headers da mensagem
+type headerCarrier amqp.Table
+
+func (c headerCarrier) Get(key string) string {
+	value, _ := c[key].(string)
+	return value
+}
+
+func (c headerCarrier) Set(key, value string) {
+	c[key] = value
+}
+
+func (c headerCarrier) Keys() []string {
+	keys := make([]string, 0, len(c))
+	for key := range c {
+		keys = append(keys, key)
+	}
+	return keys
+}
+
+// Depth consulta a fila em um canal próprio, pois uma falha no QueueInspect
+// fecha o canal usado
+func (r *RabbitMQ) Depth() (int, error) {
+	ch, err := r.conn.Channel()
+	if err != nil {
+		return 0, err
+	}
+	defer ch.Close()
+
+	q, err := ch.QueueInspect(r.queue)
+	if err != nil {
+		return 0, err
+	}
+	return q.Messages, nil
+}
+
+func result(err error) string {
+	if err != nil {
+		return "error"
+	}
+	return "success"
+}
+
+// Ping abre e fecha um canal, o que exige uma resposta do broker
+func (r *RabbitMQ) Ping(ctx context.Context) error {
+	if r.conn.IsClosed() {
+		return amqp.ErrClosed
+	}
+
+	done := make(chan error, 1)
+	go func() {
+		ch, err := r.conn.Channel()
+		if err == nil {
+			err = ch.Close()
+		}
+		done <- err
+	}()
+
+	select {
+	case err := <-done:
+		return err
+	case <-ctx.Done():
+		return ctx.Err()
+	}
 }
 
 func (r *RabbitMQ) Close() {
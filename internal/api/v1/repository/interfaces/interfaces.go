@@ -3,32 +3,89 @@ package interfaces
 import (
 	"backend-go/internal/api/v1/dto"
 	"backend-go/internal/api/v1/repository/models"
+	"backend-go/pkg/fieldset"
+	"backend-go/pkg/filter"
 	"backend-go/pkg/pagination"
 	"context"
+	"time"
 
 	"github.com/google/uuid"
 )
 
 type Database interface {
 	Close()
+	// Ping verifica se o banco responde
+	Ping(ctx context.Context) error
+	// WithTx executa fn com um repositório ligado a uma transação, confirmada
+	// se fn não retornar erro e desfeita caso contrário
+	WithTx(ctx context.Context, fn func(db Database) error) error
 	// Debt
 	GetDebtByID(ctx context.Context, id uuid.UUID) (*dto.DebtResponse, error)
 	DeleteDebtByID(ctx context.Context, id uuid.UUID) error
+	ListDeletedDebts(ctx context.Context, pgn *pagination.Pagination) ([]dto.DebtResponse, error)
+	CountDeletedDebts(ctx context.Context) (int, error)
+	RestoreDebtByID(ctx context.Context, id uuid.UUID) (*dto.DebtResponse, error)
 	InsertDebt(ctx context.Context, input models.Debt) (*dto.DebtResponse, error)
+	InsertDebts(ctx context.Context, inputs []models.Debt) ([]dto.DebtResponse, error)
+	DebtExists(ctx context.Context, input models.Debt) (bool, error)
+	ValidateDebts(ctx context.Context, inputs []models.Debt) ([]error, error)
 	UpdateDebt(ctx context.Context, input models.Debt) (*dto.DebtResponse, error)
-	ListDebts(ctx context.Context, flt dto.DebtFilters, pgn *pagination.Pagination) ([]dto.DebtResponse, error)
-	CountDebts(ctx context.Context, flt dto.DebtFilters, pgn *pagination.Pagination) (int, error)
+	ListDebts(ctx context.Context, flt filter.Filters, pgn *pagination.Pagination, fs *fieldset.Fieldset) ([]dto.DebtResponse, error)
+	CountDebts(ctx context.Context, flt filter.Filters, pgn *pagination.Pagination) (int, error)
+	// Metrics
+	CountUnpaidDebts(ctx context.Context) (int, error)
+	CountOverdueInvoices(ctx context.Context, now time.Time) (int, error)
+	// Trash
+	PurgeDeleted(ctx context.Context, before time.Time) (*models.PurgeResult, error)
+	// Audit
+	ListDebtHistory(ctx context.Context, debtID uuid.UUID, pgn *pagination.Pagination) ([]dto.AuditEntryResponse, error)
+	CountDebtHistory(ctx context.Context, debtID uuid.UUID) (int, error)
+	// Attachment
+	GetAttachmentByID(ctx context.Context, id uuid.UUID) (*models.Attachment, error)
+	ListAttachments(ctx context.Context, debtID, invoiceID *uuid.UUID) ([]dto.AttachmentResponse, error)
+	InsertAttachment(ctx context.Context, input models.Attachment) (*dto.AttachmentResponse, error)
+	DeleteAttachmentByID(ctx context.Context, id uuid.UUID) error
+	// Tag
+	GetTagByID(ctx context.Context, id uuid.UUID) (*dto.TagResponse, error)
+	DeleteTagByID(ctx context.Context, id uuid.UUID) error
+	InsertTag(ctx context.Context, input models.Tag) (*dto.TagResponse, error)
+	UpdateTag(ctx context.Context, input models.Tag) (*dto.TagResponse, error)
+	ListTags(ctx context.Context, pgn *pagination.Pagination) ([]dto.TagResponse, error)
+	CountTags(ctx context.Context, pgn *pagination.Pagination) (int, error)
+	// Report
+	ReportByCategory(ctx context.Context, flt dto.ReportFilters) ([]dto.ReportItem, error)
+	ReportByTag(ctx context.Context, flt dto.ReportFilters) ([]dto.ReportItem, error)
+	// DebtShare
+	ListDebtShares(ctx context.Context, debtID uuid.UUID) ([]dto.DebtShareResponse, error)
+	ReplaceDebtShares(ctx context.Context, debtID uuid.UUID, inputs []models.DebtShare) ([]dto.DebtShareResponse, error)
+	// Person
+	GetPersonByID(ctx context.Context, id uuid.UUID) (*dto.PersonResponse, error)
+	GetPersonBalance(ctx context.Context, id uuid.UUID) (*dto.PersonBalanceResponse, error)
+	DeletePersonByID(ctx context.Context, id uuid.UUID) error
+	ListDeletedPeople(ctx context.Context, pgn *pagination.Pagination) ([]dto.PersonResponse, error)
+	CountDeletedPeople(ctx context.Context) (int, error)
+	RestorePersonByID(ctx context.Context, id uuid.UUID) (*dto.PersonResponse, error)
+	InsertPerson(ctx context.Context, input models.Person) (*dto.PersonResponse, error)
+	UpdatePerson(ctx context.Context, input models.Person) (*dto.PersonResponse, error)
+	ListPeople(ctx context.Context, pgn *pagination.Pagination) ([]dto.PersonResponse, error)
+	CountPeople(ctx context.Context, pgn *pagination.Pagination) (int, error)
 	// Invoice
 	GetInvoiceByID(ctx context.Context, id uuid.UUID) (*dto.InvoiceResponse, error)
 	DeleteInvoiceByID(ctx context.Context, id uuid.UUID) error
+	ListDeletedInvoices(ctx context.Context, pgn *pagination.Pagination) ([]dto.InvoiceResponse, error)
+	CountDeletedInvoices(ctx context.Context) (int, error)
+	RestoreInvoiceByID(ctx context.Context, id uuid.UUID) (*dto.InvoiceResponse, error)
 	InsertInvoice(ctx context.Context, input models.Invoice) (*dto.InvoiceResponse, error)
 	UpdateInvoice(ctx context.Context, input models.Invoice) (*dto.InvoiceResponse, error)
-	ListInvoices(ctx context.Context, flt dto.InvoiceFilters, pgn *pagination.Pagination) ([]dto.InvoiceResponse, error)
-	CountInvoices(ctx context.Context, flt dto.InvoiceFilters, pgn *pagination.Pagination) (int, error)
+	ListInvoices(ctx context.Context, flt filter.Filters, pgn *pagination.Pagination) ([]dto.InvoiceResponse, error)
+	CountInvoices(ctx context.Context, flt filter.Filters, pgn *pagination.Pagination) (int, error)
 	// Category
 	GetCategoryByID(ctx context.Context, id uuid.UUID) (*dto.CategoryResponse, error)
 	GetCategoryIDByName(ctx context.Context, name *string) (*uuid.UUID, error)
 	DeleteCategoryByID(ctx context.Context, id uuid.UUID) error
+	ListDeletedCategories(ctx context.Context, pgn *pagination.Pagination) ([]dto.CategoryResponse, error)
+	CountDeletedCategories(ctx context.Context) (int, error)
+	RestoreCategoryByID(ctx context.Context, id uuid.UUID) (*dto.CategoryResponse, error)
 	InsertCategory(ctx context.Context, input models.Category) (*dto.CategoryResponse, error)
 	UpdateCategory(ctx context.Context, input models.Category) (*dto.CategoryResponse, error)
 	ListCategories(ctx context.Context, pgn *pagination.Pagination) ([]dto.CategoryResponse, error)
@@ -36,9 +93,25 @@ type Database interface {
 	// PaymentStatus
 	GetPaymentStatusByID(ctx context.Context, id uuid.UUID) (*dto.PaymentStatusResponse, error)
 	GetPaymentStatusIDByName(ctx context.Context, name *string) (*uuid.UUID, error)
-	DeletePaymentStatusByID(ctx context.Context, id uuid.UUID) error
-	InsertPaymentStatus(ctx context.Context, input models.PaymentStatus) (*dto.PaymentStatusResponse, error)
-	UpdatePaymentStatus(ctx context.Context, input models.PaymentStatus) (*dto.PaymentStatusResponse, error)
 	ListPaymentStatus(ctx context.Context, pgn *pagination.Pagination) ([]dto.PaymentStatusResponse, error)
 	CountPaymentStatus(ctx context.Context, pgn *pagination.Pagination) (int, error)
+	// User
+	GetUserByID(ctx context.Context, id uuid.UUID) (*dto.UserResponse, error)
+	GetUserByEmail(ctx context.Context, email string) (*models.User, error)
+	InsertUser(ctx context.Context, input models.User, categories []string) (*dto.UserResponse, error)
+	// APIKey
+	GetAPIKeyByHash(ctx context.Context, hash string) (*models.APIKey, error)
+	ListAPIKeys(ctx context.Context) ([]dto.APIKeyResponse, error)
+	InsertAPIKey(ctx context.Context, input models.APIKey) (*dto.APIKeyResponse, error)
+	RevokeAPIKey(ctx context.Context, id uuid.UUID) error
+	TouchAPIKey(ctx context.Context, id uuid.UUID) error
+	// Workspace
+	ListWorkspaces(ctx context.Context) ([]dto.WorkspaceResponse, error)
+	InsertWorkspace(ctx context.Context, input models.Workspace, categories []string) (*dto.WorkspaceResponse, error)
+	GetWorkspaceMember(ctx context.Context, workspaceID, userID uuid.UUID) (*models.WorkspaceMember, error)
+	GetDefaultWorkspaceMember(ctx context.Context, userID uuid.UUID) (*models.WorkspaceMember, error)
+	ListWorkspaceMembers(ctx context.Context, workspaceID uuid.UUID) ([]dto.WorkspaceMemberResponse, error)
+	CountWorkspaceOwners(ctx context.Context, workspaceID uuid.UUID) (int, error)
+	InsertWorkspaceMember(ctx context.Context, input models.WorkspaceMember) (*dto.WorkspaceMemberResponse, error)
+	DeleteWorkspaceMember(ctx context.Context, workspaceID, userID uuid.UUID) error
 }
@@ -6,6 +6,15 @@ import (
 	"github.com/google/uuid"
 )
 
+// Tipos de débito. Apenas estornos reduzem os totais.
+const (
+	DebtKindPurchase = "purchase"
+	DebtKindRefund   = "refund"
+	DebtKindFee      = "fee"
+	DebtKindInterest = "interest"
+	DebtKindIOF      = "iof"
+)
+
 type Debt struct {
 	ID           uuid.UUID  `json:"id"`
 	InvoiceID    *uuid.UUID `json:"invoice_id"`
@@ -15,7 +24,28 @@ type Debt struct {
 	PurchaseDate time.Time  `json:"purchase_date"`
 	DueDate      time.Time  `json:"due_date"`
 	// TODO: ele é obrigatorio no banco, ver depois como lidar com isso e o seu hook
-	StatusID     *uuid.UUID  `json:"status_id"`
+	StatusID *uuid.UUID `json:"status_id"`
+	// Tags nil mantém as tags atuais na atualização; vazio remove todas
+	Tags       []string   `json:"tags"`
+	Kind       string     `json:"kind"`
+	RefundOfID *uuid.UUID `json:"refund_of_id"`
+}
+
+// ValidDebtKind indica se o tipo de débito existe
+func ValidDebtKind(kind string) bool {
+	switch kind {
+	case DebtKindPurchase, DebtKindRefund, DebtKindFee, DebtKindInterest, DebtKindIOF:
+		return true
+	}
+	return false
+}
+
+// SignedAmount retorna o valor com sinal usado em totais e relatórios
+func SignedAmount(kind string, amount float64) float64 {
+	if kind == DebtKindRefund {
+		return -amount
+	}
+	return amount
 }
 
 type Category struct {
@@ -24,17 +54,81 @@ type Category struct {
 	Description *string   `json:"description"`
 }
 
+type Tag struct {
+	ID   uuid.UUID `json:"id"`
+	Name string    `json:"name"`
+}
+
+type Attachment struct {
+	ID          uuid.UUID  `json:"id"`
+	DebtID      *uuid.UUID `json:"debt_id"`
+	InvoiceID   *uuid.UUID `json:"invoice_id"`
+	FileName    string     `json:"file_name"`
+	ContentType string     `json:"content_type"`
+	Size        int64      `json:"size"`
+	StorageKey  string     `json:"-"`
+}
+
+type Person struct {
+	ID    uuid.UUID `json:"id"`
+	Name  string    `json:"name"`
+	Email *string   `json:"email"`
+}
+
+type DebtShare struct {
+	PersonID   uuid.UUID `json:"person_id"`
+	Amount     float64   `json:"amount"`
+	Percentage *float64  `json:"percentage"`
+	Settled    bool      `json:"settled"`
+}
+
 type Invoice struct {
-	ID        uuid.UUID `json:"id"`
-	Title     string    `json:"title"`
-	Amount    float64   `json:"amount"`
-	IssueDate time.Time `json:"issue_date"`
-	DueDate   time.Time `json:"due_date"`
-	StatusID  uuid.UUID `json:"status_id"`
+	ID        uuid.UUID  `json:"id"`
+	Title     string     `json:"title"`
+	Amount    float64    `json:"amount"`
+	IssueDate time.Time  `json:"issue_date"`
+	DueDate   time.Time  `json:"due_date"`
+	StatusID  *uuid.UUID `json:"status_id"`
+}
+
+type User struct {
+	ID           uuid.UUID `json:"id"`
+	Name         string    `json:"name"`
+	Email        string    `json:"email"`
+	PasswordHash string    `json:"-"`
+}
+
+type Workspace struct {
+	ID   uuid.UUID `json:"id"`
+	Name string    `json:"name"`
 }
 
-type PaymentStatus struct {
+type WorkspaceMember struct {
 	ID          uuid.UUID `json:"id"`
-	Name        string    `json:"name"`
-	Description *string   `json:"description"`
+	WorkspaceID uuid.UUID `json:"workspace_id"`
+	UserID      uuid.UUID `json:"user_id"`
+	Role        string    `json:"role"`
+}
+
+type APIKey struct {
+	ID        uuid.UUID  `json:"id"`
+	UserID    uuid.UUID  `json:"user_id"`
+	Name      string     `json:"name"`
+	Prefix    string     `json:"prefix"`
+	KeyHash   string     `json:"-"`
+	Scopes    []string   `json:"scopes"`
+	ExpiresAt *time.Time `json:"expires_at"`
+	// LastUsedAt e RevokedAt são preenchidos apenas na leitura
+	LastUsedAt *time.Time `json:"last_used_at"`
+	RevokedAt  *time.Time `json:"revoked_at"`
+}
+
+// PurgeResult resume o que foi apagado definitivamente da lixeira
+type PurgeResult struct {
+	Debts      int
+	Invoices   int
+	Categories int
+	People     int
+	// Chaves no storage dos anexos apagados junto com os débitos e faturas
+	StorageKeys []string
 }
@@ -0,0 +1,142 @@
+package postgresql
+
+import (
+	"backend-go/internal/api/errs"
+	"backend-go/internal/api/v1/dto"
+	"backend-go/internal/api/v1/repository/models"
+	"backend-go/pkg/ent"
+	"backend-go/pkg/ent/apikey"
+	"backend-go/pkg/utils"
+	"context"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// Intervalo mínimo entre duas atualizações de last_used_at da mesma chave
+const apiKeyTouchInterval = time.Minute
+
+func (d *PostgreSQL) GetAPIKeyByHash(ctx context.Context, hash string) (*models.APIKey, error) {
+	row, err := d.Client.APIKey.Query().Where(apikey.KeyHashEQ(hash)).Only(ctx)
+	if err != nil {
+		if ent.IsNotFound(err) {
+			return nil, errs.ErrNotFound
+		}
+		return nil, err
+	}
+
+	return &models.APIKey{
+		ID:         row.ID,
+		UserID:     row.UserID,
+		Name:       row.Name,
+		Prefix:     row.Prefix,
+		KeyHash:    row.KeyHash,
+		Scopes:     row.Scopes,
+		ExpiresAt:  row.ExpiresAt,
+		LastUsedAt: row.LastUsedAt,
+		RevokedAt:  row.RevokedAt,
+	}, nil
+}
+
+func (d *PostgreSQL) ListAPIKeys(ctx context.Context) ([]dto.APIKeyResponse, error) {
+	userID, err := currentUserID(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	rows, err := d.Client.APIKey.Query().
+		Where(apikey.UserID(userID)).
+		Order(ent.Desc(apikey.FieldCreatedAt)).
+		All(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	response := make([]dto.APIKeyResponse, 0, len(rows))
+	for _, row := range rows {
+		response = append(response, mapAPIKeyToResponse(row))
+	}
+	return response, nil
+}
+
+func (d *PostgreSQL) InsertAPIKey(ctx context.Context, input models.APIKey) (*dto.APIKeyResponse, error) {
+	userID, err := currentUserID(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	created, err := d.Client.APIKey.
+		Create().
+		SetUserID(userID).
+		SetName(input.Name).
+		SetPrefix(input.Prefix).
+		SetKeyHash(input.KeyHash).
+		SetScopes(input.Scopes).
+		SetNillableExpiresAt(input.ExpiresAt).
+		Save(ctx)
+	if err != nil {
+		return nil, errs.FailedToSave("api_keys", err)
+	}
+
+	response := mapAPIKeyToResponse(created)
+	return &response, nil
+}
+
+// RevokeAPIKey marca a chave como revogada; ela continua listada para histórico
+func (d *PostgreSQL) RevokeAPIKey(ctx context.Context, id uuid.UUID) error {
+	userID, err := currentUserID(ctx)
+	if err != nil {
+		return err
+	}
+
+	updated, err := d.Client.APIKey.Update().
+		Where(
+			apikey.ID(id),
+			apikey.UserID(userID),
+			apikey.RevokedAtIsNil(),
+		).
+		SetRevokedAt(time.Now()).
+		Save(ctx)
+	if err != nil {
+		return err
+	}
+	if updated == 0 {
+		return errs.ErrNotFound
+	}
+	return nil
+}
+
+// TouchAPIKey atualiza last_used_at, no máximo uma vez por minuto para cada chave
+func (d *PostgreSQL) TouchAPIKey(ctx context.Context, id uuid.UUID) error {
+	now := time.Now()
+	return d.Client.APIKey.Update().
+		Where(
+			apikey.ID(id),
+			apikey.Or(
+				apikey.LastUsedAtIsNil(),
+				apikey.LastUsedAtLT(now.Add(-apiKeyTouchInterval)),
+			),
+		).
+		SetLastUsedAt(now).
+		Exec(ctx)
+}
+
+func mapAPIKeyToResponse(row *ent.APIKey) dto.APIKeyResponse {
+	response := dto.APIKeyResponse{
+		ID:        row.ID,
+		Name:      row.Name,
+		Prefix:    row.Prefix,
+		Scopes:    row.Scopes,
+		CreatedAt: *utils.ToFormatDateTimePointer(row.CreatedAt),
+	}
+	if row.LastUsedAt != nil {
+		response.LastUsedAt = utils.ToFormatDateTimePointer(*row.LastUsedAt)
+	}
+	if row.ExpiresAt != nil {
+		response.ExpiresAt = utils.ToFormatDatePointer(*row.ExpiresAt)
+	}
+	if row.RevokedAt != nil {
+		response.RevokedAt = utils.ToFormatDateTimePointer(*row.RevokedAt)
+	}
+	return response
+}
@@ -0,0 +1,157 @@
+package postgresql
+
+import (
+	"backend-go/internal/api/errs"
+	"backend-go/internal/api/v1/dto"
+	"backend-go/internal/api/v1/repository/models"
+	"backend-go/pkg/ent"
+	"backend-go/pkg/ent/attachment"
+	"backend-go/pkg/ent/debt"
+	"backend-go/pkg/ent/invoice"
+	"backend-go/pkg/utils"
+	"context"
+
+	"github.com/google/uuid"
+)
+
+func (d *PostgreSQL) GetAttachmentByID(ctx context.Context, id uuid.UUID) (*models.Attachment, error) {
+	workspaceID, err := currentWorkspaceID(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	row, err := d.Client.Attachment.Query().
+		Where(attachment.ID(id), attachment.WorkspaceID(workspaceID)).
+		Only(ctx)
+	if err != nil {
+		if ent.IsNotFound(err) {
+			return nil, errs.ErrNotFound
+		}
+		return nil, err
+	}
+
+	return &models.Attachment{
+		ID:          row.ID,
+		DebtID:      row.DebtID,
+		InvoiceID:   row.InvoiceID,
+		FileName:    row.FileName,
+		ContentType: row.ContentType,
+		Size:        row.Size,
+		StorageKey:  row.StorageKey,
+	}, nil
+}
+
+// ListAttachments lista os anexos do débito ou da fatura informados. Retorna
+// ErrNotFound quando o débito ou a fatura não existem no workspace.
+func (d *PostgreSQL) ListAttachments(ctx context.Context, debtID, invoiceID *uuid.UUID) ([]dto.AttachmentResponse, error) {
+	workspaceID, err := currentWorkspaceID(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := checkAttachmentOwner(ctx, d.Client, workspaceID, debtID, invoiceID); err != nil {
+		return nil, err
+	}
+
+	query := d.Client.Attachment.Query().Where(attachment.WorkspaceID(workspaceID))
+	if debtID != nil {
+		query = query.Where(attachment.DebtID(*debtID))
+	}
+	if invoiceID != nil {
+		query = query.Where(attachment.InvoiceID(*invoiceID))
+	}
+
+	rows, err := query.Order(ent.Asc(attachment.FieldCreatedAt)).All(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	response := make([]dto.AttachmentResponse, 0, len(rows))
+	for _, row := range rows {
+		response = append(response, mapAttachmentToResponse(row))
+	}
+	return response, nil
+}
+
+func (d *PostgreSQL) InsertAttachment(ctx context.Context, input models.Attachment) (*dto.AttachmentResponse, error) {
+	workspaceID, err := currentWorkspaceID(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := checkAttachmentOwner(ctx, d.Client, workspaceID, input.DebtID, input.InvoiceID); err != nil {
+		return nil, err
+	}
+
+	created, err := d.Client.Attachment.
+		Create().
+		SetID(input.ID).
+		SetWorkspaceID(workspaceID).
+		SetNillableDebtID(input.DebtID).
+		SetNillableInvoiceID(input.InvoiceID).
+		SetFileName(input.FileName).
+		SetContentType(input.ContentType).
+		SetSize(input.Size).
+		SetStorageKey(input.StorageKey).
+		Save(ctx)
+	if err != nil {
+		return nil, errs.FailedToSave("attachments", err)
+	}
+
+	response := mapAttachmentToResponse(created)
+	return &response, nil
+}
+
+func (d *PostgreSQL) DeleteAttachmentByID(ctx context.Context, id uuid.UUID) error {
+	workspaceID, err := currentWorkspaceID(ctx)
+	if err != nil {
+		return err
+	}
+
+	deleted, err := d.Client.Attachment.Delete().
+		Where(attachment.ID(id), attachment.WorkspaceID(workspaceID)).
+		Exec(ctx)
+	if err != nil {
+		return err
+	}
+	if deleted == 0 {
+		return errs.ErrNotFound
+	}
+	return nil
+}
+
+// checkAttachmentOwner garante que o débito ou a fatura pertencem ao workspace
+func checkAttachmentOwner(ctx context.Context, client *ent.Client, workspaceID uuid.UUID, debtID, invoiceID *uuid.UUID) error {
+	var exists bool
+	var err error
+
+	switch {
+	case debtID != nil:
+		exists, err = client.Debt.Query().
+			Where(debt.ID(*debtID), debt.WorkspaceID(workspaceID)).
+			Exist(ctx)
+	case invoiceID != nil:
+		exists, err = client.Invoice.Query().
+			Where(invoice.ID(*invoiceID), invoice.WorkspaceID(workspaceID)).
+			Exist(ctx)
+	}
+	if err != nil {
+		return err
+	}
+	if !exists {
+		return errs.ErrNotFound
+	}
+	return nil
+}
+
+func mapAttachmentToResponse(row *ent.Attachment) dto.AttachmentResponse {
+	return dto.AttachmentResponse{
+		ID:          row.ID,
+		DebtID:      row.DebtID,
+		InvoiceID:   row.InvoiceID,
+		FileName:    row.FileName,
+		ContentType: row.ContentType,
+		Size:        row.Size,
+		CreatedAt:   *utils.ToFormatDateTimePointer(row.CreatedAt),
+	}
+}
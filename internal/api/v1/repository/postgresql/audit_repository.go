@@ -0,0 +1,80 @@
+package postgresql
+
+import (
+	"backend-go/internal/api/errs"
+	"backend-go/internal/api/v1/dto"
+	"backend-go/pkg/ent"
+	"backend-go/pkg/ent/auditentry"
+	"backend-go/pkg/ent/debt"
+	"backend-go/pkg/hooks"
+	"backend-go/pkg/pagination"
+	"backend-go/pkg/utils"
+	"context"
+
+	"github.com/google/uuid"
+)
+
+// ListDebtHistory lista as alterações do débito, da mais recente para a mais
+// antiga. O histórico continua disponível enquanto o débito está na lixeira.
+func (d *PostgreSQL) ListDebtHistory(ctx context.Context, debtID uuid.UUID, pgn *pagination.Pagination) ([]dto.AuditEntryResponse, error) {
+	query, err := d.debtHistoryQuery(ctx, debtID)
+	if err != nil {
+		return nil, err
+	}
+
+	rows, err := query.
+		Order(ent.Desc(auditentry.FieldCreatedAt)).
+		Limit(pgn.PageSize).
+		Offset(pgn.Offset()).
+		All(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	response := make([]dto.AuditEntryResponse, 0, len(rows))
+	for _, row := range rows {
+		response = append(response, mapAuditEntryToResponse(row))
+	}
+	return response, nil
+}
+
+func (d *PostgreSQL) CountDebtHistory(ctx context.Context, debtID uuid.UUID) (int, error) {
+	query, err := d.debtHistoryQuery(ctx, debtID)
+	if err != nil {
+		return 0, err
+	}
+	return query.Count(ctx)
+}
+
+func (d *PostgreSQL) debtHistoryQuery(ctx context.Context, debtID uuid.UUID) (*ent.AuditEntryQuery, error) {
+	workspaceID, err := currentWorkspaceID(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	exists, err := d.Client.Debt.Query().
+		Where(debt.ID(debtID), debt.WorkspaceID(workspaceID)).
+		Exist(hooks.IncludeDeleted(ctx))
+	if err != nil {
+		return nil, err
+	}
+	if !exists {
+		return nil, errs.ErrNotFound
+	}
+
+	return d.Client.AuditEntry.Query().
+		Where(auditentry.EntityType(ent.TypeDebt), auditentry.EntityID(debtID)), nil
+}
+
+func mapAuditEntryToResponse(row *ent.AuditEntry) dto.AuditEntryResponse {
+	return dto.AuditEntryResponse{
+		ID:        row.ID,
+		Action:    string(row.Action),
+		ActorID:   row.ActorID,
+		ViaAPIKey: row.ViaAPIKey,
+		RequestID: row.RequestID,
+		OldValues: row.OldValues,
+		NewValues: row.NewValues,
+		CreatedAt: *utils.ToFormatDateTimePointer(row.CreatedAt),
+	}
+}
@@ -6,6 +6,8 @@ import (
 	"backend-go/internal/api/v1/repository/models"
 	"backend-go/pkg/ent"
 	"backend-go/pkg/ent/category"
+	"backend-go/pkg/ent/debt"
+	"backend-go/pkg/hooks"
 	"backend-go/pkg/pagination"
 	"context"
 
@@ -13,7 +15,14 @@ import (
 )
 
 func (d *PostgreSQL) GetCategoryByID(ctx context.Context, id uuid.UUID) (*dto.CategoryResponse, error) {
-	row, err := d.Client.Category.Get(ctx, id)
+	workspaceID, err := currentWorkspaceID(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	row, err := d.Client.Category.Query().
+		Where(category.ID(id), category.WorkspaceID(workspaceID)).
+		Only(ctx)
 	if err != nil {
 		if ent.IsNotFound(err) {
 			return nil, errs.ErrNotFound
@@ -28,7 +37,14 @@ func (d *PostgreSQL) GetCategoryIDByName(ctx context.Context, name *string) (*uu
 		return nil, nil
 	}
 
-	data, err := d.Client.Category.Query().Where(category.NameEQ(*name)).Only(ctx)
+	workspaceID, err := currentWorkspaceID(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	data, err := d.Client.Category.Query().
+		Where(category.NameEQ(*name), category.WorkspaceID(workspaceID)).
+		Only(ctx)
 	if err != nil {
 		if ent.IsNotFound(err) {
 			return nil, errs.ErrNotFound
@@ -40,20 +56,103 @@ func (d *PostgreSQL) GetCategoryIDByName(ctx context.Context, name *string) (*uu
 	return &id, nil
 }
 
+// DeleteCategoryByID remove a categoria. Categorias usadas por débitos fora
+// da lixeira não podem ser removidas e retornam ErrConflict.
 func (d *PostgreSQL) DeleteCategoryByID(ctx context.Context, id uuid.UUID) error {
-	err := d.Client.Category.DeleteOneID(id).Exec(ctx)
+	workspaceID, err := currentWorkspaceID(ctx)
+	if err != nil {
+		return err
+	}
+
+	// Sem a verificação, os débitos deixariam de exibir a categoria e a
+	// limpeza da lixeira apagaria a referência deles
+	inUse, err := d.Client.Debt.Query().
+		Where(debt.WorkspaceID(workspaceID), debt.HasCategoryWith(category.ID(id)), debt.DeletedAtIsNil()).
+		Exist(ctx)
+	if err != nil {
+		return err
+	}
+	if inUse {
+		return errs.ErrConflict
+	}
+
+	deleted, err := d.Client.Category.Delete().
+		Where(category.ID(id), category.WorkspaceID(workspaceID)).
+		Exec(ctx)
 	if err != nil {
-		if ent.IsNotFound(err) {
-			return errs.ErrNotFound
-		}
 		return err
 	}
+	if deleted == 0 {
+		return errs.ErrNotFound
+	}
 	return nil
 }
 
+// ListDeletedCategories lista as categorias da lixeira, da remoção mais recente para a mais antiga
+func (d *PostgreSQL) ListDeletedCategories(ctx context.Context, pgn *pagination.Pagination) ([]dto.CategoryResponse, error) {
+	workspaceID, err := currentWorkspaceID(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	data, err := d.Client.Category.Query().
+		Where(category.WorkspaceID(workspaceID), category.DeletedAtNotNil()).
+		Where(pgn.CursorPredicate(nil)).
+		Order(pgn.Order(nil)).
+		Limit(pgn.Limit()).
+		Offset(pgn.Offset()).
+		All(hooks.IncludeDeleted(ctx))
+	if err != nil {
+		return nil, err
+	}
+
+	data, err = pagination.Trim(pgn, data, categorySortValue)
+	if err != nil {
+		return nil, err
+	}
+	return newCategoryResponseList(data)
+}
+
+func (d *PostgreSQL) CountDeletedCategories(ctx context.Context) (int, error) {
+	workspaceID, err := currentWorkspaceID(ctx)
+	if err != nil {
+		return 0, err
+	}
+
+	return d.Client.Category.Query().
+		Where(category.WorkspaceID(workspaceID), category.DeletedAtNotNil()).
+		Count(hooks.IncludeDeleted(ctx))
+}
+
+// RestoreCategoryByID tira a categoria da lixeira
+func (d *PostgreSQL) RestoreCategoryByID(ctx context.Context, id uuid.UUID) (*dto.CategoryResponse, error) {
+	workspaceID, err := currentWorkspaceID(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	restored, err := d.Client.Category.Update().
+		Where(category.ID(id), category.WorkspaceID(workspaceID), category.DeletedAtNotNil()).
+		ClearDeletedAt().
+		Save(hooks.IncludeDeleted(ctx))
+	if err != nil {
+		return nil, errs.FailedToSave("categories", err)
+	}
+	if restored == 0 {
+		return nil, errs.ErrNotFound
+	}
+	return d.GetCategoryByID(ctx, id)
+}
+
 func (d *PostgreSQL) InsertCategory(ctx context.Context, input models.Category) (*dto.CategoryResponse, error) {
+	workspaceID, err := currentWorkspaceID(ctx)
+	if err != nil {
+		return nil, err
+	}
+
 	created, err := d.Client.Category.
 		Create().
+		SetWorkspaceID(workspaceID).
 		SetName(input.Name).
 		SetNillableDescription(input.Description).
 		Save(ctx)
@@ -66,12 +165,22 @@ func (d *PostgreSQL) InsertCategory(ctx context.Context, input models.Category)
 }
 
 func (d *PostgreSQL) UpdateCategory(ctx context.Context, input models.Category) (*dto.CategoryResponse, error) {
-	updated, err := d.Client.Category.
+	workspaceID, err := currentWorkspaceID(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	update := d.Client.Category.
 		UpdateOneID(input.ID).
+		Where(category.WorkspaceID(workspaceID)).
 		SetName(input.Name).
-		SetNillableDescription(input.Description).
-		Save(ctx)
+		SetNillableDescription(input.Description)
+
+	if input.Description == nil {
+		update = update.ClearDescription()
+	}
 
+	updated, err := update.Save(ctx)
 	if err != nil {
 		if ent.IsNotFound(err) {
 			return nil, errs.ErrNotFound
@@ -83,22 +192,37 @@ func (d *PostgreSQL) UpdateCategory(ctx context.Context, input models.Category)
 }
 
 func (d *PostgreSQL) ListCategories(ctx context.Context, pgn *pagination.Pagination) ([]dto.CategoryResponse, error) {
-	query := d.Client.Category.Query()
+	workspaceID, err := currentWorkspaceID(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	query := d.Client.Category.Query().Where(category.WorkspaceID(workspaceID))
 
 	query = applyCategoryFilters(query, pgn)
-	query = query.Order(ent.Desc(pgn.OrderBy))
-	query = query.Limit(pgn.PageSize).Offset(pgn.Offset())
+	query = query.Where(pgn.CursorPredicate(nil))
+	query = query.Order(pgn.Order(nil))
+	query = query.Limit(pgn.Limit()).Offset(pgn.Offset())
 
 	data, err := query.All(ctx)
 	if err != nil {
 		return nil, err
 	}
 
+	data, err = pagination.Trim(pgn, data, categorySortValue)
+	if err != nil {
+		return nil, err
+	}
 	return newCategoryResponseList(data)
 }
 
 func (d *PostgreSQL) CountCategories(ctx context.Context, pgn *pagination.Pagination) (int, error) {
-	query := d.Client.Category.Query()
+	workspaceID, err := currentWorkspaceID(ctx)
+	if err != nil {
+		return 0, err
+	}
+
+	query := d.Client.Category.Query().Where(category.WorkspaceID(workspaceID))
 	query = applyCategoryFilters(query, pgn)
 
 	total, err := query.Count(ctx)
@@ -108,11 +232,26 @@ func (d *PostgreSQL) CountCategories(ctx context.Context, pgn *pagination.Pagina
 	return total, nil
 }
 
+// categorySortValue retorna o valor de uma coluna de ordenação, usado no cursor
+func categorySortValue(row *ent.Category, column string) any {
+	switch column {
+	case category.FieldDeletedAt:
+		return row.DeletedAt
+	case category.FieldName:
+		return row.Name
+	case category.FieldDescription:
+		return row.Description
+	}
+	return row.ID
+}
+
 func mapCategoryToResponse(row *ent.Category) dto.CategoryResponse {
 	return dto.CategoryResponse{
 		ID:          row.ID,
 		Name:        row.Name,
 		Description: row.Description,
+		DeletedAt:   formatDeletedAt(row.DeletedAt),
+		Version:     row.Version,
 	}
 }
 
@@ -3,22 +3,42 @@ package postgresql
 import (
 	"backend-go/internal/api/errs"
 	"backend-go/internal/api/v1/dto"
+	"backend-go/internal/api/v1/repository/interfaces"
 	"backend-go/internal/api/v1/repository/models"
 	"backend-go/pkg/ent"
 	"backend-go/pkg/ent/category"
 	"backend-go/pkg/ent/debt"
 	"backend-go/pkg/ent/invoice"
 	"backend-go/pkg/ent/paymentstatus"
+	"backend-go/pkg/ent/predicate"
+	"backend-go/pkg/ent/tag"
+	"backend-go/pkg/fieldset"
+	"backend-go/pkg/filter"
+	"backend-go/pkg/hooks"
 	"backend-go/pkg/utils"
 
 	"backend-go/pkg/pagination"
 	"context"
+	"errors"
+	"slices"
 
+	"entgo.io/ent/dialect/sql"
 	"github.com/google/uuid"
 )
 
 func (d *PostgreSQL) GetDebtByID(ctx context.Context, id uuid.UUID) (*dto.DebtResponse, error) {
-	row, err := d.Client.Debt.Get(ctx, id)
+	workspaceID, err := currentWorkspaceID(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	row, err := d.Client.Debt.Query().
+		Where(debt.ID(id), debt.WorkspaceID(workspaceID)).
+		WithStatus().
+		WithCategory().
+		WithInvoice().
+		WithTags().
+		Only(ctx)
 	if err != nil {
 		if ent.IsNotFound(err) {
 			return nil, errs.ErrNotFound
@@ -29,76 +49,338 @@ func (d *PostgreSQL) GetDebtByID(ctx context.Context, id uuid.UUID) (*dto.DebtRe
 }
 
 func (d *PostgreSQL) DeleteDebtByID(ctx context.Context, id uuid.UUID) error {
-	err := d.Client.Debt.DeleteOneID(id).Exec(ctx)
+	workspaceID, err := currentWorkspaceID(ctx)
 	if err != nil {
-		if ent.IsNotFound(err) {
-			return errs.ErrNotFound
-		}
 		return err
 	}
+
+	deleted, err := d.Client.Debt.Delete().
+		Where(debt.ID(id), debt.WorkspaceID(workspaceID)).
+		Exec(ctx)
+	if err != nil {
+		return err
+	}
+	if deleted == 0 {
+		return errs.ErrNotFound
+	}
 	return nil
 }
 
+// ListDeletedDebts lista os débitos da lixeira, da remoção mais recente para a mais antiga
+func (d *PostgreSQL) ListDeletedDebts(ctx context.Context, pgn *pagination.Pagination) ([]dto.DebtResponse, error) {
+	workspaceID, err := currentWorkspaceID(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	data, err := d.Client.Debt.Query().
+		Where(debt.WorkspaceID(workspaceID), debt.DeletedAtNotNil()).
+		WithStatus().
+		WithCategory().
+		WithInvoice().
+		WithTags().
+		Where(pgn.CursorPredicate(nil)).
+		Order(pgn.Order(nil)).
+		Limit(pgn.Limit()).
+		Offset(pgn.Offset()).
+		All(hooks.IncludeDeleted(ctx))
+	if err != nil {
+		return nil, err
+	}
+
+	data, err = pagination.Trim(pgn, data, debtSortValue)
+	if err != nil {
+		return nil, err
+	}
+	return newDebtResponseList(data)
+}
+
+func (d *PostgreSQL) CountDeletedDebts(ctx context.Context) (int, error) {
+	workspaceID, err := currentWorkspaceID(ctx)
+	if err != nil {
+		return 0, err
+	}
+
+	return d.Client.Debt.Query().
+		Where(debt.WorkspaceID(workspaceID), debt.DeletedAtNotNil()).
+		Count(hooks.IncludeDeleted(ctx))
+}
+
+// RestoreDebtByID tira o débito da lixeira
+func (d *PostgreSQL) RestoreDebtByID(ctx context.Context, id uuid.UUID) (*dto.DebtResponse, error) {
+	workspaceID, err := currentWorkspaceID(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	restored, err := d.Client.Debt.Update().
+		Where(debt.ID(id), debt.WorkspaceID(workspaceID), debt.DeletedAtNotNil()).
+		ClearDeletedAt().
+		Save(hooks.IncludeDeleted(ctx))
+	if err != nil {
+		return nil, errs.FailedToSave("debts", err)
+	}
+	if restored == 0 {
+		return nil, errs.ErrNotFound
+	}
+	return d.GetDebtByID(ctx, id)
+}
+
 func (d *PostgreSQL) InsertDebt(ctx context.Context, input models.Debt) (*dto.DebtResponse, error) {
-	created, err := d.Client.Debt.
-		Create().
-		SetTitle(input.Title).
-		SetAmount(input.Amount).
-		SetDueDate(input.DueDate).
-		SetPurchaseDate(input.PurchaseDate).
-		SetStatusID(*input.StatusID).
-		SetInvoiceID(*input.InvoiceID).
-		SetCategoryID(*input.CategoryID).
+	workspaceID, err := currentWorkspaceID(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := checkDebtEdges(ctx, d.Client, workspaceID, input, nil); err != nil {
+		return nil, err
+	}
+
+	tagIDs, err := resolveTagIDs(ctx, d.Client.Tag, workspaceID, input.Tags)
+	if err != nil {
+		return nil, err
+	}
+
+	created, err := newDebtCreate(d.Client.Debt, workspaceID, input).
+		AddTagIDs(tagIDs...).
 		Save(ctx)
 
 	if err != nil {
 		return nil, errs.FailedToSave("debts", err)
 	}
-	return newDebtResponse(created)
+	return d.GetDebtByID(ctx, created.ID)
+}
+
+// InsertDebts cria todos os débitos em uma única transação: ou todos são salvos, ou nenhum
+func (d *PostgreSQL) InsertDebts(ctx context.Context, inputs []models.Debt) ([]dto.DebtResponse, error) {
+	workspaceID, err := currentWorkspaceID(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	var created []*ent.Debt
+	err = d.WithTx(ctx, func(db interfaces.Database) error {
+		client := db.(*PostgreSQL).Client
+
+		// Validados na transação, somando os estornos anteriores do lote
+		refunds := refundBatch{}
+		for _, input := range inputs {
+			if err := checkDebtEdges(ctx, client, workspaceID, input, refunds); err != nil {
+				return err
+			}
+		}
+
+		builders := make([]*ent.DebtCreate, 0, len(inputs))
+		for _, input := range inputs {
+			tagIDs, err := resolveTagIDs(ctx, client.Tag, workspaceID, input.Tags)
+			if err != nil {
+				return err
+			}
+			builders = append(builders, newDebtCreate(client.Debt, workspaceID, input).AddTagIDs(tagIDs...))
+		}
+
+		created, err = client.Debt.CreateBulk(builders...).Save(ctx)
+		return err
+	})
+	if err != nil {
+		if errors.Is(err, errs.ErrUnprocessable) {
+			return nil, err
+		}
+		return nil, errs.FailedToSave("debts", err)
+	}
+	return newDebtResponseList(created)
+}
+
+// ValidateDebts faz as verificações da criação em cada débito sem salvá-los.
+// Como no InsertDebts, os estornos válidos contam para os seguintes da lista.
+// Retorna o erro de validação de cada débito (nil se válido); erros do banco
+// interrompem a validação.
+func (d *PostgreSQL) ValidateDebts(ctx context.Context, inputs []models.Debt) ([]error, error) {
+	workspaceID, err := currentWorkspaceID(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	refunds := refundBatch{}
+	invalid := make([]error, len(inputs))
+	for i, input := range inputs {
+		err := checkDebtEdges(ctx, d.Client, workspaceID, input, refunds)
+		if err != nil && !errors.Is(err, errs.ErrUnprocessable) {
+			return nil, err
+		}
+		invalid[i] = err
+	}
+	return invalid, nil
+}
+
+// DebtExists verifica se já existe um débito com o mesmo título, valor, data de compra e tipo
+func (d *PostgreSQL) DebtExists(ctx context.Context, input models.Debt) (bool, error) {
+	workspaceID, err := currentWorkspaceID(ctx)
+	if err != nil {
+		return false, err
+	}
+
+	return d.Client.Debt.Query().
+		Where(
+			debt.WorkspaceID(workspaceID),
+			debt.TitleEQ(input.Title),
+			debt.AmountEQ(input.Amount),
+			debt.PurchaseDateEQ(input.PurchaseDate),
+			debt.KindEQ(debt.Kind(input.Kind)),
+		).
+		Exist(ctx)
 }
 
 func (d *PostgreSQL) UpdateDebt(ctx context.Context, input models.Debt) (*dto.DebtResponse, error) {
-	updated, err := d.Client.Debt.
+	workspaceID, err := currentWorkspaceID(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := checkDebtEdges(ctx, d.Client, workspaceID, input, nil); err != nil {
+		return nil, err
+	}
+
+	update := d.Client.Debt.
 		UpdateOneID(input.ID).
+		Where(debt.WorkspaceID(workspaceID)).
 		SetTitle(input.Title).
 		SetAmount(input.Amount).
+		SetKind(debt.Kind(input.Kind)).
 		SetDueDate(input.DueDate).
 		SetPurchaseDate(input.PurchaseDate).
-		SetStatusID(*input.StatusID).
-		SetInvoiceID(*input.InvoiceID).
-		SetCategoryID(*input.CategoryID).
-		Save(ctx)
+		SetNillableStatusID(input.StatusID)
 
-	if err != nil {
+	if input.InvoiceID != nil {
+		update = update.SetInvoiceID(*input.InvoiceID)
+	} else {
+		update = update.ClearInvoice()
+	}
+
+	if input.CategoryID != nil {
+		update = update.SetCategoryID(*input.CategoryID)
+	} else {
+		update = update.ClearCategory()
+	}
+
+	if input.RefundOfID != nil {
+		update = update.SetRefundOfID(*input.RefundOfID)
+	} else {
+		update = update.ClearRefundOfID()
+	}
+
+	if input.Tags != nil {
+		tagIDs, err := resolveTagIDs(ctx, d.Client.Tag, workspaceID, input.Tags)
+		if err != nil {
+			return nil, err
+		}
+		update = update.ClearTags().AddTagIDs(tagIDs...)
+	}
+
+	if _, err := update.Save(ctx); err != nil {
 		if ent.IsNotFound(err) {
 			return nil, errs.ErrNotFound
 		}
 		return nil, errs.FailedToSave("debts", err)
 	}
-	return newDebtResponse(updated)
+	return d.GetDebtByID(ctx, input.ID)
 }
 
-func (d *PostgreSQL) ListDebts(ctx context.Context, flt dto.DebtFilters, pgn *pagination.Pagination) ([]dto.DebtResponse, error) {
+// ListDebts lista os débitos carregando apenas as colunas e os
+// relacionamentos usados pelos campos pedidos em fs e pela ordenação
+func (d *PostgreSQL) ListDebts(ctx context.Context, flt filter.Filters, pgn *pagination.Pagination, fs *fieldset.Fieldset) ([]dto.DebtResponse, error) {
+	workspaceID, err := currentWorkspaceID(ctx)
+	if err != nil {
+		return nil, err
+	}
+
 	query := d.Client.Debt.Query().
-		WithStatus().
-		WithCategory().
-		WithInvoice()
+		Where(debt.WorkspaceID(workspaceID))
 
-	query = applyDebtFilters(query, flt, pgn)
-	query = query.Order(ent.Desc(pgn.OrderBy))
-	query = query.Limit(pgn.PageSize).Offset(pgn.Offset())
+	sortFields := pgn.SortFields()
+	needs := func(fields ...string) bool {
+		return fs.Requires(fields...) || slices.ContainsFunc(fields, func(field string) bool {
+			return slices.Contains(sortFields, field)
+		})
+	}
+
+	if !fs.All() {
+		columns := []string{debt.FieldID}
+		for _, column := range debtColumns {
+			if needs(column) {
+				columns = append(columns, column)
+			}
+		}
+		query.Select(columns...)
+	}
+
+	if fs.Expands("status") || needs(debt.StatusColumn, "status") {
+		query = query.WithStatus()
+	}
+	if fs.Expands("category") || needs(debt.CategoryColumn, "category") {
+		query = query.WithCategory()
+	}
+	if fs.Expands("invoice") {
+		query = query.WithInvoice(func(q *ent.InvoiceQuery) {
+			q.WithStatus()
+		})
+	} else if needs(debt.InvoiceColumn, "invoice_title") {
+		query = query.WithInvoice()
+	}
+	if needs("tags") {
+		query = query.WithTags()
+	}
+
+	query = query.Where(debtSearch(pgn.Search), flt.Predicate(debtFilterFields))
+	query = query.Where(pgn.CursorPredicate(debtSortFields))
+	if rankBySearch(pgn) {
+		query = query.Order(searchRank(searchQuery(pgn.Search)))
+	}
+	query = query.Order(pgn.Order(debtSortFields))
+	query = query.Limit(pgn.Limit()).Offset(pgn.Offset())
 
 	data, err := query.All(ctx)
 	if err != nil {
 		return nil, err
 	}
 
-	return newDebtResponseList(data)
+	data, err = pagination.Trim(pgn, data, debtSortValue)
+	if err != nil {
+		return nil, err
+	}
+
+	response, err := newDebtResponseList(data)
+	if err != nil {
+		return nil, err
+	}
+
+	var invoiceTotals map[uuid.UUID]float64
+	if fs.Expands("invoice") {
+		var ids []uuid.UUID
+		for _, row := range data {
+			if row.Edges.Invoice != nil && !slices.Contains(ids, row.Edges.Invoice.ID) {
+				ids = append(ids, row.Edges.Invoice.ID)
+			}
+		}
+		if invoiceTotals, err = invoiceDebtTotals(ctx, d.Client, ids...); err != nil {
+			return nil, err
+		}
+	}
+
+	for i, row := range data {
+		response[i].Expanded = expandDebt(row, fs, invoiceTotals)
+	}
+	return response, nil
 }
 
-func (d *PostgreSQL) CountDebts(ctx context.Context, flt dto.DebtFilters, pgn *pagination.Pagination) (int, error) {
-	query := d.Client.Debt.Query()
-	query = applyDebtFilters(query, flt, pgn)
+func (d *PostgreSQL) CountDebts(ctx context.Context, flt filter.Filters, pgn *pagination.Pagination) (int, error) {
+	workspaceID, err := currentWorkspaceID(ctx)
+	if err != nil {
+		return 0, err
+	}
+
+	query := d.Client.Debt.Query().Where(debt.WorkspaceID(workspaceID))
+	query = query.Where(debtSearch(pgn.Search), flt.Predicate(debtFilterFields))
 
 	total, err := query.Count(ctx)
 	if err != nil {
@@ -107,6 +389,233 @@ func (d *PostgreSQL) CountDebts(ctx context.Context, flt dto.DebtFilters, pgn *p
 	return total, nil
 }
 
+// refundBatch soma, por débito original, os estornos de um lote que ainda não
+// foram salvos
+type refundBatch map[uuid.UUID]float64
+
+// checkDebtEdges garante que a fatura e a categoria informadas pertencem ao
+// workspace e que o status existe. Em lotes, refunds acumula os estornos já
+// validados; nos demais casos é nil.
+func checkDebtEdges(ctx context.Context, client *ent.Client, workspaceID uuid.UUID, input models.Debt, refunds refundBatch) error {
+	if input.StatusID != nil {
+		exists, err := client.PaymentStatus.Query().
+			Where(paymentstatus.ID(*input.StatusID)).
+			Exist(ctx)
+		if err != nil {
+			return err
+		}
+		if !exists {
+			return errs.InvalidParam("status_id", errs.ErrUnprocessable)
+		}
+	}
+
+	if input.InvoiceID != nil {
+		exists, err := client.Invoice.Query().
+			Where(invoice.ID(*input.InvoiceID), invoice.WorkspaceID(workspaceID)).
+			Exist(ctx)
+		if err != nil {
+			return err
+		}
+		if !exists {
+			return errs.InvalidParam("invoice_id", errs.ErrUnprocessable)
+		}
+	}
+
+	if input.CategoryID != nil {
+		exists, err := client.Category.Query().
+			Where(category.ID(*input.CategoryID), category.WorkspaceID(workspaceID)).
+			Exist(ctx)
+		if err != nil {
+			return err
+		}
+		if !exists {
+			return errs.InvalidParam("category_id", errs.ErrUnprocessable)
+		}
+	}
+	return checkDebtRefunds(ctx, client, workspaceID, input, refunds)
+}
+
+// checkDebtRefunds garante que um estorno aponta para um débito do workspace
+// que não é outro estorno, e que a soma dos estornos não ultrapassa o valor
+// do débito original, contando os estornos pendentes do lote.
+func checkDebtRefunds(ctx context.Context, client *ent.Client, workspaceID uuid.UUID, input models.Debt, refunds refundBatch) error {
+	if input.ID != uuid.Nil {
+		refunded, err := sumRefunds(ctx, client, input.ID, uuid.Nil)
+		if err != nil {
+			return err
+		}
+		if refunded > 0 && input.Kind == models.DebtKindRefund {
+			return errs.InvalidParam("kind", errs.ErrUnprocessable)
+		}
+		if refunded > input.Amount {
+			return errs.InvalidParam("amount", errs.ErrUnprocessable)
+		}
+	}
+
+	if input.RefundOfID == nil {
+		return nil
+	}
+
+	if *input.RefundOfID == input.ID {
+		return errs.InvalidParam("refund_of_id", errs.ErrUnprocessable)
+	}
+
+	original, err := client.Debt.Query().
+		Where(debt.ID(*input.RefundOfID), debt.WorkspaceID(workspaceID)).
+		Only(ctx)
+	if err != nil {
+		if ent.IsNotFound(err) {
+			return errs.InvalidParam("refund_of_id", errs.ErrUnprocessable)
+		}
+		return err
+	}
+	if original.Kind == debt.KindRefund {
+		return errs.InvalidParam("refund_of_id", errs.ErrUnprocessable)
+	}
+
+	refunded, err := sumRefunds(ctx, client, original.ID, input.ID)
+	if err != nil {
+		return err
+	}
+	pending := refunds[original.ID]
+	if utils.RoundCents(refunded+pending+input.Amount) > original.Amount {
+		return errs.InvalidParam("amount", errs.ErrUnprocessable)
+	}
+	if refunds != nil {
+		refunds[original.ID] = utils.RoundCents(pending + input.Amount)
+	}
+	return nil
+}
+
+// sumRefunds soma os estornos de um débito, ignorando o estorno informado em except
+func sumRefunds(ctx context.Context, client *ent.Client, id uuid.UUID, except uuid.UUID) (float64, error) {
+	amounts, err := client.Debt.Query().
+		Where(debt.RefundOfID(id), debt.IDNEQ(except)).
+		Select(debt.FieldAmount).
+		Float64s(ctx)
+	if err != nil {
+		return 0, err
+	}
+
+	var total float64
+	for _, amount := range amounts {
+		total += amount
+	}
+	return utils.RoundCents(total), nil
+}
+
+func newDebtCreate(client *ent.DebtClient, workspaceID uuid.UUID, input models.Debt) *ent.DebtCreate {
+	return client.
+		Create().
+		SetWorkspaceID(workspaceID).
+		SetTitle(input.Title).
+		SetAmount(input.Amount).
+		SetKind(debt.Kind(input.Kind)).
+		SetNillableRefundOfID(input.RefundOfID).
+		SetDueDate(input.DueDate).
+		SetPurchaseDate(input.PurchaseDate).
+		SetNillableStatusID(input.StatusID).
+		SetNillableInvoiceID(input.InvoiceID).
+		SetNillableCategoryID(input.CategoryID)
+}
+
+// debtColumns são as colunas da tabela de débitos que aparecem na resposta
+var debtColumns = []string{
+	debt.FieldTitle,
+	debt.FieldAmount,
+	debt.FieldKind,
+	debt.FieldPurchaseDate,
+	debt.FieldDueDate,
+	debt.FieldRefundOfID,
+	debt.FieldCreatedAt,
+	debt.FieldUpdatedAt,
+	debt.FieldDeletedAt,
+	debt.FieldVersion,
+}
+
+// expandDebt monta os objetos relacionados pedidos em expand. invoiceTotals
+// traz o total dos débitos de cada fatura expandida.
+func expandDebt(row *ent.Debt, fs *fieldset.Fieldset, invoiceTotals map[uuid.UUID]float64) *dto.DebtExpanded {
+	var expanded dto.DebtExpanded
+	var found bool
+
+	if fs.Expands("invoice") && row.Edges.Invoice != nil {
+		response := mapInvoiceToResponse(row.Edges.Invoice, invoiceTotals[row.Edges.Invoice.ID])
+		expanded.Invoice, found = &response, true
+	}
+	if fs.Expands("category") && row.Edges.Category != nil {
+		response := mapCategoryToResponse(row.Edges.Category)
+		expanded.Category, found = &response, true
+	}
+	if fs.Expands("status") && row.Edges.Status != nil {
+		response := mapPaymentStatusToResponse(row.Edges.Status)
+		expanded.Status, found = &response, true
+	}
+
+	if !found {
+		return nil
+	}
+	return &expanded
+}
+
+// debtSortFields são os campos de ordenação vindos dos relacionamentos
+var debtSortFields = map[string]pagination.SortField{
+	"category":      pagination.EdgeField(category.Table, category.FieldName, debt.CategoryColumn, category.DeletedAtIsNil()),
+	"status":        pagination.EdgeField(paymentstatus.Table, paymentstatus.FieldName, debt.StatusColumn),
+	"invoice_title": pagination.EdgeField(invoice.Table, invoice.FieldTitle, debt.InvoiceColumn, invoice.DeletedAtIsNil()),
+}
+
+// debtSortValue retorna o valor de uma coluna de ordenação, usado no cursor
+func debtSortValue(row *ent.Debt, column string) any {
+	switch column {
+	case debt.FieldDeletedAt:
+		return row.DeletedAt
+	case debt.FieldTitle:
+		return row.Title
+	case debt.FieldAmount:
+		return row.Amount
+	case debt.FieldPurchaseDate:
+		return row.PurchaseDate
+	case debt.FieldDueDate:
+		return row.DueDate
+	case debt.FieldCreatedAt:
+		return row.CreatedAt
+	case debt.FieldUpdatedAt:
+		return row.UpdatedAt
+	case debt.InvoiceColumn:
+		if row.Edges.Invoice != nil {
+			return row.Edges.Invoice.ID
+		}
+		return nil
+	case "invoice_title":
+		if row.Edges.Invoice != nil {
+			return row.Edges.Invoice.Title
+		}
+		return nil
+	case "category":
+		if row.Edges.Category != nil {
+			return row.Edges.Category.Name
+		}
+		return nil
+	case "status":
+		if row.Edges.Status != nil {
+			return row.Edges.Status.Name
+		}
+		return nil
+	case debt.CategoryColumn:
+		if row.Edges.Category != nil {
+			return row.Edges.Category.ID
+		}
+		return nil
+	case debt.StatusColumn:
+		if row.Edges.Status != nil {
+			return row.Edges.Status.ID
+		}
+		return nil
+	}
+	return row.ID
+}
+
 func mapDebtToResponse(row *ent.Debt) dto.DebtResponse {
 	var categoryID *uuid.UUID
 	var categoryName *string
@@ -114,6 +623,7 @@ func mapDebtToResponse(row *ent.Debt) dto.DebtResponse {
 	var invoiceTitle *string
 	var statusID *uuid.UUID
 	var statusName *string
+	tags := make([]string, 0, len(row.Edges.Tags))
 
 	if row.Edges.Category != nil {
 		categoryID = &row.Edges.Category.ID
@@ -130,20 +640,29 @@ func mapDebtToResponse(row *ent.Debt) dto.DebtResponse {
 		statusName = &row.Edges.Status.Name
 	}
 
+	for _, t := range row.Edges.Tags {
+		tags = append(tags, t.Name)
+	}
+
 	return dto.DebtResponse{
 		ID:           row.ID,
 		Title:        row.Title,
 		Amount:       row.Amount,
+		Kind:         string(row.Kind),
+		RefundOfID:   row.RefundOfID,
 		PurchaseDate: *utils.ToFormatDateTimePointer(row.PurchaseDate),
 		DueDate:      utils.ToFormatDatePointer(row.DueDate),
 		CategoryID:   categoryID,
 		Category:     categoryName,
 		StatusID:     statusID,
 		Status:       statusName,
+		Tags:         tags,
 		CreatedAt:    *utils.ToFormatDateTimePointer(row.CreatedAt),
 		UpdatedAt:    *utils.ToFormatDateTimePointer(row.UpdatedAt),
+		DeletedAt:    formatDeletedAt(row.DeletedAt),
 		InvoiceID:    invoiceID,
 		InvoiceTitle: invoiceTitle,
+		Version:      row.Version,
 	}
 }
 
@@ -166,65 +685,42 @@ func newDebtResponseList(rows []*ent.Debt) ([]dto.DebtResponse, error) {
 	return response, nil
 }
 
-func applyDebtFilters(query *ent.DebtQuery, flt dto.DebtFilters, pgn *pagination.Pagination) *ent.DebtQuery {
-	if pgn.Search != "" {
-		query = query.Where(
-			debt.Or(
-				debt.TitleContainsFold(pgn.Search),
-				debt.HasStatusWith(
-					paymentstatus.NameContainsFold(pgn.Search),
-				),
-				debt.HasCategoryWith(
-					category.NameContainsFold(pgn.Search),
-				),
-				debt.HasInvoiceWith(
-					invoice.TitleContains(pgn.Search),
-				),
-			),
-		)
-	}
-
-	if flt.StatusID != nil {
-		statusIds := utils.ToUUIDSlice(*flt.StatusID)
-		if len(statusIds) > 0 {
-			query = query.Where(
-				debt.HasStatusWith(paymentstatus.IDIn(statusIds...)),
-			)
+// debtFilterFields são os filtros que não são colunas da tabela de débitos
+var debtFilterFields = map[string]filter.Custom{
+	"tag": func(c filter.Condition) func(*sql.Selector) {
+		if c.Op == filter.OpNull {
+			if c.Value.(bool) {
+				return debt.Not(debt.HasTags())
+			}
+			return debt.HasTags()
 		}
-	}
-	if flt.CategoryID != nil {
-		categoryIds := utils.ToUUIDSlice(*flt.CategoryID)
-		if len(categoryIds) > 0 {
-			query = query.Where(
-				debt.HasCategoryWith(category.IDIn(categoryIds...)),
-			)
-		}
-	}
-	if flt.InvoiceID != nil {
-		invoiceIds := utils.ToUUIDSlice(*flt.InvoiceID)
-		if len(invoiceIds) > 0 {
-			query = query.Where(
-				debt.HasInvoiceWith(invoice.IDIn(invoiceIds...)),
-			)
+
+		positive, negated := c.Positive()
+		has := debt.HasTagsWith(positive.Predicate(tag.FieldName))
+		if negated {
+			return debt.Not(has)
 		}
-	}
-	if flt.MinAmount != nil {
-		query = query.Where(
-			debt.AmountGTE(*flt.MinAmount),
-		)
-	}
-	if flt.MaxAmount != nil {
-		query = query.Where(
-			debt.AmountLTE(*flt.MaxAmount),
-		)
-	}
-	if t := utils.ToTimePointer(flt.StartDate); t != nil {
-		query = query.Where(debt.PurchaseDateGTE(*t))
-	}
+		return has
+	},
+}
 
-	if t := utils.ToTimePointer(flt.EndDate); t != nil {
-		query = query.Where(debt.PurchaseDateLTE(*t))
+// debtSearch busca o texto, sem diferenciar acentos, no título do débito e
+// nos nomes do status, da categoria e da fatura
+func debtSearch(search string) predicate.Debt {
+	query := searchQuery(search)
+	if query == "" {
+		return func(*sql.Selector) {}
 	}
-
-	return query
+	return debt.Or(
+		searchVectorMatches(query),
+		debt.HasStatusWith(
+			textMatches(paymentstatus.FieldName, query),
+		),
+		debt.HasCategoryWith(
+			textMatches(category.FieldName, query),
+		),
+		debt.HasInvoiceWith(
+			searchVectorMatches(query),
+		),
+	)
 }
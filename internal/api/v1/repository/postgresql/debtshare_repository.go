@@ -0,0 +1,140 @@
+package postgresql
+
+import (
+	"backend-go/internal/api/errs"
+	"backend-go/internal/api/v1/dto"
+	"backend-go/internal/api/v1/repository/models"
+	"backend-go/pkg/ent"
+	"backend-go/pkg/ent/debt"
+	"backend-go/pkg/ent/debtshare"
+	"backend-go/pkg/ent/person"
+	"context"
+
+	"github.com/google/uuid"
+)
+
+func (d *PostgreSQL) ListDebtShares(ctx context.Context, debtID uuid.UUID) ([]dto.DebtShareResponse, error) {
+	workspaceID, err := currentWorkspaceID(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	exists, err := d.Client.Debt.Query().
+		Where(debt.ID(debtID), debt.WorkspaceID(workspaceID)).
+		Exist(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if !exists {
+		return nil, errs.ErrNotFound
+	}
+
+	return listDebtShares(ctx, d.Client.DebtShare, debtID)
+}
+
+// ReplaceDebtShares substitui todas as divisões do débito em uma única transação
+func (d *PostgreSQL) ReplaceDebtShares(ctx context.Context, debtID uuid.UUID, inputs []models.DebtShare) ([]dto.DebtShareResponse, error) {
+	workspaceID, err := currentWorkspaceID(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	tx, err := d.Client.Tx(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	exists, err := tx.Debt.Query().
+		Where(debt.ID(debtID), debt.WorkspaceID(workspaceID)).
+		Exist(ctx)
+	if err != nil {
+		tx.Rollback()
+		return nil, err
+	}
+	if !exists {
+		tx.Rollback()
+		return nil, errs.ErrNotFound
+	}
+
+	personIDs := make([]uuid.UUID, 0, len(inputs))
+	for _, input := range inputs {
+		personIDs = append(personIDs, input.PersonID)
+	}
+
+	found, err := tx.Person.Query().
+		Where(person.IDIn(personIDs...), person.WorkspaceID(workspaceID)).
+		Count(ctx)
+	if err != nil {
+		tx.Rollback()
+		return nil, err
+	}
+	if found != len(personIDs) {
+		tx.Rollback()
+		return nil, errs.InvalidParam("person_id", errs.ErrUnprocessable)
+	}
+
+	if _, err := tx.DebtShare.Delete().Where(debtshare.DebtID(debtID)).Exec(ctx); err != nil {
+		tx.Rollback()
+		return nil, err
+	}
+
+	builders := make([]*ent.DebtShareCreate, 0, len(inputs))
+	for _, input := range inputs {
+		builders = append(builders, tx.DebtShare.Create().
+			SetDebtID(debtID).
+			SetPersonID(input.PersonID).
+			SetAmount(input.Amount).
+			SetNillablePercentage(input.Percentage).
+			SetSettled(input.Settled),
+		)
+	}
+
+	if len(builders) > 0 {
+		if _, err := tx.DebtShare.CreateBulk(builders...).Save(ctx); err != nil {
+			tx.Rollback()
+			return nil, errs.FailedToSave("debt_shares", err)
+		}
+	}
+
+	response, err := listDebtShares(ctx, tx.DebtShare, debtID)
+	if err != nil {
+		tx.Rollback()
+		return nil, err
+	}
+
+	if err := tx.Commit(); err != nil {
+		return nil, err
+	}
+	return response, nil
+}
+
+func listDebtShares(ctx context.Context, client *ent.DebtShareClient, debtID uuid.UUID) ([]dto.DebtShareResponse, error) {
+	rows, err := client.Query().
+		Where(debtshare.DebtID(debtID)).
+		WithPerson().
+		Order(ent.Asc(debtshare.FieldCreatedAt)).
+		All(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	response := make([]dto.DebtShareResponse, 0, len(rows))
+	for _, row := range rows {
+		response = append(response, mapDebtShareToResponse(row))
+	}
+	return response, nil
+}
+
+func mapDebtShareToResponse(row *ent.DebtShare) dto.DebtShareResponse {
+	response := dto.DebtShareResponse{
+		ID:         row.ID,
+		PersonID:   row.PersonID,
+		Amount:     row.Amount,
+		Percentage: row.Percentage,
+		Settled:    row.Settled,
+	}
+	if row.Edges.Person != nil {
+		response.Person = row.Edges.Person.Name
+	}
+	return response
+}
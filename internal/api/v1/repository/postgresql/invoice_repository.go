@@ -5,89 +5,242 @@ import (
 	"backend-go/internal/api/v1/dto"
 	"backend-go/internal/api/v1/repository/models"
 	"backend-go/pkg/ent"
+	"backend-go/pkg/ent/debt"
 	"backend-go/pkg/ent/invoice"
 	"backend-go/pkg/ent/paymentstatus"
+	"backend-go/pkg/ent/predicate"
+	"backend-go/pkg/filter"
+	"backend-go/pkg/hooks"
 	"backend-go/pkg/pagination"
 	"backend-go/pkg/utils"
 	"context"
+	"fmt"
 
+	"entgo.io/ent/dialect/sql"
 	"github.com/google/uuid"
 )
 
 func (d *PostgreSQL) GetInvoiceByID(ctx context.Context, id uuid.UUID) (*dto.InvoiceResponse, error) {
-	row, err := d.Client.Invoice.Get(ctx, id)
+	workspaceID, err := currentWorkspaceID(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	row, err := d.Client.Invoice.Query().
+		Where(invoice.ID(id), invoice.WorkspaceID(workspaceID)).
+		WithStatus().
+		Only(ctx)
 	if err != nil {
 		if ent.IsNotFound(err) {
 			return nil, errs.ErrNotFound
 		}
 		return nil, err
 	}
-	return newInvoiceResponse(row)
+
+	totals, err := invoiceDebtTotals(ctx, d.Client, row.ID)
+	if err != nil {
+		return nil, err
+	}
+	return newInvoiceResponse(row, totals)
 }
 
+// DeleteInvoiceByID remove a fatura. Faturas com débitos fora da lixeira não
+// podem ser removidas e retornam ErrConflict.
 func (d *PostgreSQL) DeleteInvoiceByID(ctx context.Context, id uuid.UUID) error {
-	err := d.Client.Invoice.DeleteOneID(id).Exec(ctx)
+	workspaceID, err := currentWorkspaceID(ctx)
+	if err != nil {
+		return err
+	}
+
+	// Sem a verificação, os débitos deixariam de exibir a fatura e a limpeza
+	// da lixeira apagaria a referência deles
+	inUse, err := d.Client.Debt.Query().
+		Where(debt.WorkspaceID(workspaceID), debt.HasInvoiceWith(invoice.ID(id)), debt.DeletedAtIsNil()).
+		Exist(ctx)
+	if err != nil {
+		return err
+	}
+	if inUse {
+		return errs.ErrConflict
+	}
+
+	deleted, err := d.Client.Invoice.Delete().
+		Where(invoice.ID(id), invoice.WorkspaceID(workspaceID)).
+		Exec(ctx)
 	if err != nil {
-		if ent.IsNotFound(err) {
-			return errs.ErrNotFound
-		}
 		return err
 	}
+	if deleted == 0 {
+		return errs.ErrNotFound
+	}
 	return nil
 }
 
+// ListDeletedInvoices lista as faturas da lixeira, da remoção mais recente para a mais antiga
+func (d *PostgreSQL) ListDeletedInvoices(ctx context.Context, pgn *pagination.Pagination) ([]dto.InvoiceResponse, error) {
+	workspaceID, err := currentWorkspaceID(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	ctx = hooks.IncludeDeleted(ctx)
+	data, err := d.Client.Invoice.Query().
+		Where(invoice.WorkspaceID(workspaceID), invoice.DeletedAtNotNil()).
+		WithStatus().
+		Where(pgn.CursorPredicate(nil)).
+		Order(pgn.Order(nil)).
+		Limit(pgn.Limit()).
+		Offset(pgn.Offset()).
+		All(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	data, err = pagination.Trim(pgn, data, invoiceSortValue)
+	if err != nil {
+		return nil, err
+	}
+
+	totals, err := invoiceDebtTotals(ctx, d.Client, invoiceIDs(data)...)
+	if err != nil {
+		return nil, err
+	}
+	return newInvoiceResponseList(data, totals)
+}
+
+func (d *PostgreSQL) CountDeletedInvoices(ctx context.Context) (int, error) {
+	workspaceID, err := currentWorkspaceID(ctx)
+	if err != nil {
+		return 0, err
+	}
+
+	return d.Client.Invoice.Query().
+		Where(invoice.WorkspaceID(workspaceID), invoice.DeletedAtNotNil()).
+		Count(hooks.IncludeDeleted(ctx))
+}
+
+// RestoreInvoiceByID tira a fatura da lixeira
+func (d *PostgreSQL) RestoreInvoiceByID(ctx context.Context, id uuid.UUID) (*dto.InvoiceResponse, error) {
+	workspaceID, err := currentWorkspaceID(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	restored, err := d.Client.Invoice.Update().
+		Where(invoice.ID(id), invoice.WorkspaceID(workspaceID), invoice.DeletedAtNotNil()).
+		ClearDeletedAt().
+		Save(hooks.IncludeDeleted(ctx))
+	if err != nil {
+		return nil, errs.FailedToSave("invoices", err)
+	}
+	if restored == 0 {
+		return nil, errs.ErrNotFound
+	}
+	return d.GetInvoiceByID(ctx, id)
+}
+
 func (d *PostgreSQL) InsertInvoice(ctx context.Context, input models.Invoice) (*dto.InvoiceResponse, error) {
+	workspaceID, err := currentWorkspaceID(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := checkInvoiceEdges(ctx, d.Client, input); err != nil {
+		return nil, err
+	}
+
 	created, err := d.Client.Invoice.
 		Create().
+		SetWorkspaceID(workspaceID).
 		SetTitle(input.Title).
 		SetAmount(input.Amount).
 		SetIssueDate(input.IssueDate).
 		SetDueDate(input.DueDate).
+		SetNillableStatusID(input.StatusID).
 		Save(ctx)
 
 	if err != nil {
 		return nil, errs.FailedToSave("invoices", err)
 	}
-	return newInvoiceResponse(created)
+	return d.GetInvoiceByID(ctx, created.ID)
 }
 
 func (d *PostgreSQL) UpdateInvoice(ctx context.Context, input models.Invoice) (*dto.InvoiceResponse, error) {
-	updated, err := d.Client.Invoice.
+	workspaceID, err := currentWorkspaceID(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := checkInvoiceEdges(ctx, d.Client, input); err != nil {
+		return nil, err
+	}
+
+	update := d.Client.Invoice.
 		UpdateOneID(input.ID).
+		Where(invoice.WorkspaceID(workspaceID)).
 		SetTitle(input.Title).
 		SetAmount(input.Amount).
 		SetIssueDate(input.IssueDate).
-		SetDueDate(input.DueDate).
-		SetStatusID(input.StatusID).
-		Save(ctx)
+		SetDueDate(input.DueDate)
 
-	if err != nil {
+	if input.StatusID != nil {
+		update = update.SetStatusID(*input.StatusID)
+	} else {
+		update = update.ClearStatus()
+	}
+
+	if _, err := update.Save(ctx); err != nil {
 		if ent.IsNotFound(err) {
 			return nil, errs.ErrNotFound
 		}
 		return nil, errs.FailedToSave("invoices", err)
 	}
-	return newInvoiceResponse(updated)
+	return d.GetInvoiceByID(ctx, input.ID)
 }
 
-func (d *PostgreSQL) ListInvoices(ctx context.Context, flt dto.InvoiceFilters, pgn *pagination.Pagination) ([]dto.InvoiceResponse, error) {
-	query := d.Client.Invoice.Query()
+func (d *PostgreSQL) ListInvoices(ctx context.Context, flt filter.Filters, pgn *pagination.Pagination) ([]dto.InvoiceResponse, error) {
+	workspaceID, err := currentWorkspaceID(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	query := d.Client.Invoice.Query().
+		Where(invoice.WorkspaceID(workspaceID)).
+		WithStatus()
 
-	query = applyInvoiceFilters(query, flt, pgn)
-	query = query.Order(ent.Desc(pgn.OrderBy))
-	query = query.Limit(pgn.PageSize).Offset(pgn.Offset())
+	query = query.Where(invoiceSearch(pgn.Search), flt.Predicate(nil))
+	query = query.Where(pgn.CursorPredicate(invoiceSortFields))
+	if rankBySearch(pgn) {
+		query = query.Order(searchRank(searchQuery(pgn.Search)))
+	}
+	query = query.Order(pgn.Order(invoiceSortFields))
+	query = query.Limit(pgn.Limit()).Offset(pgn.Offset())
 
 	data, err := query.All(ctx)
 	if err != nil {
 		return nil, err
 	}
 
-	return newInvoiceResponseList(data)
+	data, err = pagination.Trim(pgn, data, invoiceSortValue)
+	if err != nil {
+		return nil, err
+	}
+
+	totals, err := invoiceDebtTotals(ctx, d.Client, invoiceIDs(data)...)
+	if err != nil {
+		return nil, err
+	}
+	return newInvoiceResponseList(data, totals)
 }
 
-func (d *PostgreSQL) CountInvoices(ctx context.Context, flt dto.InvoiceFilters, pgn *pagination.Pagination) (int, error) {
-	query := d.Client.Invoice.Query()
-	query = applyInvoiceFilters(query, flt, pgn)
+func (d *PostgreSQL) CountInvoices(ctx context.Context, flt filter.Filters, pgn *pagination.Pagination) (int, error) {
+	workspaceID, err := currentWorkspaceID(ctx)
+	if err != nil {
+		return 0, err
+	}
+
+	query := d.Client.Invoice.Query().Where(invoice.WorkspaceID(workspaceID))
+	query = query.Where(invoiceSearch(pgn.Search), flt.Predicate(nil))
 
 	total, err := query.Count(ctx)
 	if err != nil {
@@ -96,7 +249,61 @@ func (d *PostgreSQL) CountInvoices(ctx context.Context, flt dto.InvoiceFilters,
 	return total, nil
 }
 
-func mapInvoiceToResponse(row *ent.Invoice) dto.InvoiceResponse {
+// checkInvoiceEdges garante que o status informado existe
+func checkInvoiceEdges(ctx context.Context, client *ent.Client, input models.Invoice) error {
+	if input.StatusID == nil {
+		return nil
+	}
+
+	exists, err := client.PaymentStatus.Query().
+		Where(paymentstatus.ID(*input.StatusID)).
+		Exist(ctx)
+	if err != nil {
+		return err
+	}
+	if !exists {
+		return errs.InvalidParam("status_id", errs.ErrUnprocessable)
+	}
+	return nil
+}
+
+// invoiceSortFields são os campos de ordenação vindos dos relacionamentos
+var invoiceSortFields = map[string]pagination.SortField{
+	"status": pagination.EdgeField(paymentstatus.Table, paymentstatus.FieldName, invoice.StatusColumn),
+}
+
+// invoiceSortValue retorna o valor de uma coluna de ordenação, usado no cursor
+func invoiceSortValue(row *ent.Invoice, column string) any {
+	switch column {
+	case invoice.FieldDeletedAt:
+		return row.DeletedAt
+	case invoice.FieldTitle:
+		return row.Title
+	case invoice.FieldAmount:
+		return row.Amount
+	case invoice.FieldIssueDate:
+		return row.IssueDate
+	case invoice.FieldDueDate:
+		return row.DueDate
+	case invoice.FieldCreatedAt:
+		return row.CreatedAt
+	case invoice.FieldUpdatedAt:
+		return row.UpdatedAt
+	case invoice.StatusColumn:
+		if row.Edges.Status != nil {
+			return row.Edges.Status.ID
+		}
+		return nil
+	case "status":
+		if row.Edges.Status != nil {
+			return row.Edges.Status.Name
+		}
+		return nil
+	}
+	return row.ID
+}
+
+func mapInvoiceToResponse(row *ent.Invoice, debtsTotal float64) dto.InvoiceResponse {
 	var statusID *uuid.UUID
 	var statusName *string
 
@@ -106,73 +313,97 @@ func mapInvoiceToResponse(row *ent.Invoice) dto.InvoiceResponse {
 	}
 
 	return dto.InvoiceResponse{
-		ID:        row.ID,
-		Title:     row.Title,
-		Amount:    row.Amount,
-		IssueDate: *utils.ToFormatDatePointer(row.IssueDate),
-		DueDate:   utils.ToFormatDatePointer(row.DueDate),
-		CreatedAt: *utils.ToFormatDateTimePointer(row.CreatedAt),
-		UpdatedAt: *utils.ToFormatDateTimePointer(row.UpdatedAt),
-		StatusID:  statusID,
-		Status:    statusName,
+		ID:         row.ID,
+		Title:      row.Title,
+		Amount:     row.Amount,
+		IssueDate:  *utils.ToFormatDatePointer(row.IssueDate),
+		DueDate:    utils.ToFormatDatePointer(row.DueDate),
+		CreatedAt:  *utils.ToFormatDateTimePointer(row.CreatedAt),
+		UpdatedAt:  *utils.ToFormatDateTimePointer(row.UpdatedAt),
+		StatusID:   statusID,
+		Status:     statusName,
+		DebtsTotal: debtsTotal,
+		DeletedAt:  formatDeletedAt(row.DeletedAt),
+		Version:    row.Version,
 	}
 }
 
-func newInvoiceResponse(row *ent.Invoice) (*dto.InvoiceResponse, error) {
+// invoiceDebtTotals soma no banco, em uma única consulta agrupada, os débitos
+// de cada fatura, com os estornos subtraídos. Faturas sem débitos ficam fora
+// do mapa.
+func invoiceDebtTotals(ctx context.Context, client *ent.Client, ids ...uuid.UUID) (map[uuid.UUID]float64, error) {
+	totals := make(map[uuid.UUID]float64, len(ids))
+	if len(ids) == 0 {
+		return totals, nil
+	}
+
+	values := make([]any, len(ids))
+	for i, id := range ids {
+		values[i] = id
+	}
+
+	var rows []struct {
+		InvoiceID uuid.UUID `json:"invoice_id"`
+		Total     float64   `json:"total"`
+	}
+	err := client.Debt.Query().
+		Where(func(s *sql.Selector) {
+			s.Where(sql.In(s.C(debt.InvoiceColumn), values...))
+		}).
+		GroupBy(debt.InvoiceColumn).
+		Aggregate(func(s *sql.Selector) string {
+			amount := s.C(debt.FieldAmount)
+			return sql.As(fmt.Sprintf("SUM(CASE WHEN %s = '%s' THEN -%s ELSE %s END)", s.C(debt.FieldKind), debt.KindRefund, amount, amount), "total")
+		}).
+		Scan(ctx, &rows)
+	if err != nil {
+		return nil, err
+	}
+
+	for _, row := range rows {
+		totals[row.InvoiceID] = utils.RoundCents(row.Total)
+	}
+	return totals, nil
+}
+
+func invoiceIDs(rows []*ent.Invoice) []uuid.UUID {
+	ids := make([]uuid.UUID, len(rows))
+	for i, row := range rows {
+		ids[i] = row.ID
+	}
+	return ids
+}
+
+func newInvoiceResponse(row *ent.Invoice, totals map[uuid.UUID]float64) (*dto.InvoiceResponse, error) {
 	if row == nil {
 		return nil, nil
 	}
-	response := mapInvoiceToResponse(row)
+	response := mapInvoiceToResponse(row, totals[row.ID])
 	return &response, nil
 }
 
-func newInvoiceResponseList(rows []*ent.Invoice) ([]dto.InvoiceResponse, error) {
+func newInvoiceResponseList(rows []*ent.Invoice, totals map[uuid.UUID]float64) ([]dto.InvoiceResponse, error) {
 	if rows == nil {
 		return nil, nil
 	}
 	response := make([]dto.InvoiceResponse, 0, len(rows))
 	for _, row := range rows {
-		response = append(response, mapInvoiceToResponse(row))
+		response = append(response, mapInvoiceToResponse(row, totals[row.ID]))
 	}
 	return response, nil
 }
 
-func applyInvoiceFilters(query *ent.InvoiceQuery, flt dto.InvoiceFilters, pgn *pagination.Pagination) *ent.InvoiceQuery {
-	if pgn.Search != "" {
-		query = query.Where(
-			invoice.Or(
-				invoice.TitleContainsFold(pgn.Search),
-				invoice.HasStatusWith(
-					paymentstatus.NameContainsFold(pgn.Search),
-				),
-			),
-		)
-	}
-	if flt.StatusID != nil {
-		statusIds := utils.ToUUIDSlice(*flt.StatusID)
-		if len(statusIds) > 0 {
-			query = query.Where(
-				invoice.HasStatusWith(paymentstatus.IDIn(statusIds...)),
-			)
-		}
-	}
-	if flt.MinAmount != nil {
-		query = query.Where(
-			invoice.AmountGTE(*flt.MinAmount),
-		)
-	}
-	if flt.MaxAmount != nil {
-		query = query.Where(
-			invoice.AmountLTE(*flt.MaxAmount),
-		)
-	}
-	if t := utils.ToTimePointer(flt.StartDate); t != nil {
-		query = query.Where(invoice.IssueDateGTE(*t))
+// invoiceSearch busca o texto, sem diferenciar acentos, no título da fatura
+// e no nome do status
+func invoiceSearch(search string) predicate.Invoice {
+	query := searchQuery(search)
+	if query == "" {
+		return func(*sql.Selector) {}
 	}
-
-	if t := utils.ToTimePointer(flt.EndDate); t != nil {
-		query = query.Where(invoice.IssueDateLTE(*t))
-	}
-
-	return query
+	return invoice.Or(
+		searchVectorMatches(query),
+		invoice.HasStatusWith(
+			textMatches(paymentstatus.FieldName, query),
+		),
+	)
 }
@@ -1,50 +1,149 @@
 package postgresql
 
 import (
+	"backend-go/internal/api/config"
+	"backend-go/internal/api/errs"
+	"backend-go/internal/api/v1/repository/interfaces"
+	"backend-go/pkg/auth"
 	"backend-go/pkg/ent"
+	"backend-go/pkg/ent/hook"
 	"backend-go/pkg/hooks"
+	"backend-go/pkg/metrics"
+	"backend-go/pkg/tracing"
 	"context"
+	stdsql "database/sql"
 	"fmt"
-	"log"
-	"time"
+	"log/slog"
 
 	"entgo.io/ent/dialect"
 	"entgo.io/ent/dialect/sql"
+	"github.com/google/uuid"
 	_ "github.com/lib/pq"
 )
 
 type PostgreSQL struct {
 	Client *ent.Client
+	// Driver do ent, usado no Ping
+	driver *sql.Driver
+	// Indica que o Client está ligado a uma transação aberta pelo WithTx
+	inTx bool
 }
 
-func NewPostgreSQL(dsn string) (*PostgreSQL, error) {
-	drv, err := sql.Open(dialect.Postgres, dsn)
+func NewPostgreSQL(cfg config.DatabaseConfig) (*PostgreSQL, error) {
+	drv, err := sql.Open(dialect.Postgres, cfg.DSN(cfg.Name))
 	if err != nil {
 		return nil, fmt.Errorf("erro ao abrir conexão com o banco: %w", err)
 	}
 
-	client := ent.NewClient(ent.Driver(drv))
+	db := drv.DB()
+	db.SetMaxOpenConns(cfg.MaxOpenConns)
+	db.SetMaxIdleConns(cfg.MaxIdleConns)
+	db.SetConnMaxLifetime(cfg.ConnMaxLifetime)
+	db.SetConnMaxIdleTime(cfg.ConnMaxIdleTime)
+
+	// O driver com métricas e traces é usado nas consultas; o original, no Ping
+	var queryDriver dialect.Driver = tracing.NewDriver(metrics.NewDriver(drv))
+
+	// No nível debug, o SQL de cada consulta é logado junto com o request_id
+	// da requisição que a originou
+	if slog.Default().Enabled(context.Background(), slog.LevelDebug) {
+		queryDriver = dialect.DebugWithContext(queryDriver, func(ctx context.Context, args ...any) {
+			slog.DebugContext(ctx, "consulta ao banco", "sql", fmt.Sprint(args...))
+		})
+	}
+
+	client := ent.NewClient(ent.Driver(queryDriver))
 
 	// TODO: ver como isso funciona na pratica depois
 	// Colocar em outro lugar??
-	client.Debt.Use(hooks.SetDefaultStatusHook(client))
+	client.Debt.Use(hook.On(hooks.SetDefaultStatusHook(client), ent.OpCreate))
 
-	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
-	defer cancel()
+	// Débitos, faturas, categorias e pessoas vão para a lixeira ao serem removidos
+	client.Debt.Use(hooks.SoftDeleteHook())
+	client.Debt.Intercept(hooks.SoftDeleteInterceptor())
+	client.Invoice.Use(hooks.SoftDeleteHook())
+	client.Invoice.Intercept(hooks.SoftDeleteInterceptor())
+	client.Category.Use(hooks.SoftDeleteHook())
+	client.Category.Intercept(hooks.SoftDeleteInterceptor())
+	client.Person.Use(hooks.SoftDeleteHook())
+	client.Person.Intercept(hooks.SoftDeleteInterceptor())
 
-	if err := client.Schema.Create(ctx); err != nil {
-		client.Close()
-		return nil, fmt.Errorf("erro ao criar schema: %w", err)
-	}
+	// Versão usada no ETag e no If-Match
+	client.Debt.Use(hooks.VersionHook())
+	client.Invoice.Use(hooks.VersionHook())
+	client.Category.Use(hooks.VersionHook())
+	client.PaymentStatus.Use(hooks.VersionHook())
+	client.Tag.Use(hooks.VersionHook())
+	client.Person.Use(hooks.VersionHook())
+
+	// Histórico de alterações; registrado depois da lixeira para que a remoção
+	// seja gravada como delete
+	client.Debt.Use(hooks.AuditHook())
+	client.Invoice.Use(hooks.AuditHook())
+	client.Category.Use(hooks.AuditHook())
+	client.PaymentStatus.Use(hooks.AuditHook())
 
-	log.Println("Banco de dados conectado com sucesso via Ent")
-	return &PostgreSQL{Client: client}, nil
+	// O schema é criado pelas migrations versionadas (comando migrate)
+	slog.Info("banco de dados conectado")
+	return &PostgreSQL{Client: client, driver: drv}, nil
 }
 
 func (d *PostgreSQL) Close() {
 	if err := d.Client.Close(); err != nil {
-		log.Println("Erro ao fechar conexão com o banco:", err)
+		slog.Error("erro ao fechar conexão com o banco", "error", err)
 	} else {
-		log.Println("Conexão com o banco fechada.")
+		slog.Info("conexão com o banco fechada")
+	}
+}
+
+// DB retorna o pool de conexões, para as métricas
+func (d *PostgreSQL) DB() *stdsql.DB {
+	return d.driver.DB()
+}
+
+// Ping verifica se o banco responde
+func (d *PostgreSQL) Ping(ctx context.Context) error {
+	return d.driver.DB().PingContext(ctx)
+}
+
+func (d *PostgreSQL) WithTx(ctx context.Context, fn func(db interfaces.Database) error) error {
+	if d.inTx {
+		return fn(d)
+	}
+
+	tx, err := d.Client.Tx(ctx)
+	if err != nil {
+		return err
+	}
+	defer func() {
+		if v := recover(); v != nil {
+			_ = tx.Rollback()
+			panic(v)
+		}
+	}()
+
+	if err := fn(&PostgreSQL{Client: tx.Client(), driver: d.driver, inTx: true}); err != nil {
+		_ = tx.Rollback()
+		return err
+	}
+	return tx.Commit()
+}
+
+// currentUserID retorna o usuário autenticado
+func currentUserID(ctx context.Context) (uuid.UUID, error) {
+	id, ok := auth.UserIDFromContext(ctx)
+	if !ok {
+		return uuid.Nil, errs.ErrUnauthorized
+	}
+	return id, nil
+}
+
+// currentWorkspaceID retorna o workspace ativo; as consultas de débitos,
+// faturas e categorias são filtradas por ele
+func currentWorkspaceID(ctx context.Context) (uuid.UUID, error) {
+	workspace, ok := auth.WorkspaceFromContext(ctx)
+	if !ok {
+		return uuid.Nil, errs.ErrUnauthorized
 	}
+	return workspace.ID, nil
 }
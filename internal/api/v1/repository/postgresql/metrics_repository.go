@@ -0,0 +1,31 @@
+package postgresql
+
+import (
+	"backend-go/pkg/ent/debt"
+	"backend-go/pkg/ent/invoice"
+	"backend-go/pkg/ent/paymentstatus"
+	"context"
+	"time"
+)
+
+// Nome do status de pagamento quitado (ver seed)
+const paidStatus = "paid"
+
+// CountUnpaidDebts conta, em todos os workspaces, os débitos que não estão
+// pagos, para as métricas
+func (d *PostgreSQL) CountUnpaidDebts(ctx context.Context) (int, error) {
+	return d.Client.Debt.Query().
+		Where(debt.Not(debt.HasStatusWith(paymentstatus.NameEQ(paidStatus)))).
+		Count(ctx)
+}
+
+// CountOverdueInvoices conta, em todos os workspaces, as faturas não pagas
+// com vencimento antes de now, para as métricas
+func (d *PostgreSQL) CountOverdueInvoices(ctx context.Context, now time.Time) (int, error) {
+	return d.Client.Invoice.Query().
+		Where(
+			invoice.DueDateLT(now),
+			invoice.Not(invoice.HasStatusWith(paymentstatus.NameEQ(paidStatus))),
+		).
+		Count(ctx)
+}
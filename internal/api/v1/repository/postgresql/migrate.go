@@ -0,0 +1,470 @@
+package postgresql
+
+import (
+	"backend-go/migrations"
+	"context"
+	stdsql "database/sql"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io/fs"
+	"log/slog"
+	"time"
+
+	"ariga.io/atlas/sql/migrate"
+	"ariga.io/atlas/sql/postgres"
+	"ariga.io/atlas/sql/schema"
+)
+
+// Mesmos nomes usados pelo atlas CLI, para que `make atlas-up` e o comando
+// migrate compartilhem o histórico e não rodem ao mesmo tempo
+const (
+	revisionsSchema = "atlas_schema_revisions"
+	revisionsTable  = "atlas_schema_revisions"
+	migrateLock     = "atlas_migrate_execute"
+	operatorVersion = "backend-go"
+)
+
+// ErrMigrationsBehind é retornado quando há migrations ainda não aplicadas
+var ErrMigrationsBehind = errors.New("há migrations pendentes")
+
+// Migrator aplica e reverte as migrations versionadas do diretório migrations
+type Migrator struct {
+	db  *stdsql.DB
+	dir migrate.Dir
+}
+
+// MigrationStatus resume o estado das migrations no banco
+type MigrationStatus struct {
+	// Versão da última migration aplicada; vazio se nenhuma foi aplicada
+	Current string
+	// Quantidade de migrations aplicadas
+	Applied int
+	// Arquivos ainda não aplicados, em ordem
+	Pending []string
+}
+
+func NewMigrator(dsn string) (*Migrator, error) {
+	dir, err := embeddedDir()
+	if err != nil {
+		return nil, err
+	}
+	if err := migrate.Validate(dir); err != nil {
+		return nil, fmt.Errorf("diretório de migrations inválido: %w", err)
+	}
+
+	db, err := stdsql.Open("postgres", dsn)
+	if err != nil {
+		return nil, fmt.Errorf("erro ao abrir conexão com o banco: %w", err)
+	}
+	return &Migrator{db: db, dir: dir}, nil
+}
+
+func (m *Migrator) Close() {
+	if err := m.db.Close(); err != nil {
+		slog.Error("erro ao fechar conexão das migrations", "error", err)
+	}
+}
+
+// embeddedDir copia as migrations embutidas no binário para um diretório em memória
+func embeddedDir() (migrate.Dir, error) {
+	dir := &migrate.MemDir{}
+	names, err := fs.Glob(migrations.FS, "*")
+	if err != nil {
+		return nil, err
+	}
+	for _, name := range names {
+		data, err := migrations.FS.ReadFile(name)
+		if err != nil {
+			return nil, err
+		}
+		if err := dir.WriteFile(name, data); err != nil {
+			return nil, err
+		}
+	}
+	return dir, nil
+}
+
+// Status lista as migrations aplicadas e pendentes
+func (m *Migrator) Status(ctx context.Context) (*MigrationStatus, error) {
+	drv, err := postgres.Open(m.db)
+	if err != nil {
+		return nil, err
+	}
+
+	revisions := &revisionTable{conn: m.db}
+	applied, err := revisions.ReadRevisions(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	status := &MigrationStatus{Applied: len(applied)}
+	if len(applied) > 0 {
+		status.Current = applied[len(applied)-1].Version
+	}
+
+	pending, err := m.pending(ctx, drv, revisions, "")
+	if err != nil {
+		return nil, err
+	}
+	for _, file := range pending {
+		status.Pending = append(status.Pending, file.Name())
+	}
+	return status, nil
+}
+
+// CheckUpToDate retorna ErrMigrationsBehind se houver migrations pendentes
+func (m *Migrator) CheckUpToDate(ctx context.Context) error {
+	status, err := m.Status(ctx)
+	if err != nil {
+		return err
+	}
+	if len(status.Pending) > 0 {
+		return fmt.Errorf("%w: %d, a partir de %s", ErrMigrationsBehind, len(status.Pending), status.Pending[0])
+	}
+	return nil
+}
+
+// Up aplica até n migrations pendentes (todas se n <= 0), cada uma em uma
+// transação. baseline marca como aplicadas as migrations até essa versão em um
+// banco que já tem tabelas mas ainda não tem histórico.
+func (m *Migrator) Up(ctx context.Context, n int, baseline string) ([]string, error) {
+	drv, err := postgres.Open(m.db)
+	if err != nil {
+		return nil, err
+	}
+
+	unlock, err := drv.Lock(ctx, migrateLock, 10*time.Second)
+	if err != nil {
+		return nil, fmt.Errorf("erro ao obter o lock das migrations: %w", err)
+	}
+	defer unlock()
+
+	revisions := &revisionTable{conn: m.db}
+	if err := revisions.create(ctx); err != nil {
+		return nil, err
+	}
+
+	pending, err := m.pending(ctx, drv, revisions, baseline)
+	if err != nil {
+		return nil, err
+	}
+	if n > 0 && n < len(pending) {
+		pending = pending[:n]
+	}
+
+	var applied []string
+	for _, file := range pending {
+		if err := m.execute(ctx, file); err != nil {
+			return applied, err
+		}
+		applied = append(applied, file.Name())
+	}
+	return applied, nil
+}
+
+// pending retorna os arquivos ainda não aplicados
+func (m *Migrator) pending(ctx context.Context, drv migrate.Driver, revisions *revisionTable, baseline string) ([]migrate.File, error) {
+	var opts []migrate.ExecutorOption
+	if baseline != "" {
+		opts = append(opts, migrate.WithBaselineVersion(baseline))
+	}
+
+	executor, err := migrate.NewExecutor(drv, m.dir, revisions, opts...)
+	if err != nil {
+		return nil, err
+	}
+
+	pending, err := executor.Pending(ctx)
+	var notClean *migrate.NotCleanError
+	switch {
+	case errors.Is(err, migrate.ErrNoPendingFiles):
+		return nil, nil
+	case errors.As(err, &notClean):
+		return nil, fmt.Errorf("o banco já tem tabelas mas não tem histórico de migrations; informe a versão já aplicada com --baseline: %w", err)
+	case err != nil:
+		return nil, err
+	}
+	return pending, nil
+}
+
+// execute aplica um arquivo e grava o histórico na mesma transação
+func (m *Migrator) execute(ctx context.Context, file migrate.File) error {
+	tx, err := m.db.BeginTx(ctx, nil)
+	if err != nil {
+		return err
+	}
+	defer tx.Rollback()
+
+	drv, err := postgres.Open(tx)
+	if err != nil {
+		return err
+	}
+
+	executor, err := migrate.NewExecutor(drv, m.dir, &revisionTable{conn: tx},
+		migrate.WithOperatorVersion(operatorVersion),
+	)
+	if err != nil {
+		return err
+	}
+
+	slog.Info("aplicando migration", "file", file.Name())
+	if err := executor.Execute(ctx, file); err != nil {
+		return fmt.Errorf("erro ao aplicar %s: %w", file.Name(), err)
+	}
+	return tx.Commit()
+}
+
+// Down reverte as n últimas migrations aplicadas. Como as migrations do atlas
+// não têm scripts de reversão, o estado anterior é reconstruído aplicando as
+// migrations restantes no banco de desenvolvimento (devDSN, que precisa estar
+// vazio) e a diferença de schema é aplicada no banco. Dados alterados pelas
+// migrations revertidas não são restaurados.
+func (m *Migrator) Down(ctx context.Context, devDSN string, n int) ([]string, error) {
+	if n <= 0 {
+		n = 1
+	}
+
+	drv, err := postgres.Open(m.db)
+	if err != nil {
+		return nil, err
+	}
+
+	unlock, err := drv.Lock(ctx, migrateLock, 10*time.Second)
+	if err != nil {
+		return nil, fmt.Errorf("erro ao obter o lock das migrations: %w", err)
+	}
+	defer unlock()
+
+	revisions, err := (&revisionTable{conn: m.db}).ReadRevisions(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if n > len(revisions) {
+		n = len(revisions)
+	}
+	if n == 0 {
+		return nil, nil
+	}
+	reverted := revisions[len(revisions)-n:]
+
+	var target string
+	if remaining := revisions[:len(revisions)-n]; len(remaining) > 0 {
+		target = remaining[len(remaining)-1].Version
+	}
+
+	desired, err := m.replay(ctx, devDSN, target)
+	if err != nil {
+		return nil, err
+	}
+
+	current, err := drv.InspectSchema(ctx, "public", nil)
+	if err != nil {
+		return nil, err
+	}
+
+	changes, err := drv.SchemaDiff(current, desired)
+	if err != nil {
+		return nil, err
+	}
+
+	tx, err := m.db.BeginTx(ctx, nil)
+	if err != nil {
+		return nil, err
+	}
+	defer tx.Rollback()
+
+	txDrv, err := postgres.Open(tx)
+	if err != nil {
+		return nil, err
+	}
+	if len(changes) > 0 {
+		if err := txDrv.ApplyChanges(ctx, changes); err != nil {
+			return nil, fmt.Errorf("erro ao reverter o schema: %w", err)
+		}
+	}
+
+	txRevisions := &revisionTable{conn: tx}
+	var versions []string
+	for i := len(reverted) - 1; i >= 0; i-- {
+		if err := txRevisions.DeleteRevision(ctx, reverted[i].Version); err != nil {
+			return nil, err
+		}
+		versions = append(versions, reverted[i].Version)
+	}
+	return versions, tx.Commit()
+}
+
+// replay aplica as migrations até target no banco de desenvolvimento e
+// retorna o schema resultante. O banco é limpo ao final.
+func (m *Migrator) replay(ctx context.Context, devDSN, target string) (*schema.Schema, error) {
+	if target == "" {
+		return schema.New("public"), nil
+	}
+
+	dev, err := stdsql.Open("postgres", devDSN)
+	if err != nil {
+		return nil, fmt.Errorf("erro ao abrir o banco de desenvolvimento: %w", err)
+	}
+	defer dev.Close()
+
+	devDrv, err := postgres.Open(dev)
+	if err != nil {
+		return nil, fmt.Errorf("erro ao abrir o banco de desenvolvimento: %w", err)
+	}
+
+	executor, err := migrate.NewExecutor(devDrv, m.dir, migrate.NopRevisionReadWriter{})
+	if err != nil {
+		return nil, err
+	}
+
+	realm, err := executor.Replay(ctx, migrate.SchemaConn(devDrv, "public", nil), migrate.ReplayToVersion(target))
+	if err != nil {
+		return nil, fmt.Errorf("erro ao reconstruir o schema no banco de desenvolvimento: %w", err)
+	}
+
+	desired, ok := realm.Schema("public")
+	if !ok {
+		return nil, errors.New("schema public não encontrado no banco de desenvolvimento")
+	}
+	return desired, nil
+}
+
+// revisionTable guarda o histórico de migrations na mesma tabela do atlas CLI
+type revisionTable struct {
+	conn schema.ExecQuerier
+}
+
+func (r *revisionTable) Ident() *migrate.TableIdent {
+	return &migrate.TableIdent{Name: revisionsTable, Schema: revisionsSchema}
+}
+
+func (r *revisionTable) create(ctx context.Context) error {
+	_, err := r.conn.ExecContext(ctx, `
+		CREATE SCHEMA IF NOT EXISTS "atlas_schema_revisions";
+		CREATE TABLE IF NOT EXISTS "atlas_schema_revisions"."atlas_schema_revisions" (
+			"version" character varying NOT NULL,
+			"description" character varying NOT NULL,
+			"type" bigint NOT NULL DEFAULT 2,
+			"applied" bigint NOT NULL DEFAULT 0,
+			"total" bigint NOT NULL DEFAULT 0,
+			"executed_at" timestamptz NOT NULL,
+			"execution_time" bigint NOT NULL,
+			"error" text NULL,
+			"error_stmt" text NULL,
+			"hash" character varying NOT NULL,
+			"partial_hashes" jsonb NULL,
+			"operator_version" character varying NOT NULL,
+			PRIMARY KEY ("version")
+		)`)
+	if err != nil {
+		return fmt.Errorf("erro ao criar a tabela de histórico das migrations: %w", err)
+	}
+	return nil
+}
+
+func (r *revisionTable) exists(ctx context.Context) (bool, error) {
+	rows, err := r.conn.QueryContext(ctx, `SELECT to_regclass('"atlas_schema_revisions"."atlas_schema_revisions"') IS NOT NULL`)
+	if err != nil {
+		return false, err
+	}
+	defer rows.Close()
+
+	var exists bool
+	if rows.Next() {
+		if err := rows.Scan(&exists); err != nil {
+			return false, err
+		}
+	}
+	return exists, rows.Err()
+}
+
+const selectRevisions = `SELECT "version", "description", "type", "applied", "total", "executed_at", "execution_time",
+	coalesce("error", ''), coalesce("error_stmt", ''), "hash", "partial_hashes", "operator_version"
+	FROM "atlas_schema_revisions"."atlas_schema_revisions"`
+
+func (r *revisionTable) ReadRevisions(ctx context.Context) ([]*migrate.Revision, error) {
+	exists, err := r.exists(ctx)
+	if err != nil || !exists {
+		return nil, err
+	}
+	return r.query(ctx, selectRevisions+` ORDER BY "version"`)
+}
+
+func (r *revisionTable) ReadRevision(ctx context.Context, version string) (*migrate.Revision, error) {
+	revisions, err := r.query(ctx, selectRevisions+` WHERE "version" = $1`, version)
+	if err != nil {
+		return nil, err
+	}
+	if len(revisions) == 0 {
+		return nil, migrate.ErrRevisionNotExist
+	}
+	return revisions[0], nil
+}
+
+func (r *revisionTable) query(ctx context.Context, query string, args ...any) ([]*migrate.Revision, error) {
+	rows, err := r.conn.QueryContext(ctx, query, args...)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	var revisions []*migrate.Revision
+	for rows.Next() {
+		var (
+			rev           migrate.Revision
+			executionTime int64
+			partialHashes []byte
+		)
+		if err := rows.Scan(
+			&rev.Version, &rev.Description, &rev.Type, &rev.Applied, &rev.Total, &rev.ExecutedAt, &executionTime,
+			&rev.Error, &rev.ErrorStmt, &rev.Hash, &partialHashes, &rev.OperatorVersion,
+		); err != nil {
+			return nil, err
+		}
+		rev.ExecutionTime = time.Duration(executionTime)
+		if len(partialHashes) > 0 {
+			if err := json.Unmarshal(partialHashes, &rev.PartialHashes); err != nil {
+				return nil, err
+			}
+		}
+		revisions = append(revisions, &rev)
+	}
+	return revisions, rows.Err()
+}
+
+func (r *revisionTable) WriteRevision(ctx context.Context, rev *migrate.Revision) error {
+	var partialHashes []byte
+	if len(rev.PartialHashes) > 0 {
+		var err error
+		if partialHashes, err = json.Marshal(rev.PartialHashes); err != nil {
+			return err
+		}
+	}
+
+	_, err := r.conn.ExecContext(ctx, `
+		INSERT INTO "atlas_schema_revisions"."atlas_schema_revisions"
+			("version", "description", "type", "applied", "total", "executed_at", "execution_time",
+			 "error", "error_stmt", "hash", "partial_hashes", "operator_version")
+		VALUES ($1, $2, $3, $4, $5, $6, $7, NULLIF($8, ''), NULLIF($9, ''), $10, $11, $12)
+		ON CONFLICT ("version") DO UPDATE SET
+			"type" = EXCLUDED."type",
+			"applied" = EXCLUDED."applied",
+			"total" = EXCLUDED."total",
+			"executed_at" = EXCLUDED."executed_at",
+			"execution_time" = EXCLUDED."execution_time",
+			"error" = EXCLUDED."error",
+			"error_stmt" = EXCLUDED."error_stmt",
+			"hash" = EXCLUDED."hash",
+			"partial_hashes" = EXCLUDED."partial_hashes",
+			"operator_version" = EXCLUDED."operator_version"`,
+		rev.Version, rev.Description, rev.Type, rev.Applied, rev.Total, rev.ExecutedAt, int64(rev.ExecutionTime),
+		rev.Error, rev.ErrorStmt, rev.Hash, partialHashes, rev.OperatorVersion,
+	)
+	return err
+}
+
+func (r *revisionTable) DeleteRevision(ctx context.Context, version string) error {
+	_, err := r.conn.ExecContext(ctx, `DELETE FROM "atlas_schema_revisions"."atlas_schema_revisions" WHERE "version" = $1`, version)
+	return err
+}
@@ -3,7 +3,6 @@ package postgresql
 import (
 	"backend-go/internal/api/errs"
 	"backend-go/internal/api/v1/dto"
-	"backend-go/internal/api/v1/repository/models"
 	"backend-go/pkg/ent"
 	"backend-go/pkg/ent/paymentstatus"
 	"backend-go/pkg/pagination"
@@ -40,60 +39,23 @@ func (d *PostgreSQL) GetPaymentStatusIDByName(ctx context.Context, name *string)
 	return &id, nil
 }
 
-func (d *PostgreSQL) DeletePaymentStatusByID(ctx context.Context, id uuid.UUID) error {
-	err := d.Client.PaymentStatus.DeleteOneID(id).Exec(ctx)
-	if err != nil {
-		if ent.IsNotFound(err) {
-			return errs.ErrNotFound
-		}
-		return err
-	}
-	return nil
-}
-
-func (d *PostgreSQL) InsertPaymentStatus(ctx context.Context, input models.PaymentStatus) (*dto.PaymentStatusResponse, error) {
-	created, err := d.Client.PaymentStatus.
-		Create().
-		SetName(input.Name).
-		SetNillableDescription(input.Description).
-		Save(ctx)
-
-	if err != nil {
-		return nil, errs.FailedToSave("payment_status", err)
-	}
-
-	return newPaymentStatusResponse(created)
-}
-
-func (d *PostgreSQL) UpdatePaymentStatus(ctx context.Context, input models.PaymentStatus) (*dto.PaymentStatusResponse, error) {
-	updated, err := d.Client.PaymentStatus.
-		UpdateOneID(input.ID).
-		SetName(input.Name).
-		SetNillableDescription(input.Description).
-		Save(ctx)
-
-	if err != nil {
-		if ent.IsNotFound(err) {
-			return nil, errs.ErrNotFound
-		}
-		return nil, errs.FailedToSave("payment_status", err)
-	}
-
-	return newPaymentStatusResponse(updated)
-}
-
 func (d *PostgreSQL) ListPaymentStatus(ctx context.Context, pgn *pagination.Pagination) ([]dto.PaymentStatusResponse, error) {
 	query := d.Client.PaymentStatus.Query()
 
 	query = applyPaymentStatusFilters(query, pgn)
-	query = query.Order(ent.Desc(pgn.OrderBy))
-	query = query.Limit(pgn.PageSize).Offset(pgn.Offset())
+	query = query.Where(pgn.CursorPredicate(nil))
+	query = query.Order(pgn.Order(nil))
+	query = query.Limit(pgn.Limit()).Offset(pgn.Offset())
 
 	data, err := query.All(ctx)
 	if err != nil {
 		return nil, err
 	}
 
+	data, err = pagination.Trim(pgn, data, paymentStatusSortValue)
+	if err != nil {
+		return nil, err
+	}
 	return newPaymentStatusResponseList(data)
 }
 
@@ -108,11 +70,23 @@ func (d *PostgreSQL) CountPaymentStatus(ctx context.Context, pgn *pagination.Pag
 	return total, nil
 }
 
+// paymentStatusSortValue retorna o valor de uma coluna de ordenação, usado no cursor
+func paymentStatusSortValue(row *ent.PaymentStatus, column string) any {
+	switch column {
+	case paymentstatus.FieldName:
+		return row.Name
+	case paymentstatus.FieldDescription:
+		return row.Description
+	}
+	return row.ID
+}
+
 func mapPaymentStatusToResponse(row *ent.PaymentStatus) dto.PaymentStatusResponse {
 	return dto.PaymentStatusResponse{
 		ID:          row.ID,
 		Name:        row.Name,
 		Description: row.Description,
+		Version:     row.Version,
 	}
 }
 
@@ -0,0 +1,326 @@
+package postgresql
+
+import (
+	"backend-go/internal/api/errs"
+	"backend-go/internal/api/v1/dto"
+	"backend-go/internal/api/v1/repository/models"
+	"backend-go/pkg/ent"
+	"backend-go/pkg/ent/debt"
+	"backend-go/pkg/ent/debtshare"
+	"backend-go/pkg/ent/person"
+	"backend-go/pkg/hooks"
+	"backend-go/pkg/pagination"
+	"backend-go/pkg/utils"
+	"context"
+
+	"entgo.io/ent/dialect/sql"
+	"github.com/google/uuid"
+)
+
+func (d *PostgreSQL) GetPersonByID(ctx context.Context, id uuid.UUID) (*dto.PersonResponse, error) {
+	workspaceID, err := currentWorkspaceID(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	row, err := d.Client.Person.Query().
+		Where(person.ID(id), person.WorkspaceID(workspaceID)).
+		Only(ctx)
+	if err != nil {
+		if ent.IsNotFound(err) {
+			return nil, errs.ErrNotFound
+		}
+		return nil, err
+	}
+	return newPersonResponse(row)
+}
+
+// GetPersonBalance soma as partes da pessoa em todos os débitos do workspace
+func (d *PostgreSQL) GetPersonBalance(ctx context.Context, id uuid.UUID) (*dto.PersonBalanceResponse, error) {
+	workspaceID, err := currentWorkspaceID(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	row, err := d.Client.Person.Query().
+		Where(person.ID(id), person.WorkspaceID(workspaceID)).
+		Only(ctx)
+	if err != nil {
+		if ent.IsNotFound(err) {
+			return nil, errs.ErrNotFound
+		}
+		return nil, err
+	}
+
+	shares, err := d.Client.DebtShare.Query().
+		Where(debtshare.PersonID(id), debtshare.HasDebtWith(debt.DeletedAtIsNil())).
+		WithDebt().
+		Order(debtshare.ByDebtField(debt.FieldPurchaseDate, sql.OrderDesc())).
+		All(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	response := &dto.PersonBalanceResponse{
+		PersonID: row.ID,
+		Name:     row.Name,
+		Items:    make([]dto.PersonBalanceItem, 0, len(shares)),
+	}
+	for _, share := range shares {
+		item := dto.PersonBalanceItem{
+			ShareID: share.ID,
+			DebtID:  share.DebtID,
+			Amount:  share.Amount,
+			Settled: share.Settled,
+		}
+		if share.Edges.Debt != nil {
+			item.Title = share.Edges.Debt.Title
+			item.PurchaseDate = *utils.ToFormatDatePointer(share.Edges.Debt.PurchaseDate)
+		}
+
+		response.Total += share.Amount
+		if share.Settled {
+			response.Settled += share.Amount
+		}
+		response.Items = append(response.Items, item)
+	}
+
+	response.Total = utils.RoundCents(response.Total)
+	response.Settled = utils.RoundCents(response.Settled)
+	response.Owed = utils.RoundCents(response.Total - response.Settled)
+	return response, nil
+}
+
+// DeletePersonByID remove a pessoa. Pessoas com divisões de débitos não podem
+// ser removidas e retornam ErrConflict.
+func (d *PostgreSQL) DeletePersonByID(ctx context.Context, id uuid.UUID) error {
+	workspaceID, err := currentWorkspaceID(ctx)
+	if err != nil {
+		return err
+	}
+
+	// Com a lixeira a chave estrangeira não impede mais a remoção, então as
+	// divisões em débitos ativos são verificadas aqui
+	hasShares, err := d.Client.DebtShare.Query().
+		Where(
+			debtshare.PersonID(id),
+			debtshare.HasPersonWith(person.WorkspaceID(workspaceID)),
+			debtshare.HasDebtWith(debt.DeletedAtIsNil()),
+		).
+		Exist(ctx)
+	if err != nil {
+		return err
+	}
+	if hasShares {
+		return errs.ErrConflict
+	}
+
+	deleted, err := d.Client.Person.Delete().
+		Where(person.ID(id), person.WorkspaceID(workspaceID)).
+		Exec(ctx)
+	if err != nil {
+		return err
+	}
+	if deleted == 0 {
+		return errs.ErrNotFound
+	}
+	return nil
+}
+
+// ListDeletedPeople lista as pessoas da lixeira, da remoção mais recente para a mais antiga
+func (d *PostgreSQL) ListDeletedPeople(ctx context.Context, pgn *pagination.Pagination) ([]dto.PersonResponse, error) {
+	workspaceID, err := currentWorkspaceID(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	data, err := d.Client.Person.Query().
+		Where(person.WorkspaceID(workspaceID), person.DeletedAtNotNil()).
+		Where(pgn.CursorPredicate(nil)).
+		Order(pgn.Order(nil)).
+		Limit(pgn.Limit()).
+		Offset(pgn.Offset()).
+		All(hooks.IncludeDeleted(ctx))
+	if err != nil {
+		return nil, err
+	}
+
+	data, err = pagination.Trim(pgn, data, personSortValue)
+	if err != nil {
+		return nil, err
+	}
+	return newPersonResponseList(data)
+}
+
+func (d *PostgreSQL) CountDeletedPeople(ctx context.Context) (int, error) {
+	workspaceID, err := currentWorkspaceID(ctx)
+	if err != nil {
+		return 0, err
+	}
+
+	return d.Client.Person.Query().
+		Where(person.WorkspaceID(workspaceID), person.DeletedAtNotNil()).
+		Count(hooks.IncludeDeleted(ctx))
+}
+
+// RestorePersonByID tira a pessoa da lixeira
+func (d *PostgreSQL) RestorePersonByID(ctx context.Context, id uuid.UUID) (*dto.PersonResponse, error) {
+	workspaceID, err := currentWorkspaceID(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	restored, err := d.Client.Person.Update().
+		Where(person.ID(id), person.WorkspaceID(workspaceID), person.DeletedAtNotNil()).
+		ClearDeletedAt().
+		Save(hooks.IncludeDeleted(ctx))
+	if err != nil {
+		return nil, errs.FailedToSave("people", err)
+	}
+	if restored == 0 {
+		return nil, errs.ErrNotFound
+	}
+	return d.GetPersonByID(ctx, id)
+}
+
+func (d *PostgreSQL) InsertPerson(ctx context.Context, input models.Person) (*dto.PersonResponse, error) {
+	workspaceID, err := currentWorkspaceID(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	created, err := d.Client.Person.
+		Create().
+		SetWorkspaceID(workspaceID).
+		SetName(input.Name).
+		SetNillableEmail(input.Email).
+		Save(ctx)
+
+	if err != nil {
+		return nil, errs.FailedToSave("people", err)
+	}
+
+	return newPersonResponse(created)
+}
+
+func (d *PostgreSQL) UpdatePerson(ctx context.Context, input models.Person) (*dto.PersonResponse, error) {
+	workspaceID, err := currentWorkspaceID(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	update := d.Client.Person.
+		UpdateOneID(input.ID).
+		Where(person.WorkspaceID(workspaceID)).
+		SetName(input.Name)
+	if input.Email != nil {
+		update = update.SetEmail(*input.Email)
+	} else {
+		update = update.ClearEmail()
+	}
+
+	updated, err := update.Save(ctx)
+	if err != nil {
+		if ent.IsNotFound(err) {
+			return nil, errs.ErrNotFound
+		}
+		return nil, errs.FailedToSave("people", err)
+	}
+
+	return newPersonResponse(updated)
+}
+
+func (d *PostgreSQL) ListPeople(ctx context.Context, pgn *pagination.Pagination) ([]dto.PersonResponse, error) {
+	workspaceID, err := currentWorkspaceID(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	query := d.Client.Person.Query().Where(person.WorkspaceID(workspaceID))
+
+	query = applyPersonFilters(query, pgn)
+	query = query.Where(pgn.CursorPredicate(nil))
+	query = query.Order(pgn.Order(nil))
+	query = query.Limit(pgn.Limit()).Offset(pgn.Offset())
+
+	data, err := query.All(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	data, err = pagination.Trim(pgn, data, personSortValue)
+	if err != nil {
+		return nil, err
+	}
+	return newPersonResponseList(data)
+}
+
+func (d *PostgreSQL) CountPeople(ctx context.Context, pgn *pagination.Pagination) (int, error) {
+	workspaceID, err := currentWorkspaceID(ctx)
+	if err != nil {
+		return 0, err
+	}
+
+	query := d.Client.Person.Query().Where(person.WorkspaceID(workspaceID))
+	query = applyPersonFilters(query, pgn)
+
+	total, err := query.Count(ctx)
+	if err != nil {
+		return 0, err
+	}
+	return total, nil
+}
+
+// personSortValue retorna o valor de uma coluna de ordenação, usado no cursor
+func personSortValue(row *ent.Person, column string) any {
+	switch column {
+	case person.FieldDeletedAt:
+		return row.DeletedAt
+	case person.FieldName:
+		return row.Name
+	case person.FieldEmail:
+		return row.Email
+	}
+	return row.ID
+}
+
+func mapPersonToResponse(row *ent.Person) dto.PersonResponse {
+	return dto.PersonResponse{
+		ID:        row.ID,
+		Name:      row.Name,
+		Email:     row.Email,
+		DeletedAt: formatDeletedAt(row.DeletedAt),
+		Version:   row.Version,
+	}
+}
+
+func newPersonResponse(row *ent.Person) (*dto.PersonResponse, error) {
+	if row == nil {
+		return nil, nil
+	}
+	response := mapPersonToResponse(row)
+	return &response, nil
+}
+
+func newPersonResponseList(rows []*ent.Person) ([]dto.PersonResponse, error) {
+	if rows == nil {
+		return nil, nil
+	}
+	response := make([]dto.PersonResponse, 0, len(rows))
+	for _, row := range rows {
+		response = append(response, mapPersonToResponse(row))
+	}
+	return response, nil
+}
+
+func applyPersonFilters(query *ent.PersonQuery, pgn *pagination.Pagination) *ent.PersonQuery {
+	if pgn.Search != "" {
+		query = query.Where(
+			person.Or(
+				person.NameContainsFold(pgn.Search),
+				person.EmailContainsFold(pgn.Search),
+			),
+		)
+	}
+	return query
+}
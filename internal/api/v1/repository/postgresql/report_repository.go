@@ -0,0 +1,119 @@
+package postgresql
+
+import (
+	"backend-go/internal/api/v1/dto"
+	"backend-go/internal/api/v1/repository/models"
+	"backend-go/pkg/ent"
+	"backend-go/pkg/ent/debt"
+	"backend-go/pkg/utils"
+	"context"
+	"sort"
+
+	"github.com/google/uuid"
+)
+
+// Nomes usados para os débitos sem categoria ou sem tag
+const (
+	uncategorizedName = "Sem categoria"
+	untaggedName      = "Sem tag"
+)
+
+// ReportByCategory soma os débitos do período agrupados por categoria. Estornos
+// são descontados do total.
+func (d *PostgreSQL) ReportByCategory(ctx context.Context, flt dto.ReportFilters) ([]dto.ReportItem, error) {
+	rows, err := d.reportDebts(ctx, flt, func(q *ent.DebtQuery) { q.WithCategory() })
+	if err != nil {
+		return nil, err
+	}
+
+	report := newReportBuilder()
+	for _, row := range rows {
+		amount := models.SignedAmount(string(row.Kind), row.Amount)
+		if row.Edges.Category == nil {
+			report.add(nil, uncategorizedName, amount)
+			continue
+		}
+		report.add(&row.Edges.Category.ID, row.Edges.Category.Name, amount)
+	}
+	return report.items(), nil
+}
+
+// ReportByTag soma os débitos do período agrupados por tag. Um débito com
+// várias tags entra no total de cada uma delas, então a soma dos itens pode
+// ser maior que o total gasto.
+func (d *PostgreSQL) ReportByTag(ctx context.Context, flt dto.ReportFilters) ([]dto.ReportItem, error) {
+	rows, err := d.reportDebts(ctx, flt, func(q *ent.DebtQuery) { q.WithTags() })
+	if err != nil {
+		return nil, err
+	}
+
+	report := newReportBuilder()
+	for _, row := range rows {
+		amount := models.SignedAmount(string(row.Kind), row.Amount)
+		if len(row.Edges.Tags) == 0 {
+			report.add(nil, untaggedName, amount)
+			continue
+		}
+		for _, t := range row.Edges.Tags {
+			report.add(&t.ID, t.Name, amount)
+		}
+	}
+	return report.items(), nil
+}
+
+func (d *PostgreSQL) reportDebts(ctx context.Context, flt dto.ReportFilters, with func(*ent.DebtQuery)) ([]*ent.Debt, error) {
+	workspaceID, err := currentWorkspaceID(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	query := d.Client.Debt.Query().Where(debt.WorkspaceID(workspaceID))
+	if t := utils.ToTimePointer(flt.StartDate); t != nil {
+		query = query.Where(debt.PurchaseDateGTE(*t))
+	}
+	if t := utils.ToTimePointer(flt.EndDate); t != nil {
+		query = query.Where(debt.PurchaseDateLTE(*t))
+	}
+	with(query)
+
+	return query.All(ctx)
+}
+
+type reportBuilder struct {
+	byKey map[string]*dto.ReportItem
+	order []string
+}
+
+func newReportBuilder() *reportBuilder {
+	return &reportBuilder{byKey: make(map[string]*dto.ReportItem)}
+}
+
+func (r *reportBuilder) add(id *uuid.UUID, name string, amount float64) {
+	key := ""
+	if id != nil {
+		key = id.String()
+	}
+
+	item, ok := r.byKey[key]
+	if !ok {
+		item = &dto.ReportItem{ID: id, Name: name}
+		r.byKey[key] = item
+		r.order = append(r.order, key)
+	}
+	item.Total += amount
+	item.Count++
+}
+
+// items retorna os itens do maior para o menor total
+func (r *reportBuilder) items() []dto.ReportItem {
+	items := make([]dto.ReportItem, 0, len(r.order))
+	for _, key := range r.order {
+		item := *r.byKey[key]
+		item.Total = utils.RoundCents(item.Total)
+		items = append(items, item)
+	}
+	sort.SliceStable(items, func(i, j int) bool {
+		return items[i].Total > items[j].Total
+	})
+	return items
+}
@@ -0,0 +1,71 @@
+package postgresql
+
+import (
+	"backend-go/pkg/pagination"
+	"strings"
+	"unicode"
+
+	"entgo.io/ent/dialect/sql"
+)
+
+// searchVectorColumn é a coluna tsvector gerada pelo banco em débitos e
+// faturas (ver migration full_text_search)
+const searchVectorColumn = "search_vector"
+
+// searchQuery converte o texto da busca para o to_tsquery. Cada palavra vira
+// um prefixo, para que a busca funcione enquanto o usuário digita. Retorna
+// vazio se não houver palavras.
+func searchQuery(search string) string {
+	words := strings.FieldsFunc(search, func(r rune) bool {
+		return !unicode.IsLetter(r) && !unicode.IsDigit(r)
+	})
+	for i, word := range words {
+		words[i] = word + ":*"
+	}
+	return strings.Join(words, " & ")
+}
+
+// writeTSQuery escreve a consulta sem acentos e com a configuração em português
+func writeTSQuery(b *sql.Builder, query string) {
+	b.WriteString("to_tsquery('portuguese', f_unaccent(").Arg(query).WriteString("))")
+}
+
+// searchVectorMatches filtra os registros cuja coluna tsvector atende a consulta
+func searchVectorMatches(query string) func(*sql.Selector) {
+	return func(s *sql.Selector) {
+		s.Where(sql.P(func(b *sql.Builder) {
+			b.WriteString(s.C(searchVectorColumn)).WriteString(" @@ ")
+			writeTSQuery(b, query)
+		}))
+	}
+}
+
+// textMatches filtra pela coluna de texto column, para tabelas pequenas que
+// não têm a coluna tsvector, como categorias e status
+func textMatches(column, query string) func(*sql.Selector) {
+	return func(s *sql.Selector) {
+		s.Where(sql.P(func(b *sql.Builder) {
+			b.WriteString("to_tsvector('portuguese', f_unaccent(").WriteString(s.C(column)).WriteString(")) @@ ")
+			writeTSQuery(b, query)
+		}))
+	}
+}
+
+// rankBySearch indica se a listagem deve ser ordenada pela relevância: há
+// busca e o cliente não escolheu a ordenação. No modo por cursor, a ordem
+// pedida é mantida, pois a relevância não é guardada no cursor.
+func rankBySearch(pgn *pagination.Pagination) bool {
+	return searchQuery(pgn.Search) != "" && pgn.UsesDefaultOrder() && !pgn.UsesCursor()
+}
+
+// searchRank ordena pela relevância do registro na busca
+func searchRank(query string) func(*sql.Selector) {
+	return func(s *sql.Selector) {
+		// OrderExprFunc descarta os argumentos da expressão; ExprFunc os mantém
+		s.OrderExpr(sql.ExprFunc(func(b *sql.Builder) {
+			b.WriteString("ts_rank(").WriteString(s.C(searchVectorColumn)).WriteString(", ")
+			writeTSQuery(b, query)
+			b.WriteString(") DESC")
+		}))
+	}
+}
@@ -0,0 +1,218 @@
+package postgresql
+
+import (
+	"backend-go/internal/api/errs"
+	"backend-go/internal/api/v1/dto"
+	"backend-go/internal/api/v1/repository/models"
+	"backend-go/pkg/ent"
+	"backend-go/pkg/ent/tag"
+	"backend-go/pkg/pagination"
+	"context"
+
+	"github.com/google/uuid"
+)
+
+func (d *PostgreSQL) GetTagByID(ctx context.Context, id uuid.UUID) (*dto.TagResponse, error) {
+	workspaceID, err := currentWorkspaceID(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	row, err := d.Client.Tag.Query().
+		Where(tag.ID(id), tag.WorkspaceID(workspaceID)).
+		Only(ctx)
+	if err != nil {
+		if ent.IsNotFound(err) {
+			return nil, errs.ErrNotFound
+		}
+		return nil, err
+	}
+	return newTagResponse(row)
+}
+
+// DeleteTagByID remove a tag e a desassocia de todos os débitos
+func (d *PostgreSQL) DeleteTagByID(ctx context.Context, id uuid.UUID) error {
+	workspaceID, err := currentWorkspaceID(ctx)
+	if err != nil {
+		return err
+	}
+
+	deleted, err := d.Client.Tag.Delete().
+		Where(tag.ID(id), tag.WorkspaceID(workspaceID)).
+		Exec(ctx)
+	if err != nil {
+		return err
+	}
+	if deleted == 0 {
+		return errs.ErrNotFound
+	}
+	return nil
+}
+
+func (d *PostgreSQL) InsertTag(ctx context.Context, input models.Tag) (*dto.TagResponse, error) {
+	workspaceID, err := currentWorkspaceID(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	created, err := d.Client.Tag.
+		Create().
+		SetWorkspaceID(workspaceID).
+		SetName(input.Name).
+		Save(ctx)
+
+	if err != nil {
+		if ent.IsConstraintError(err) {
+			return nil, errs.InvalidParam("name", errs.ErrConflict)
+		}
+		return nil, errs.FailedToSave("tags", err)
+	}
+
+	return newTagResponse(created)
+}
+
+func (d *PostgreSQL) UpdateTag(ctx context.Context, input models.Tag) (*dto.TagResponse, error) {
+	workspaceID, err := currentWorkspaceID(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	updated, err := d.Client.Tag.
+		UpdateOneID(input.ID).
+		Where(tag.WorkspaceID(workspaceID)).
+		SetName(input.Name).
+		Save(ctx)
+
+	if err != nil {
+		if ent.IsNotFound(err) {
+			return nil, errs.ErrNotFound
+		}
+		if ent.IsConstraintError(err) {
+			return nil, errs.InvalidParam("name", errs.ErrConflict)
+		}
+		return nil, errs.FailedToSave("tags", err)
+	}
+
+	return newTagResponse(updated)
+}
+
+func (d *PostgreSQL) ListTags(ctx context.Context, pgn *pagination.Pagination) ([]dto.TagResponse, error) {
+	workspaceID, err := currentWorkspaceID(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	query := d.Client.Tag.Query().Where(tag.WorkspaceID(workspaceID))
+
+	query = applyTagFilters(query, pgn)
+	query = query.Where(pgn.CursorPredicate(nil))
+	query = query.Order(pgn.Order(nil))
+	query = query.Limit(pgn.Limit()).Offset(pgn.Offset())
+
+	data, err := query.All(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	data, err = pagination.Trim(pgn, data, tagSortValue)
+	if err != nil {
+		return nil, err
+	}
+	return newTagResponseList(data)
+}
+
+func (d *PostgreSQL) CountTags(ctx context.Context, pgn *pagination.Pagination) (int, error) {
+	workspaceID, err := currentWorkspaceID(ctx)
+	if err != nil {
+		return 0, err
+	}
+
+	query := d.Client.Tag.Query().Where(tag.WorkspaceID(workspaceID))
+	query = applyTagFilters(query, pgn)
+
+	total, err := query.Count(ctx)
+	if err != nil {
+		return 0, err
+	}
+	return total, nil
+}
+
+// resolveTagIDs retorna os IDs das tags com os nomes informados, criando as
+// que ainda não existem no workspace
+func resolveTagIDs(ctx context.Context, client *ent.TagClient, workspaceID uuid.UUID, names []string) ([]uuid.UUID, error) {
+	if len(names) == 0 {
+		return []uuid.UUID{}, nil
+	}
+
+	existing, err := client.Query().
+		Where(tag.WorkspaceID(workspaceID), tag.NameIn(names...)).
+		All(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	ids := make(map[string]uuid.UUID, len(names))
+	for _, row := range existing {
+		ids[row.Name] = row.ID
+	}
+
+	result := make([]uuid.UUID, 0, len(names))
+	for _, name := range names {
+		id, ok := ids[name]
+		if !ok {
+			created, err := client.Create().
+				SetWorkspaceID(workspaceID).
+				SetName(name).
+				Save(ctx)
+			if err != nil {
+				return nil, errs.FailedToSave("tags", err)
+			}
+			id = created.ID
+			ids[name] = id
+		}
+		result = append(result, id)
+	}
+	return result, nil
+}
+
+// tagSortValue retorna o valor de uma coluna de ordenação, usado no cursor
+func tagSortValue(row *ent.Tag, column string) any {
+	if column == tag.FieldName {
+		return row.Name
+	}
+	return row.ID
+}
+
+func mapTagToResponse(row *ent.Tag) dto.TagResponse {
+	return dto.TagResponse{
+		ID:      row.ID,
+		Name:    row.Name,
+		Version: row.Version,
+	}
+}
+
+func newTagResponse(row *ent.Tag) (*dto.TagResponse, error) {
+	if row == nil {
+		return nil, nil
+	}
+	response := mapTagToResponse(row)
+	return &response, nil
+}
+
+func newTagResponseList(rows []*ent.Tag) ([]dto.TagResponse, error) {
+	if rows == nil {
+		return nil, nil
+	}
+	response := make([]dto.TagResponse, 0, len(rows))
+	for _, row := range rows {
+		response = append(response, mapTagToResponse(row))
+	}
+	return response, nil
+}
+
+func applyTagFilters(query *ent.TagQuery, pgn *pagination.Pagination) *ent.TagQuery {
+	if pgn.Search != "" {
+		query = query.Where(tag.NameContainsFold(pgn.Search))
+	}
+	return query
+}
@@ -0,0 +1,92 @@
+package postgresql
+
+import (
+	"backend-go/internal/api/v1/repository/models"
+	"backend-go/pkg/ent/attachment"
+	"backend-go/pkg/ent/category"
+	"backend-go/pkg/ent/debt"
+	"backend-go/pkg/ent/invoice"
+	"backend-go/pkg/ent/person"
+	"backend-go/pkg/hooks"
+	"backend-go/pkg/utils"
+	"context"
+	"time"
+)
+
+// PurgeDeleted apaga definitivamente, de todos os workspaces, os registros que
+// estão na lixeira desde antes de before. Pessoas que ainda têm divisões em
+// algum débito e faturas ou categorias ainda usadas por débitos fora da
+// lixeira são mantidas.
+func (d *PostgreSQL) PurgeDeleted(ctx context.Context, before time.Time) (*models.PurgeResult, error) {
+	ctx = hooks.IncludeDeleted(ctx)
+
+	tx, err := d.Client.Tx(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	debtIDs, err := tx.Debt.Query().Where(debt.DeletedAtLT(before)).IDs(ctx)
+	if err != nil {
+		_ = tx.Rollback()
+		return nil, err
+	}
+
+	invoiceIDs, err := tx.Invoice.Query().
+		Where(invoice.DeletedAtLT(before), invoice.Not(invoice.HasDebtsWith(debt.DeletedAtIsNil()))).
+		IDs(ctx)
+	if err != nil {
+		_ = tx.Rollback()
+		return nil, err
+	}
+
+	// Os anexos saem em cascata com o débito ou a fatura, mas os arquivos
+	// precisam ser removidos do storage depois do commit
+	keys, err := tx.Attachment.Query().
+		Where(attachment.Or(attachment.DebtIDIn(debtIDs...), attachment.InvoiceIDIn(invoiceIDs...))).
+		Select(attachment.FieldStorageKey).
+		Strings(ctx)
+	if err != nil {
+		_ = tx.Rollback()
+		return nil, err
+	}
+
+	result := &models.PurgeResult{StorageKeys: keys}
+
+	if result.Debts, err = tx.Debt.Delete().Where(debt.IDIn(debtIDs...)).Exec(ctx); err != nil {
+		_ = tx.Rollback()
+		return nil, err
+	}
+
+	if result.Invoices, err = tx.Invoice.Delete().Where(invoice.IDIn(invoiceIDs...)).Exec(ctx); err != nil {
+		_ = tx.Rollback()
+		return nil, err
+	}
+
+	result.Categories, err = tx.Category.Delete().
+		Where(category.DeletedAtLT(before), category.Not(category.HasDebtsWith(debt.DeletedAtIsNil()))).
+		Exec(ctx)
+	if err != nil {
+		_ = tx.Rollback()
+		return nil, err
+	}
+
+	result.People, err = tx.Person.Delete().
+		Where(person.DeletedAtLT(before), person.Not(person.HasShares())).
+		Exec(ctx)
+	if err != nil {
+		_ = tx.Rollback()
+		return nil, err
+	}
+
+	if err := tx.Commit(); err != nil {
+		return nil, err
+	}
+	return result, nil
+}
+
+func formatDeletedAt(t *time.Time) *string {
+	if t == nil {
+		return nil
+	}
+	return utils.ToFormatDateTimePointer(*t)
+}
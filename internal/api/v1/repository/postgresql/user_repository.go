@@ -0,0 +1,89 @@
+package postgresql
+
+import (
+	"backend-go/internal/api/errs"
+	"backend-go/internal/api/v1/dto"
+	"backend-go/internal/api/v1/repository/models"
+	"backend-go/pkg/ent"
+	"backend-go/pkg/ent/user"
+	"backend-go/pkg/utils"
+	"context"
+
+	"github.com/google/uuid"
+)
+
+func (d *PostgreSQL) GetUserByID(ctx context.Context, id uuid.UUID) (*dto.UserResponse, error) {
+	row, err := d.Client.User.Get(ctx, id)
+	if err != nil {
+		if ent.IsNotFound(err) {
+			return nil, errs.ErrNotFound
+		}
+		return nil, err
+	}
+	return newUserResponse(row)
+}
+
+func (d *PostgreSQL) GetUserByEmail(ctx context.Context, email string) (*models.User, error) {
+	row, err := d.Client.User.Query().Where(user.EmailEQ(email)).Only(ctx)
+	if err != nil {
+		if ent.IsNotFound(err) {
+			return nil, errs.ErrNotFound
+		}
+		return nil, err
+	}
+	return &models.User{
+		ID:           row.ID,
+		Name:         row.Name,
+		Email:        row.Email,
+		PasswordHash: row.PasswordHash,
+	}, nil
+}
+
+// InsertUser cria o usuário junto com o seu workspace pessoal e as categorias iniciais
+func (d *PostgreSQL) InsertUser(ctx context.Context, input models.User, categories []string) (*dto.UserResponse, error) {
+	tx, err := d.Client.Tx(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	created, err := tx.User.
+		Create().
+		SetName(input.Name).
+		SetEmail(input.Email).
+		SetPasswordHash(input.PasswordHash).
+		Save(ctx)
+	if err != nil {
+		_ = tx.Rollback()
+		if ent.IsConstraintError(err) {
+			return nil, errs.ErrConflict
+		}
+		return nil, errs.FailedToSave("users", err)
+	}
+
+	if _, err := createWorkspace(ctx, tx, input.Name, created.ID, categories); err != nil {
+		_ = tx.Rollback()
+		return nil, err
+	}
+
+	if err := tx.Commit(); err != nil {
+		return nil, errs.FailedToSave("users", err)
+	}
+	return newUserResponse(created)
+}
+
+func mapUserToResponse(row *ent.User) dto.UserResponse {
+	return dto.UserResponse{
+		ID:        row.ID,
+		Name:      row.Name,
+		Email:     row.Email,
+		CreatedAt: *utils.ToFormatDateTimePointer(row.CreatedAt),
+	}
+}
+
+func newUserResponse(row *ent.User) (*dto.UserResponse, error) {
+	if row == nil {
+		return nil, nil
+	}
+	response := mapUserToResponse(row)
+	return &response, nil
+}
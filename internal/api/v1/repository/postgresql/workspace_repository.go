@@ -0,0 +1,227 @@
+package postgresql
+
+import (
+	"backend-go/internal/api/errs"
+	"backend-go/internal/api/v1/dto"
+	"backend-go/internal/api/v1/repository/models"
+	"backend-go/pkg/auth"
+	"backend-go/pkg/ent"
+	"backend-go/pkg/ent/workspacemember"
+	"backend-go/pkg/utils"
+	"context"
+
+	"github.com/google/uuid"
+)
+
+func (d *PostgreSQL) ListWorkspaces(ctx context.Context) ([]dto.WorkspaceResponse, error) {
+	userID, err := currentUserID(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	rows, err := d.Client.WorkspaceMember.Query().
+		Where(workspacemember.UserID(userID)).
+		WithWorkspace().
+		Order(ent.Asc(workspacemember.FieldCreatedAt)).
+		All(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	response := make([]dto.WorkspaceResponse, 0, len(rows))
+	for _, row := range rows {
+		response = append(response, mapWorkspaceToResponse(row.Edges.Workspace, row.Role.String()))
+	}
+	return response, nil
+}
+
+// InsertWorkspace cria o workspace tendo o usuário autenticado como dono
+func (d *PostgreSQL) InsertWorkspace(ctx context.Context, input models.Workspace, categories []string) (*dto.WorkspaceResponse, error) {
+	userID, err := currentUserID(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	tx, err := d.Client.Tx(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	created, err := createWorkspace(ctx, tx, input.Name, userID, categories)
+	if err != nil {
+		_ = tx.Rollback()
+		return nil, err
+	}
+
+	if err := tx.Commit(); err != nil {
+		return nil, errs.FailedToSave("workspaces", err)
+	}
+
+	response := mapWorkspaceToResponse(created, auth.RoleOwner)
+	return &response, nil
+}
+
+func (d *PostgreSQL) GetWorkspaceMember(ctx context.Context, workspaceID, userID uuid.UUID) (*models.WorkspaceMember, error) {
+	row, err := d.Client.WorkspaceMember.Query().
+		Where(
+			workspacemember.WorkspaceID(workspaceID),
+			workspacemember.UserID(userID),
+		).
+		Only(ctx)
+	if err != nil {
+		if ent.IsNotFound(err) {
+			return nil, errs.ErrNotFound
+		}
+		return nil, err
+	}
+	return newWorkspaceMemberModel(row), nil
+}
+
+// GetDefaultWorkspaceMember retorna o workspace pessoal do usuário, que é o
+// primeiro em que ele é dono
+func (d *PostgreSQL) GetDefaultWorkspaceMember(ctx context.Context, userID uuid.UUID) (*models.WorkspaceMember, error) {
+	row, err := d.Client.WorkspaceMember.Query().
+		Where(
+			workspacemember.UserID(userID),
+			workspacemember.RoleEQ(workspacemember.RoleOwner),
+		).
+		Order(ent.Asc(workspacemember.FieldCreatedAt)).
+		First(ctx)
+	if err != nil {
+		if ent.IsNotFound(err) {
+			return nil, errs.ErrNotFound
+		}
+		return nil, err
+	}
+	return newWorkspaceMemberModel(row), nil
+}
+
+func (d *PostgreSQL) ListWorkspaceMembers(ctx context.Context, workspaceID uuid.UUID) ([]dto.WorkspaceMemberResponse, error) {
+	rows, err := d.Client.WorkspaceMember.Query().
+		Where(workspacemember.WorkspaceID(workspaceID)).
+		WithUser().
+		Order(ent.Asc(workspacemember.FieldCreatedAt)).
+		All(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	response := make([]dto.WorkspaceMemberResponse, 0, len(rows))
+	for _, row := range rows {
+		response = append(response, mapWorkspaceMemberToResponse(row))
+	}
+	return response, nil
+}
+
+func (d *PostgreSQL) CountWorkspaceOwners(ctx context.Context, workspaceID uuid.UUID) (int, error) {
+	return d.Client.WorkspaceMember.Query().
+		Where(
+			workspacemember.WorkspaceID(workspaceID),
+			workspacemember.RoleEQ(workspacemember.RoleOwner),
+		).
+		Count(ctx)
+}
+
+func (d *PostgreSQL) InsertWorkspaceMember(ctx context.Context, input models.WorkspaceMember) (*dto.WorkspaceMemberResponse, error) {
+	created, err := d.Client.WorkspaceMember.
+		Create().
+		SetWorkspaceID(input.WorkspaceID).
+		SetUserID(input.UserID).
+		SetRole(workspacemember.Role(input.Role)).
+		Save(ctx)
+	if err != nil {
+		if ent.IsConstraintError(err) {
+			return nil, errs.ErrConflict
+		}
+		return nil, errs.FailedToSave("workspace_members", err)
+	}
+
+	row, err := d.Client.WorkspaceMember.Query().
+		Where(workspacemember.ID(created.ID)).
+		WithUser().
+		Only(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	response := mapWorkspaceMemberToResponse(row)
+	return &response, nil
+}
+
+func (d *PostgreSQL) DeleteWorkspaceMember(ctx context.Context, workspaceID, userID uuid.UUID) error {
+	deleted, err := d.Client.WorkspaceMember.Delete().
+		Where(
+			workspacemember.WorkspaceID(workspaceID),
+			workspacemember.UserID(userID),
+		).
+		Exec(ctx)
+	if err != nil {
+		return err
+	}
+	if deleted == 0 {
+		return errs.ErrNotFound
+	}
+	return nil
+}
+
+// createWorkspace cria o workspace, o membro dono e as categorias iniciais dentro da transação
+func createWorkspace(ctx context.Context, tx *ent.Tx, name string, ownerID uuid.UUID, categories []string) (*ent.Workspace, error) {
+	created, err := tx.Workspace.
+		Create().
+		SetName(name).
+		Save(ctx)
+	if err != nil {
+		return nil, errs.FailedToSave("workspaces", err)
+	}
+
+	err = tx.WorkspaceMember.
+		Create().
+		SetWorkspaceID(created.ID).
+		SetUserID(ownerID).
+		SetRole(workspacemember.RoleOwner).
+		Exec(ctx)
+	if err != nil {
+		return nil, errs.FailedToSave("workspace_members", err)
+	}
+
+	builders := make([]*ent.CategoryCreate, 0, len(categories))
+	for _, name := range categories {
+		builders = append(builders, tx.Category.Create().SetName(name).SetWorkspaceID(created.ID))
+	}
+
+	if err := tx.Category.CreateBulk(builders...).Exec(ctx); err != nil {
+		return nil, errs.FailedToSave("categories", err)
+	}
+	return created, nil
+}
+
+func mapWorkspaceToResponse(row *ent.Workspace, role string) dto.WorkspaceResponse {
+	return dto.WorkspaceResponse{
+		ID:        row.ID,
+		Name:      row.Name,
+		Role:      role,
+		CreatedAt: *utils.ToFormatDateTimePointer(row.CreatedAt),
+	}
+}
+
+func mapWorkspaceMemberToResponse(row *ent.WorkspaceMember) dto.WorkspaceMemberResponse {
+	response := dto.WorkspaceMemberResponse{
+		UserID:    row.UserID,
+		Role:      row.Role.String(),
+		CreatedAt: *utils.ToFormatDateTimePointer(row.CreatedAt),
+	}
+	if row.Edges.User != nil {
+		response.Name = row.Edges.User.Name
+		response.Email = row.Edges.User.Email
+	}
+	return response
+}
+
+func newWorkspaceMemberModel(row *ent.WorkspaceMember) *models.WorkspaceMember {
+	return &models.WorkspaceMember{
+		ID:          row.ID,
+		WorkspaceID: row.WorkspaceID,
+		UserID:      row.UserID,
+		Role:        row.Role.String(),
+	}
+}
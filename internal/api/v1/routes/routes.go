@@ -24,12 +24,87 @@ func RegisterDocsRoutes(router *gin.RouterGroup) {
 
 }
 
+func RegisterHealthRoutes(router *gin.RouterGroup, handler *handlers.HealthHandler) {
+	router.GET("/healthz", handler.LivenessHandler)
+	router.GET("/readyz", handler.ReadinessHandler)
+}
+
+func RegisterAuthRoutes(router *gin.RouterGroup, handler *handlers.AuthHandler, authMiddleware gin.HandlerFunc) {
+	router.POST("/register", handler.RegisterHandler)
+	router.POST("/login", handler.LoginHandler)
+	router.POST("/refresh", handler.RefreshHandler)
+	router.GET("/me", authMiddleware, handler.MeHandler)
+}
+
+func RegisterAPIKeyRoutes(router *gin.RouterGroup, handler *handlers.APIKeyHandler) {
+	router.GET("", handler.ListAPIKeysHandler)
+	router.POST("", handler.CreateAPIKeyHandler)
+	router.DELETE("/:id", handler.RevokeAPIKeyHandler)
+}
+
+func RegisterWorkspaceRoutes(router *gin.RouterGroup, handler *handlers.WorkspaceHandler) {
+	router.GET("", handler.ListWorkspacesHandler)
+	router.POST("", handler.CreateWorkspaceHandler)
+	router.GET("/:id/members", handler.ListMembersHandler)
+	router.POST("/:id/members", handler.AddMemberHandler)
+	router.DELETE("/:id/members/:user_id", handler.RemoveMemberHandler)
+}
+
 func RegisterDebtRoutes(router *gin.RouterGroup, handler *handlers.DebtHandler) {
 	router.POST("", handler.CreateDebtHandler)
+	router.POST("/bulk", handler.BulkDebtsHandler)
 	router.GET("", handler.ListDebtsHandler)
 	router.GET("/:id", handler.GetDebtByIDHandler)
 	router.PUT("/:id", handler.UpdateDebtHandler)
+	router.PATCH("/:id", handler.PatchDebtHandler)
 	router.DELETE("/:id", handler.DeleteDebtHandler)
+	router.GET("/trash", handler.ListDeletedDebtsHandler)
+	router.POST("/:id/restore", handler.RestoreDebtHandler)
+	router.GET("/:id/shares", handler.ListDebtSharesHandler)
+	router.PUT("/:id/shares", handler.ReplaceDebtSharesHandler)
+	router.GET("/:id/history", handler.ListDebtHistoryHandler)
+}
+
+// RegisterAttachmentRoutes registra as rotas de anexos, inclusive as
+// aninhadas em débitos e faturas
+func RegisterAttachmentRoutes(router *gin.RouterGroup, handler *handlers.AttachmentHandler) {
+	router.GET("/debts/:id/attachments", handler.ListDebtAttachmentsHandler)
+	router.POST("/debts/:id/attachments", handler.UploadDebtAttachmentHandler)
+	router.GET("/invoices/:id/attachments", handler.ListInvoiceAttachmentsHandler)
+	router.POST("/invoices/:id/attachments", handler.UploadInvoiceAttachmentHandler)
+	router.GET("/attachments/:id/download", handler.DownloadAttachmentHandler)
+	router.DELETE("/attachments/:id", handler.DeleteAttachmentHandler)
+}
+
+func RegisterTagRoutes(router *gin.RouterGroup, handler *handlers.TagHandler) {
+	router.POST("", handler.CreateTagHandler)
+	router.GET("", handler.ListTagsHandler)
+	router.GET("/:id", handler.GetTagByIDHandler)
+	router.PUT("/:id", handler.UpdateTagHandler)
+	router.PATCH("/:id", handler.PatchTagHandler)
+	router.DELETE("/:id", handler.DeleteTagHandler)
+}
+
+func RegisterReportRoutes(router *gin.RouterGroup, handler *handlers.ReportHandler) {
+	router.GET("/categories", handler.CategoriesReportHandler)
+	router.GET("/tags", handler.TagsReportHandler)
+}
+
+func RegisterPersonRoutes(router *gin.RouterGroup, handler *handlers.PersonHandler) {
+	router.POST("", handler.CreatePersonHandler)
+	router.GET("", handler.ListPeopleHandler)
+	router.GET("/:id", handler.GetPersonByIDHandler)
+	router.GET("/:id/balance", handler.GetPersonBalanceHandler)
+	router.PUT("/:id", handler.UpdatePersonHandler)
+	router.PATCH("/:id", handler.PatchPersonHandler)
+	router.DELETE("/:id", handler.DeletePersonHandler)
+	router.GET("/trash", handler.ListDeletedPeopleHandler)
+	router.POST("/:id/restore", handler.RestorePersonHandler)
+}
+
+func RegisterImportRoutes(router *gin.RouterGroup, handler *handlers.ImportHandler) {
+	router.POST("", handler.CommitImportHandler)
+	router.POST("/preview", handler.PreviewImportHandler)
 }
 
 func RegisterInvoiceRoutes(router *gin.RouterGroup, handler *handlers.InvoiceHandler) {
@@ -37,7 +112,10 @@ func RegisterInvoiceRoutes(router *gin.RouterGroup, handler *handlers.InvoiceHan
 	router.GET("", handler.ListInvoicesHandler)
 	router.GET("/:id", handler.GetInvoiceByIDHandler)
 	router.PUT("/:id", handler.UpdateInvoiceHandler)
+	router.PATCH("/:id", handler.PatchInvoiceHandler)
 	router.DELETE("/:id", handler.DeleteInvoiceHandler)
+	router.GET("/trash", handler.ListDeletedInvoicesHandler)
+	router.POST("/:id/restore", handler.RestoreInvoiceHandler)
 }
 
 func RegisterCategoryRoutes(router *gin.RouterGroup, handler *handlers.CategoryHandler) {
@@ -45,13 +123,15 @@ func RegisterCategoryRoutes(router *gin.RouterGroup, handler *handlers.CategoryH
 	router.GET("", handler.ListCategorysHandler)
 	router.GET("/:id", handler.GetCategoryByIDHandler)
 	router.PUT("/:id", handler.UpdateCategoryHandler)
+	router.PATCH("/:id", handler.PatchCategoryHandler)
 	router.DELETE("/:id", handler.DeleteCategoryHandler)
+	router.GET("/trash", handler.ListDeletedCategoriesHandler)
+	router.POST("/:id/restore", handler.RestoreCategoryHandler)
 }
 
+// RegisterPaymentStatusRoutes expõe apenas a leitura: os status são
+// compartilhados por todos os workspaces e criados pelas migrations
 func RegisterPaymentStatusRoutes(router *gin.RouterGroup, handler *handlers.PaymentStatusHandler) {
-	router.POST("", handler.CreatePaymentStatusHandler)
 	router.GET("", handler.ListPaymentStatussHandler)
 	router.GET("/:id", handler.GetPaymentStatusByIDHandler)
-	router.PUT("/:id", handler.UpdatePaymentStatusHandler)
-	router.DELETE("/:id", handler.DeletePaymentStatusHandler)
 }
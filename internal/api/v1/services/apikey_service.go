@@ -0,0 +1,135 @@
+package services
+
+import (
+	"backend-go/internal/api/errs"
+	"backend-go/internal/api/v1/dto"
+	repository "backend-go/internal/api/v1/repository/interfaces"
+	"backend-go/internal/api/v1/repository/models"
+	"backend-go/pkg/auth"
+	"context"
+	"errors"
+	"fmt"
+	"log/slog"
+	"strings"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+type APIKeyService struct {
+	DB repository.Database
+}
+
+func NewAPIKeyService(db repository.Database) *APIKeyService {
+	return &APIKeyService{DB: db}
+}
+
+func (s *APIKeyService) ParseAPIKey(req dto.APIKeyRequest) (models.APIKey, error) {
+	name := strings.TrimSpace(req.Name)
+	if name == "" {
+		return models.APIKey{}, errs.InvalidParam("name", errs.ErrBadRequest)
+	}
+
+	if len(req.Scopes) == 0 {
+		return models.APIKey{}, errs.InvalidParam("scopes", errs.ErrBadRequest)
+	}
+
+	scopes := make([]string, 0, len(req.Scopes))
+	seen := make(map[string]bool)
+	for _, scope := range req.Scopes {
+		scope = strings.TrimSpace(scope)
+		if !auth.ValidScope(scope) {
+			return models.APIKey{}, errs.InvalidParam("scopes", fmt.Errorf("escopo desconhecido: %s", scope))
+		}
+		if !seen[scope] {
+			seen[scope] = true
+			scopes = append(scopes, scope)
+		}
+	}
+
+	input := models.APIKey{Name: name, Scopes: scopes}
+	if req.ExpiresAt != "" {
+		expiresAt, err := time.Parse("2006-01-02", req.ExpiresAt)
+		if err != nil {
+			return models.APIKey{}, errs.DateParsing("expires_at")
+		}
+		if !expiresAt.After(time.Now()) {
+			return models.APIKey{}, errs.InvalidParam("expires_at", errs.ErrBadRequest)
+		}
+		input.ExpiresAt = &expiresAt
+	}
+	return input, nil
+}
+
+func (s *APIKeyService) ListAPIKeys(ctx context.Context) ([]dto.APIKeyResponse, error) {
+	if err := requireSession(ctx); err != nil {
+		return nil, err
+	}
+	return s.DB.ListAPIKeys(ctx)
+}
+
+// CreateAPIKey gera a chave e salva apenas o seu hash. A chave completa só é
+// retornada nesta resposta.
+func (s *APIKeyService) CreateAPIKey(ctx context.Context, input models.APIKey) (*dto.APIKeyCreatedResponse, error) {
+	if err := requireSession(ctx); err != nil {
+		return nil, err
+	}
+
+	key, prefix, hash, err := auth.GenerateAPIKey()
+	if err != nil {
+		return nil, errs.UnknownWithContext("gerar chave de API", err)
+	}
+	input.Prefix = prefix
+	input.KeyHash = hash
+
+	created, err := s.DB.InsertAPIKey(ctx, input)
+	if err != nil {
+		return nil, err
+	}
+
+	return &dto.APIKeyCreatedResponse{APIKeyResponse: *created, Key: key}, nil
+}
+
+func (s *APIKeyService) RevokeAPIKey(ctx context.Context, id uuid.UUID) error {
+	if err := requireSession(ctx); err != nil {
+		return err
+	}
+	return s.DB.RevokeAPIKey(ctx, id)
+}
+
+// Authenticate valida a chave recebida no header Authorization e registra o
+// seu uso. Chaves revogadas, expiradas ou desconhecidas retornam ErrUnauthorized.
+func (s *APIKeyService) Authenticate(ctx context.Context, key string) (*models.APIKey, error) {
+	if !strings.HasPrefix(key, auth.APIKeyPrefix) {
+		return nil, errs.ErrUnauthorized
+	}
+
+	apiKey, err := s.DB.GetAPIKeyByHash(ctx, auth.HashAPIKey(key))
+	if err != nil {
+		if errors.Is(err, errs.ErrNotFound) {
+			return nil, errs.ErrUnauthorized
+		}
+		return nil, err
+	}
+
+	if apiKey.RevokedAt != nil {
+		return nil, errs.ErrUnauthorized
+	}
+	if apiKey.ExpiresAt != nil && time.Now().After(*apiKey.ExpiresAt) {
+		return nil, errs.ErrUnauthorized
+	}
+
+	// Falhar ao registrar o uso não deve impedir a requisição
+	if err := s.DB.TouchAPIKey(ctx, apiKey.ID); err != nil {
+		slog.WarnContext(ctx, "erro ao registrar uso da chave de API", "error", err)
+	}
+	return apiKey, nil
+}
+
+// requireSession impede que chaves de API gerenciem outras chaves
+func requireSession(ctx context.Context) error {
+	if auth.IsAPIKey(ctx) {
+		return errs.ErrForbidden
+	}
+	return nil
+}
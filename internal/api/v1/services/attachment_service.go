@@ -0,0 +1,153 @@
+package services
+
+import (
+	"backend-go/internal/api/errs"
+	"backend-go/internal/api/v1/dto"
+	repository "backend-go/internal/api/v1/repository/interfaces"
+	"backend-go/internal/api/v1/repository/models"
+	storage "backend-go/internal/api/v1/storage/interfaces"
+	"backend-go/pkg/auth"
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"log/slog"
+	"net/http"
+	"path/filepath"
+	"strings"
+
+	"github.com/google/uuid"
+)
+
+// Tamanho máximo padrão de um anexo
+const DefaultMaxAttachmentSize int64 = 10 << 20
+
+// Tipos aceitos nos anexos. O tipo é detectado pelo conteúdo do arquivo, e não
+// pelo Content-Type enviado pelo cliente.
+var AttachmentContentTypes = map[string]bool{
+	"application/pdf": true,
+	"image/jpeg":      true,
+	"image/png":       true,
+	"image/webp":      true,
+}
+
+type AttachmentService struct {
+	DB      repository.Database
+	Storage storage.FileStorage
+	MaxSize int64
+}
+
+func NewAttachmentService(db repository.Database, fs storage.FileStorage, maxSize int64) *AttachmentService {
+	if maxSize <= 0 {
+		maxSize = DefaultMaxAttachmentSize
+	}
+	return &AttachmentService{DB: db, Storage: fs, MaxSize: maxSize}
+}
+
+func (s *AttachmentService) ListAttachments(ctx context.Context, debtID, invoiceID *uuid.UUID) ([]dto.AttachmentResponse, error) {
+	return s.DB.ListAttachments(ctx, debtID, invoiceID)
+}
+
+// UploadAttachment valida o arquivo, envia o conteúdo ao storage e salva o
+// registro. Se o registro não puder ser salvo, o arquivo é removido do storage.
+func (s *AttachmentService) UploadAttachment(ctx context.Context, input models.Attachment, file io.ReadSeeker, size int64) (*dto.AttachmentResponse, error) {
+	workspace, ok := auth.WorkspaceFromContext(ctx)
+	if !ok {
+		return nil, errs.ErrUnauthorized
+	}
+
+	if size <= 0 {
+		return nil, errs.InvalidParam("file", errs.ErrBadRequest)
+	}
+	if size > s.MaxSize {
+		return nil, errs.InvalidParam("file", fmt.Errorf("%w: o limite é de %d bytes", errs.ErrPayloadTooLarge, s.MaxSize))
+	}
+
+	contentType, err := detectContentType(file)
+	if err != nil {
+		return nil, errs.ParsingField("file", err)
+	}
+	if !AttachmentContentTypes[contentType] {
+		return nil, errs.InvalidParam("file", fmt.Errorf("%w: %s", errs.ErrUnsupportedMedia, contentType))
+	}
+
+	input.ID = uuid.New()
+	input.FileName = sanitizeFileName(input.FileName)
+	input.ContentType = contentType
+	input.Size = size
+	input.StorageKey = workspace.ID.String() + "/" + input.ID.String()
+
+	if err := s.Storage.Put(ctx, input.StorageKey, file, size, contentType); err != nil {
+		return nil, errs.UnknownWithContext("salvar arquivo", err)
+	}
+
+	created, err := s.DB.InsertAttachment(ctx, input)
+	if err != nil {
+		if delErr := s.Storage.Delete(ctx, input.StorageKey); delErr != nil {
+			slog.WarnContext(ctx, "erro ao remover arquivo do storage", "key", input.StorageKey, "error", delErr)
+		}
+		return nil, err
+	}
+	return created, nil
+}
+
+// OpenAttachment retorna os dados do anexo e o seu conteúdo, que deve ser fechado
+func (s *AttachmentService) OpenAttachment(ctx context.Context, id uuid.UUID) (*models.Attachment, io.ReadCloser, error) {
+	attachment, err := s.DB.GetAttachmentByID(ctx, id)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	body, err := s.Storage.Get(ctx, attachment.StorageKey)
+	if err != nil {
+		if errors.Is(err, storage.ErrObjectNotFound) {
+			return nil, nil, errs.ErrNotFound
+		}
+		return nil, nil, errs.UnknownWithContext("ler arquivo", err)
+	}
+	return attachment, body, nil
+}
+
+func (s *AttachmentService) DeleteAttachmentByID(ctx context.Context, id uuid.UUID) error {
+	attachment, err := s.DB.GetAttachmentByID(ctx, id)
+	if err != nil {
+		return err
+	}
+
+	if err := s.DB.DeleteAttachmentByID(ctx, id); err != nil {
+		return err
+	}
+
+	// O registro já foi removido; uma falha aqui só deixa o arquivo órfão
+	if err := s.Storage.Delete(ctx, attachment.StorageKey); err != nil {
+		slog.WarnContext(ctx, "erro ao remover arquivo do storage", "key", attachment.StorageKey, "error", err)
+	}
+	return nil
+}
+
+// detectContentType lê o início do arquivo para descobrir o tipo e volta ao começo
+func detectContentType(file io.ReadSeeker) (string, error) {
+	head := make([]byte, 512)
+	n, err := io.ReadFull(file, head)
+	if err != nil && !errors.Is(err, io.ErrUnexpectedEOF) {
+		return "", err
+	}
+	if _, err := file.Seek(0, io.SeekStart); err != nil {
+		return "", err
+	}
+
+	contentType, _, _ := strings.Cut(http.DetectContentType(head[:n]), ";")
+	return strings.TrimSpace(contentType), nil
+}
+
+// sanitizeFileName mantém apenas o nome do arquivo, sem diretórios
+func sanitizeFileName(name string) string {
+	name = filepath.Base(strings.ReplaceAll(name, "\\", "/"))
+	if name == "." || name == "/" || name == "" {
+		return "arquivo"
+	}
+	if len(name) > 255 {
+		name = name[len(name)-255:]
+	}
+	return name
+}
@@ -0,0 +1,122 @@
+package services
+
+import (
+	"backend-go/internal/api/config"
+	"backend-go/internal/api/errs"
+	"backend-go/internal/api/v1/dto"
+	repository "backend-go/internal/api/v1/repository/interfaces"
+	"backend-go/internal/api/v1/repository/models"
+	"backend-go/pkg/auth"
+	"context"
+	"errors"
+	"fmt"
+	"net/mail"
+	"strings"
+
+	"github.com/google/uuid"
+)
+
+const MinPasswordLength = 8
+
+type AuthService struct {
+	DB     repository.Database
+	Tokens *auth.TokenManager
+}
+
+func NewAuthService(db repository.Database, tokens *auth.TokenManager) *AuthService {
+	return &AuthService{DB: db, Tokens: tokens}
+}
+
+func (s *AuthService) ParseUser(req dto.RegisterRequest) (models.User, error) {
+	name := strings.TrimSpace(req.Name)
+	if name == "" {
+		return models.User{}, errs.InvalidParam("name", errs.ErrBadRequest)
+	}
+
+	email := normalizeEmail(req.Email)
+	if _, err := mail.ParseAddress(email); err != nil {
+		return models.User{}, errs.ParsingField("email", err)
+	}
+
+	if len(req.Password) < MinPasswordLength {
+		return models.User{}, errs.InvalidParam("password", fmt.Errorf("mínimo de %d caracteres", MinPasswordLength))
+	}
+
+	hash, err := auth.HashPassword(req.Password)
+	if err != nil {
+		return models.User{}, errs.UnknownWithContext("gerar hash da senha", err)
+	}
+
+	return models.User{
+		Name:         name,
+		Email:        email,
+		PasswordHash: hash,
+	}, nil
+}
+
+// Register cria o usuário com as categorias padrão
+func (s *AuthService) Register(ctx context.Context, input models.User) (*dto.UserResponse, error) {
+	return s.DB.InsertUser(ctx, input, config.DefaultCategories)
+}
+
+// Login valida as credenciais e emite os tokens. E-mail inexistente e senha
+// errada retornam o mesmo erro, no mesmo tempo, para não revelar quais contas
+// existem.
+func (s *AuthService) Login(ctx context.Context, req dto.LoginRequest) (*dto.TokenResponse, error) {
+	user, err := s.DB.GetUserByEmail(ctx, normalizeEmail(req.Email))
+	if err != nil {
+		if errors.Is(err, errs.ErrNotFound) {
+			auth.CheckDummyPassword(req.Password)
+			return nil, errs.ErrUnauthorized
+		}
+		return nil, err
+	}
+
+	if !auth.CheckPassword(user.PasswordHash, req.Password) {
+		return nil, errs.ErrUnauthorized
+	}
+
+	return s.issueTokens(user.ID)
+}
+
+// Refresh emite um novo par de tokens a partir de um refresh token válido.
+// Os refresh tokens não são guardados no servidor: não há como revogá-los, e
+// o token usado continua válido até expirar. Por isso o auth.refresh_ttl deve
+// ser curto.
+func (s *AuthService) Refresh(ctx context.Context, req dto.RefreshRequest) (*dto.TokenResponse, error) {
+	userID, err := s.Tokens.ParseToken(req.RefreshToken, auth.RefreshToken)
+	if err != nil {
+		return nil, errs.ErrUnauthorized
+	}
+
+	if _, err := s.DB.GetUserByID(ctx, userID); err != nil {
+		if errors.Is(err, errs.ErrNotFound) {
+			return nil, errs.ErrUnauthorized
+		}
+		return nil, err
+	}
+
+	return s.issueTokens(userID)
+}
+
+func (s *AuthService) GetUserByID(ctx context.Context, id uuid.UUID) (*dto.UserResponse, error) {
+	return s.DB.GetUserByID(ctx, id)
+}
+
+func (s *AuthService) issueTokens(userID uuid.UUID) (*dto.TokenResponse, error) {
+	tokens, err := s.Tokens.GenerateTokens(userID)
+	if err != nil {
+		return nil, errs.UnknownWithContext("gerar tokens", err)
+	}
+
+	return &dto.TokenResponse{
+		AccessToken:  tokens.AccessToken,
+		RefreshToken: tokens.RefreshToken,
+		TokenType:    "Bearer",
+		ExpiresIn:    int(tokens.ExpiresIn.Seconds()),
+	}, nil
+}
+
+func normalizeEmail(email string) string {
+	return strings.ToLower(strings.TrimSpace(email))
+}
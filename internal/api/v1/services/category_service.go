@@ -5,6 +5,7 @@ import (
 	repository "backend-go/internal/api/v1/repository/interfaces"
 	"backend-go/internal/api/v1/repository/models"
 	"backend-go/pkg/pagination"
+	"backend-go/pkg/utils"
 	"context"
 
 	"github.com/google/uuid"
@@ -21,11 +22,20 @@ func NewCategoryService(db repository.Database) *CategoryService {
 func (s *CategoryService) ParseCategory(req dto.CategoryRequest) (models.Category, error) {
 	return models.Category{
 		Name:        req.Name,
-		Description: &req.Description,
+		Description: utils.ToStrPointer(req.Description),
 	}, nil
 
 }
 
+// ApplyCategoryPatch monta a requisição de atualização a partir da categoria
+// atual com as alterações do patch
+func (s *CategoryService) ApplyCategoryPatch(current dto.CategoryResponse, patch []byte) (dto.CategoryRequest, error) {
+	return applyMergePatch(dto.CategoryRequest{
+		Name:        current.Name,
+		Description: stringValue(current.Description),
+	}, patch)
+}
+
 func (s *CategoryService) CreateCategory(ctx context.Context, input models.Category) (*dto.CategoryResponse, error) {
 	return s.DB.InsertCategory(ctx, input)
 }
@@ -40,9 +50,11 @@ func (s *CategoryService) ListCategories(ctx context.Context, pgn *pagination.Pa
 		return nil, 0, err
 	}
 
-	total, err := s.DB.CountCategories(ctx, pgn)
-	if err != nil {
-		return nil, 0, err
+	total := -1
+	if pgn.WithTotal {
+		if total, err = s.DB.CountCategories(ctx, pgn); err != nil {
+			return nil, 0, err
+		}
 	}
 
 	return data, total, nil
@@ -55,3 +67,23 @@ func (s *CategoryService) GetCategoryByID(ctx context.Context, id uuid.UUID) (*d
 func (s *CategoryService) DeleteCategoryByID(ctx context.Context, id uuid.UUID) error {
 	return s.DB.DeleteCategoryByID(ctx, id)
 }
+
+func (s *CategoryService) ListDeletedCategories(ctx context.Context, pgn *pagination.Pagination) ([]dto.CategoryResponse, int, error) {
+	data, err := s.DB.ListDeletedCategories(ctx, pgn)
+	if err != nil {
+		return nil, 0, err
+	}
+
+	total := -1
+	if pgn.WithTotal {
+		if total, err = s.DB.CountDeletedCategories(ctx); err != nil {
+			return nil, 0, err
+		}
+	}
+
+	return data, total, nil
+}
+
+func (s *CategoryService) RestoreCategoryByID(ctx context.Context, id uuid.UUID) (*dto.CategoryResponse, error) {
+	return s.DB.RestoreCategoryByID(ctx, id)
+}
@@ -9,9 +9,12 @@ import (
 	"backend-go/internal/api/v1/repository/models"
 	"context"
 
+	"backend-go/pkg/fieldset"
+	"backend-go/pkg/filter"
 	"backend-go/pkg/pagination"
 	"backend-go/pkg/utils"
 	"errors"
+	"fmt"
 	"strconv"
 	"time"
 
@@ -27,7 +30,33 @@ func NewDebtService(db repository.Database, mq queue.MessageQueue) *DebtService
 	return &DebtService{DB: db, MQ: mq}
 }
 
+// ParseDebt converte a requisição de criação ou de substituição do débito.
+// Sem categoria informada, tenta categorizar o débito pelo título.
 func (s *DebtService) ParseDebt(ctx context.Context, debtReq dto.DebtRequest) (models.Debt, error) {
+	input, err := parseDebtRequest(debtReq)
+	if err != nil {
+		return models.Debt{}, err
+	}
+
+	if input.CategoryID == nil {
+		category := categorizeTransaction(input.Title)
+		if category != nil {
+			input.CategoryID, err = s.DB.GetCategoryIDByName(ctx, category)
+			if errors.Is(err, errs.ErrNotFound) {
+				return models.Debt{}, errs.ResorceNotFound("category", *category)
+			}
+
+		}
+		if err != nil {
+			return models.Debt{}, errs.UnknownWithContext("buscar categoria", err)
+		}
+	}
+	return input, nil
+}
+
+// parseDebtRequest converte os campos da requisição sem preencher valores
+// padrão
+func parseDebtRequest(debtReq dto.DebtRequest) (models.Debt, error) {
 	purchaseDate, err := time.Parse("2006-01-02", debtReq.PurchaseDate)
 	if err != nil {
 		return models.Debt{}, errs.ParsingField("purchase_date", err)
@@ -43,18 +72,14 @@ func (s *DebtService) ParseDebt(ctx context.Context, debtReq dto.DebtRequest) (m
 		return models.Debt{}, errs.ParsingField("amount", err)
 	}
 
-	var categoryID *uuid.UUID
-	categoryID = nil
-	category := categorizeTransaction(debtReq.Title)
-	if category != nil {
-		categoryID, err = s.DB.GetCategoryIDByName(ctx, category)
-		if errors.Is(err, errs.ErrNotFound) {
-			return models.Debt{}, errs.ResorceNotFound("category", *category)
-		}
-
+	categoryID, err := utils.ToUUIDPointer(debtReq.CategoryID)
+	if err != nil {
+		return models.Debt{}, errs.ParsingField("category_id", err)
 	}
+
+	statusID, err := utils.ToUUIDPointer(debtReq.StatusID)
 	if err != nil {
-		return models.Debt{}, errs.UnknownWithContext("buscar categoria", err)
+		return models.Debt{}, errs.ParsingField("status_id", err)
 	}
 
 	invoiceID, err := utils.ToUUIDPointer(debtReq.InvoiceID)
@@ -62,6 +87,24 @@ func (s *DebtService) ParseDebt(ctx context.Context, debtReq dto.DebtRequest) (m
 		return models.Debt{}, errs.ParsingField("invoice_id", err)
 	}
 
+	tags, err := parseTagNames(debtReq.Tags)
+	if err != nil {
+		return models.Debt{}, err
+	}
+
+	kind, amount, err := parseDebtKind(debtReq.Kind, amount)
+	if err != nil {
+		return models.Debt{}, err
+	}
+
+	refundOfID, err := utils.ToUUIDPointer(debtReq.RefundOfID)
+	if err != nil {
+		return models.Debt{}, errs.ParsingField("refund_of_id", err)
+	}
+	if refundOfID != nil && kind != models.DebtKindRefund {
+		return models.Debt{}, errs.InvalidParam("refund_of_id", errs.ErrBadRequest)
+	}
+
 	return models.Debt{
 		InvoiceID:    invoiceID,
 		Title:        debtReq.Title,
@@ -69,26 +112,93 @@ func (s *DebtService) ParseDebt(ctx context.Context, debtReq dto.DebtRequest) (m
 		PurchaseDate: purchaseDate,
 		DueDate:      dueDate,
 		CategoryID:   categoryID,
+		StatusID:     statusID,
+		Tags:         tags,
+		Kind:         kind,
+		RefundOfID:   refundOfID,
 	}, nil
 }
 
+// ApplyDebtPatch monta a atualização a partir do débito atual com as
+// alterações do patch. Tags ou category_id com null removem as tags ou a
+// categoria; o status não pode ser removido. Ao contrário do ParseDebt, não
+// categoriza o débito automaticamente.
+func (s *DebtService) ApplyDebtPatch(current dto.DebtResponse, patch []byte) (models.Debt, error) {
+	req, err := applyMergePatch(dto.DebtRequest{
+		InvoiceID:    uuidString(current.InvoiceID),
+		PurchaseDate: dateOnly(current.PurchaseDate),
+		DueDate:      stringValue(current.DueDate),
+		Title:        current.Title,
+		Amount:       formatAmount(current.Amount),
+		Tags:         current.Tags,
+		Kind:         current.Kind,
+		RefundOfID:   uuidString(current.RefundOfID),
+		CategoryID:   uuidString(current.CategoryID),
+		StatusID:     uuidString(current.StatusID),
+	}, patch)
+	if err != nil {
+		return models.Debt{}, err
+	}
+
+	if req.StatusID == "" && current.StatusID != nil {
+		return models.Debt{}, errs.InvalidParam("status_id", fmt.Errorf("%w: o status não pode ser removido", errs.ErrBadRequest))
+	}
+	if req.Tags == nil {
+		req.Tags = []string{}
+	}
+	return parseDebtRequest(req)
+}
+
+// parseDebtKind valida o tipo do débito. Os valores são sempre salvos como
+// positivos; um valor negativo sem tipo informado é tratado como estorno.
+func parseDebtKind(kind string, amount float64) (string, float64, error) {
+	if kind == "" {
+		if amount < 0 {
+			return models.DebtKindRefund, -amount, nil
+		}
+		return models.DebtKindPurchase, amount, nil
+	}
+
+	if !models.ValidDebtKind(kind) {
+		return "", 0, errs.InvalidParam("kind", errs.ErrBadRequest)
+	}
+
+	if amount < 0 {
+		if kind != models.DebtKindRefund {
+			return "", 0, errs.InvalidParam("amount", errs.ErrBadRequest)
+		}
+		amount = -amount
+	}
+	return kind, amount, nil
+}
+
 func (s *DebtService) CreateDebt(ctx context.Context, debt models.Debt) (*dto.DebtResponse, error) {
 	return s.DB.InsertDebt(ctx, debt)
 }
 
 func (s *DebtService) UpdateDebt(ctx context.Context, debt models.Debt) (*dto.DebtResponse, error) {
+	if err := s.checkDebtShares(ctx, debt.ID, debt.Amount); err != nil {
+		return nil, err
+	}
 	return s.DB.UpdateDebt(ctx, debt)
 }
 
-func (s *DebtService) ListDebts(ctx context.Context, flt dto.DebtFilters, pgn *pagination.Pagination) ([]dto.DebtResponse, int, error) {
-	debts, err := s.DB.ListDebts(ctx, flt, pgn)
+// ListDebts lista os débitos. conds traz os filtros filter[campo][operador] e
+// flt os parâmetros antigos, convertidos também em filtros. fs define os
+// campos e relacionamentos devolvidos.
+func (s *DebtService) ListDebts(ctx context.Context, flt dto.DebtFilters, conds filter.Filters, pgn *pagination.Pagination, fs *fieldset.Fieldset) ([]dto.DebtResponse, int, error) {
+	conds = append(debtFilterConditions(flt), conds...)
+
+	debts, err := s.DB.ListDebts(ctx, conds, pgn, fs)
 	if err != nil {
 		return nil, 0, err
 	}
 
-	total, err := s.DB.CountDebts(ctx, flt, pgn)
-	if err != nil {
-		return nil, 0, err
+	total := -1
+	if pgn.WithTotal {
+		if total, err = s.DB.CountDebts(ctx, conds, pgn); err != nil {
+			return nil, 0, err
+		}
 	}
 
 	return debts, total, nil
@@ -102,6 +212,43 @@ func (s *DebtService) DeleteDebtByID(ctx context.Context, id uuid.UUID) error {
 	return s.DB.DeleteDebtByID(ctx, id)
 }
 
+func (s *DebtService) ListDeletedDebts(ctx context.Context, pgn *pagination.Pagination) ([]dto.DebtResponse, int, error) {
+	data, err := s.DB.ListDeletedDebts(ctx, pgn)
+	if err != nil {
+		return nil, 0, err
+	}
+
+	total := -1
+	if pgn.WithTotal {
+		if total, err = s.DB.CountDeletedDebts(ctx); err != nil {
+			return nil, 0, err
+		}
+	}
+
+	return data, total, nil
+}
+
+func (s *DebtService) RestoreDebtByID(ctx context.Context, id uuid.UUID) (*dto.DebtResponse, error) {
+	return s.DB.RestoreDebtByID(ctx, id)
+}
+
+// ListDebtHistory retorna as alterações registradas no débito
+func (s *DebtService) ListDebtHistory(ctx context.Context, id uuid.UUID, pgn *pagination.Pagination) ([]dto.AuditEntryResponse, int, error) {
+	data, err := s.DB.ListDebtHistory(ctx, id, pgn)
+	if err != nil {
+		return nil, 0, err
+	}
+
+	total := -1
+	if pgn.WithTotal {
+		if total, err = s.DB.CountDebtHistory(ctx, id); err != nil {
+			return nil, 0, err
+		}
+	}
+
+	return data, total, nil
+}
+
 func categorizeTransaction(name string) *string {
 	if category, exists := config.CategoryMap[name]; exists {
 		return &category
@@ -0,0 +1,263 @@
+package services
+
+import (
+	"backend-go/internal/api/errs"
+	"backend-go/internal/api/v1/dto"
+	repository "backend-go/internal/api/v1/repository/interfaces"
+	"backend-go/internal/api/v1/repository/models"
+	"backend-go/pkg/etag"
+	"backend-go/pkg/utils"
+	"context"
+	"errors"
+	"fmt"
+	"net/http"
+
+	"github.com/google/uuid"
+)
+
+// Modos das operações em lote
+const (
+	BulkModeAllOrNothing = "all_or_nothing"
+	BulkModeBestEffort   = "best_effort"
+)
+
+// MaxBulkOperations limita a quantidade de operações por requisição
+const MaxBulkOperations = 500
+
+const (
+	bulkOpCreate = "create"
+	bulkOpUpdate = "update"
+	bulkOpDelete = "delete"
+)
+
+// errBulkRollback desfaz a transação quando uma operação falha no modo all_or_nothing
+var errBulkRollback = errors.New("operação em lote desfeita")
+
+type bulkDebtOperation struct {
+	index   int
+	op      string
+	id      uuid.UUID
+	version *int
+	input   models.Debt
+}
+
+// BulkDebts executa as operações em uma única transação. As criações são
+// validadas uma a uma e salvas juntas; depois vêm as atualizações e remoções,
+// na ordem enviada. No modo all_or_nothing, qualquer falha desfaz tudo; no
+// best_effort, apenas as operações que falharam ficam de fora. Erros
+// inesperados do banco sempre desfazem a transação inteira.
+func (s *DebtService) BulkDebts(ctx context.Context, req dto.DebtBulkRequest) (*dto.DebtBulkResponse, error) {
+	mode := req.Mode
+	if mode == "" {
+		mode = BulkModeAllOrNothing
+	}
+	if mode != BulkModeAllOrNothing && mode != BulkModeBestEffort {
+		return nil, errs.InvalidParam("mode", errs.ErrBadRequest)
+	}
+	if len(req.Operations) == 0 {
+		return nil, errs.InvalidParam("operations", errs.ErrBadRequest)
+	}
+	if len(req.Operations) > MaxBulkOperations {
+		return nil, errs.InvalidParam("operations", fmt.Errorf("%w: máximo de %d operações", errs.ErrPayloadTooLarge, MaxBulkOperations))
+	}
+
+	results := make([]dto.DebtBulkResult, len(req.Operations))
+	var creates, changes []bulkDebtOperation
+	for i, op := range req.Operations {
+		results[i] = dto.DebtBulkResult{Index: i, Op: op.Op}
+
+		parsed, err := s.parseBulkOperation(ctx, op)
+		if err != nil {
+			results[i].Status = http.StatusBadRequest
+			results[i].Error = err.Error()
+			continue
+		}
+		parsed.index = i
+
+		if parsed.op == bulkOpCreate {
+			creates = append(creates, parsed)
+		} else {
+			changes = append(changes, parsed)
+		}
+	}
+
+	atomic := mode == BulkModeAllOrNothing
+	if atomic && len(creates)+len(changes) < len(req.Operations) {
+		return newDebtBulkResponse(mode, rollbackBulkResults(results)), nil
+	}
+
+	err := s.DB.WithTx(ctx, func(db repository.Database) error {
+		tx := &DebtService{DB: db, MQ: s.MQ}
+		failed := false
+
+		// fail registra a falha da operação; erros inesperados interrompem o lote
+		fail := func(i int, err error) error {
+			status := bulkErrorStatus(err)
+			if status == 0 {
+				return err
+			}
+			results[i].Status = status
+			results[i].Error = err.Error()
+			failed = true
+			return nil
+		}
+
+		// Valida as criações juntas, para que os estornos do lote somem no
+		// limite do débito original
+		candidates := make([]models.Debt, 0, len(creates))
+		for _, op := range creates {
+			candidates = append(candidates, op.input)
+		}
+		invalid, err := db.ValidateDebts(ctx, candidates)
+		if err != nil {
+			return err
+		}
+
+		valid := make([]bulkDebtOperation, 0, len(creates))
+		for j, op := range creates {
+			if invalid[j] != nil {
+				if err := fail(op.index, invalid[j]); err != nil {
+					return err
+				}
+				continue
+			}
+			valid = append(valid, op)
+		}
+
+		if len(valid) > 0 {
+			inputs := make([]models.Debt, 0, len(valid))
+			for _, op := range valid {
+				inputs = append(inputs, op.input)
+			}
+
+			created, err := db.InsertDebts(ctx, inputs)
+			if err != nil {
+				return err
+			}
+			for j, op := range valid {
+				results[op.index].Status = http.StatusCreated
+				results[op.index].Debt = &created[j]
+			}
+		}
+
+		for _, op := range changes {
+			if err := tx.applyBulkChange(ctx, op, &results[op.index]); err != nil {
+				if err := fail(op.index, err); err != nil {
+					return err
+				}
+			}
+		}
+
+		if atomic && failed {
+			return errBulkRollback
+		}
+		return nil
+	})
+	if errors.Is(err, errBulkRollback) {
+		return newDebtBulkResponse(mode, rollbackBulkResults(results)), nil
+	}
+	if err != nil {
+		return nil, err
+	}
+	return newDebtBulkResponse(mode, results), nil
+}
+
+func (s *DebtService) parseBulkOperation(ctx context.Context, op dto.DebtBulkOperation) (bulkDebtOperation, error) {
+	parsed := bulkDebtOperation{op: op.Op, version: op.Version}
+
+	switch op.Op {
+	case bulkOpCreate, bulkOpUpdate, bulkOpDelete:
+	default:
+		return parsed, errs.InvalidParam("op", errs.ErrBadRequest)
+	}
+
+	if op.Op != bulkOpCreate {
+		id, err := utils.ToUUIDPointer(op.ID)
+		if err != nil || id == nil {
+			return parsed, errs.InvalidParam("id", errs.ErrBadRequest)
+		}
+		parsed.id = *id
+	}
+	if op.Op == bulkOpDelete {
+		return parsed, nil
+	}
+
+	if op.Debt == nil {
+		return parsed, errs.InvalidParam("debt", errs.ErrBadRequest)
+	}
+	input, err := s.ParseDebt(ctx, *op.Debt)
+	if err != nil {
+		return parsed, err
+	}
+	input.ID = parsed.id
+	parsed.input = input
+	return parsed, nil
+}
+
+// applyBulkChange executa uma atualização ou remoção do lote
+func (s *DebtService) applyBulkChange(ctx context.Context, op bulkDebtOperation, result *dto.DebtBulkResult) error {
+	if _, err := s.GetDebtByID(ctx, op.id); err != nil {
+		return err
+	}
+	if op.version != nil {
+		ctx = etag.WithExpectedVersion(ctx, *op.version)
+	}
+
+	if op.op == bulkOpDelete {
+		if err := s.DeleteDebtByID(ctx, op.id); err != nil {
+			return err
+		}
+		result.Status = http.StatusNoContent
+		return nil
+	}
+
+	data, err := s.UpdateDebt(ctx, op.input)
+	if err != nil {
+		return err
+	}
+	result.Status = http.StatusOK
+	result.Debt = data
+	return nil
+}
+
+// bulkErrorStatus traduz os erros esperados de uma operação para o status
+// HTTP equivalente; 0 indica um erro inesperado
+func bulkErrorStatus(err error) int {
+	switch {
+	case errors.Is(err, errs.ErrBadRequest):
+		return http.StatusBadRequest
+	case errors.Is(err, errs.ErrNotFound):
+		return http.StatusNotFound
+	case errors.Is(err, errs.ErrConflict):
+		return http.StatusConflict
+	case errors.Is(err, errs.ErrPreconditionFailed):
+		return http.StatusPreconditionFailed
+	case errors.Is(err, errs.ErrUnprocessable):
+		return http.StatusUnprocessableEntity
+	}
+	return 0
+}
+
+// rollbackBulkResults marca como desfeitas as operações que não falharam
+func rollbackBulkResults(results []dto.DebtBulkResult) []dto.DebtBulkResult {
+	for i := range results {
+		if results[i].Status < http.StatusBadRequest {
+			results[i].Status = http.StatusFailedDependency
+			results[i].Error = "desfeita porque outra operação do lote falhou"
+			results[i].Debt = nil
+		}
+	}
+	return results
+}
+
+func newDebtBulkResponse(mode string, results []dto.DebtBulkResult) *dto.DebtBulkResponse {
+	response := &dto.DebtBulkResponse{Mode: mode, Results: results}
+	for _, result := range results {
+		if result.Status < http.StatusBadRequest {
+			response.Succeeded++
+		} else {
+			response.Failed++
+		}
+	}
+	return response
+}
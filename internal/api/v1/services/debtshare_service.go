@@ -0,0 +1,110 @@
+package services
+
+import (
+	"backend-go/internal/api/errs"
+	"backend-go/internal/api/v1/dto"
+	"backend-go/internal/api/v1/repository/models"
+	"backend-go/pkg/utils"
+	"context"
+	"fmt"
+	"math"
+
+	"github.com/google/uuid"
+)
+
+// ParseDebtShares valida as divisões de um débito de valor amount. Cada divisão
+// informa um valor ou um percentual, que é convertido em valor. A soma das
+// divisões deve ser igual ao valor do débito; a diferença de arredondamento
+// dos percentuais fica com a última divisão em percentual.
+func (s *DebtService) ParseDebtShares(amount float64, reqs []dto.DebtShareRequest) ([]models.DebtShare, error) {
+	shares := make([]models.DebtShare, 0, len(reqs))
+	seen := make(map[uuid.UUID]bool)
+	lastPercentage := -1
+	total := 0.0
+
+	for i, req := range reqs {
+		personID, err := utils.ToUUIDPointer(req.PersonID)
+		if err != nil || personID == nil {
+			return nil, errs.InvalidParam(fmt.Sprintf("shares[%d].person_id", i), errs.ErrBadRequest)
+		}
+		if seen[*personID] {
+			return nil, errs.InvalidParam(fmt.Sprintf("shares[%d].person_id", i), fmt.Errorf("%w: pessoa repetida", errs.ErrBadRequest))
+		}
+		seen[*personID] = true
+
+		share := models.DebtShare{PersonID: *personID, Settled: req.Settled}
+		switch {
+		case req.Amount != nil && req.Percentage != nil, req.Amount == nil && req.Percentage == nil:
+			return nil, errs.InvalidParam(fmt.Sprintf("shares[%d]", i), fmt.Errorf("%w: informe amount ou percentage", errs.ErrBadRequest))
+		case req.Amount != nil:
+			if *req.Amount <= 0 {
+				return nil, errs.InvalidParam(fmt.Sprintf("shares[%d].amount", i), errs.ErrBadRequest)
+			}
+			share.Amount = utils.RoundCents(*req.Amount)
+		default:
+			if *req.Percentage <= 0 || *req.Percentage > 100 {
+				return nil, errs.InvalidParam(fmt.Sprintf("shares[%d].percentage", i), errs.ErrBadRequest)
+			}
+			percentage := *req.Percentage
+			share.Percentage = &percentage
+			share.Amount = utils.RoundCents(amount * percentage / 100)
+			lastPercentage = i
+		}
+
+		total += share.Amount
+		shares = append(shares, share)
+	}
+
+	if len(shares) == 0 {
+		return shares, nil
+	}
+
+	diff := utils.RoundCents(amount - total)
+	// Arredondamentos de até um centavo por divisão em percentual são absorvidos
+	if diff != 0 && lastPercentage >= 0 && math.Abs(diff) <= 0.01*float64(len(shares)) {
+		shares[lastPercentage].Amount = utils.RoundCents(shares[lastPercentage].Amount + diff)
+		diff = 0
+	}
+	if diff != 0 {
+		return nil, errs.InvalidParam("shares", fmt.Errorf("%w: a soma das divisões (%.2f) é diferente do valor do débito (%.2f)", errs.ErrUnprocessable, utils.RoundCents(total), amount))
+	}
+	return shares, nil
+}
+
+func (s *DebtService) ListDebtShares(ctx context.Context, debtID uuid.UUID) ([]dto.DebtShareResponse, error) {
+	return s.DB.ListDebtShares(ctx, debtID)
+}
+
+// ReplaceDebtShares valida as divisões contra o valor atual do débito e
+// substitui as existentes
+func (s *DebtService) ReplaceDebtShares(ctx context.Context, debtID uuid.UUID, reqs []dto.DebtShareRequest) ([]dto.DebtShareResponse, error) {
+	debt, err := s.DB.GetDebtByID(ctx, debtID)
+	if err != nil {
+		return nil, err
+	}
+
+	shares, err := s.ParseDebtShares(debt.Amount, reqs)
+	if err != nil {
+		return nil, err
+	}
+
+	return s.DB.ReplaceDebtShares(ctx, debtID, shares)
+}
+
+// checkDebtShares impede que o valor de um débito dividido fique diferente da
+// soma das suas divisões
+func (s *DebtService) checkDebtShares(ctx context.Context, debtID uuid.UUID, amount float64) error {
+	shares, err := s.DB.ListDebtShares(ctx, debtID)
+	if err != nil || len(shares) == 0 {
+		return err
+	}
+
+	total := 0.0
+	for _, share := range shares {
+		total += share.Amount
+	}
+	if utils.RoundCents(total) != utils.RoundCents(amount) {
+		return errs.InvalidParam("amount", fmt.Errorf("%w: o débito está dividido em %.2f; atualize as divisões antes de alterar o valor", errs.ErrUnprocessable, utils.RoundCents(total)))
+	}
+	return nil
+}
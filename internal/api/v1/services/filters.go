@@ -0,0 +1,79 @@
+package services
+
+import (
+	"backend-go/internal/api/v1/dto"
+	"backend-go/pkg/filter"
+	"backend-go/pkg/utils"
+)
+
+// debtFilterConditions converte os parâmetros antigos da listagem de débitos
+// (category_id, min_amount, start_date...) em filtros
+func debtFilterConditions(flt dto.DebtFilters) filter.Filters {
+	var conds filter.Filters
+	conds = appendUUIDIn(conds, "category_id", flt.CategoryID)
+	conds = appendUUIDIn(conds, "status_id", flt.StatusID)
+	conds = appendUUIDIn(conds, "invoice_id", flt.InvoiceID)
+	conds = appendAmountRange(conds, flt.MinAmount, flt.MaxAmount)
+	conds = appendDateRange(conds, "purchase_date", flt.StartDate, flt.EndDate)
+	conds = appendStringIn(conds, "kind", flt.Kind)
+	conds = appendStringIn(conds, "tag", flt.Tag)
+	return conds
+}
+
+// invoiceFilterConditions converte os parâmetros antigos da listagem de
+// faturas em filtros
+func invoiceFilterConditions(flt dto.InvoiceFilters) filter.Filters {
+	var conds filter.Filters
+	conds = appendUUIDIn(conds, "status_id", flt.StatusID)
+	conds = appendAmountRange(conds, flt.MinAmount, flt.MaxAmount)
+	conds = appendDateRange(conds, "issue_date", flt.StartDate, flt.EndDate)
+	return conds
+}
+
+// appendUUIDIn ignora os IDs inválidos, como os parâmetros antigos faziam
+func appendUUIDIn(conds filter.Filters, field string, values *[]string) filter.Filters {
+	if values == nil {
+		return conds
+	}
+
+	var ids []any
+	for _, id := range utils.ToUUIDSlice(*values) {
+		ids = append(ids, id)
+	}
+	if len(ids) == 0 {
+		return conds
+	}
+	return append(conds, filter.Condition{Field: field, Op: filter.OpIn, Value: ids})
+}
+
+func appendStringIn(conds filter.Filters, field string, values *[]string) filter.Filters {
+	if values == nil || len(*values) == 0 {
+		return conds
+	}
+
+	list := make([]any, 0, len(*values))
+	for _, value := range *values {
+		list = append(list, value)
+	}
+	return append(conds, filter.Condition{Field: field, Op: filter.OpIn, Value: list})
+}
+
+func appendAmountRange(conds filter.Filters, min, max *float64) filter.Filters {
+	if min != nil {
+		conds = append(conds, filter.Condition{Field: "amount", Op: filter.OpGTE, Value: *min})
+	}
+	if max != nil {
+		conds = append(conds, filter.Condition{Field: "amount", Op: filter.OpLTE, Value: *max})
+	}
+	return conds
+}
+
+func appendDateRange(conds filter.Filters, field string, start, end *string) filter.Filters {
+	if t := utils.ToTimePointer(start); t != nil {
+		conds = append(conds, filter.Condition{Field: field, Op: filter.OpGTE, Value: *t})
+	}
+	if t := utils.ToTimePointer(end); t != nil {
+		conds = append(conds, filter.Condition{Field: field, Op: filter.OpLTE, Value: *t})
+	}
+	return conds
+}
@@ -0,0 +1,89 @@
+package services
+
+import (
+	"backend-go/internal/api/v1/dto"
+	queue "backend-go/internal/api/v1/queue/interfaces"
+	repository "backend-go/internal/api/v1/repository/interfaces"
+	"context"
+	"errors"
+	"sync"
+	"sync/atomic"
+	"time"
+)
+
+// Tempo máximo de cada verificação da prontidão
+const DefaultHealthTimeout = 2 * time.Second
+
+const (
+	HealthUp   = "up"
+	HealthDown = "down"
+)
+
+var errShuttingDown = errors.New("servidor em encerramento")
+
+type HealthService struct {
+	DB      repository.Database
+	MQ      queue.MessageQueue
+	Timeout time.Duration
+
+	shuttingDown atomic.Bool
+}
+
+func NewHealthService(db repository.Database, mq queue.MessageQueue) *HealthService {
+	return &HealthService{DB: db, MQ: mq, Timeout: DefaultHealthTimeout}
+}
+
+// SetShuttingDown faz a prontidão falhar a partir de agora, para que o
+// balanceador pare de enviar requisições durante o encerramento
+func (s *HealthService) SetShuttingDown() {
+	s.shuttingDown.Store(true)
+}
+
+// Readiness verifica o banco e a fila em paralelo, cada um com o seu prazo
+func (s *HealthService) Readiness(ctx context.Context) dto.HealthResponse {
+	checks := map[string]func(context.Context) error{
+		"database": s.DB.Ping,
+		"queue":    s.MQ.Ping,
+	}
+
+	var (
+		mu sync.Mutex
+		wg sync.WaitGroup
+	)
+	resp := dto.HealthResponse{Status: HealthUp, Components: make(map[string]dto.ComponentHealth, len(checks))}
+	for name, check := range checks {
+		wg.Add(1)
+		go func(name string, check func(context.Context) error) {
+			defer wg.Done()
+			component := s.check(ctx, check)
+
+			mu.Lock()
+			defer mu.Unlock()
+			resp.Components[name] = component
+			if component.Status != HealthUp {
+				resp.Status = HealthDown
+			}
+		}(name, check)
+	}
+	wg.Wait()
+
+	if s.shuttingDown.Load() {
+		resp.Status = HealthDown
+		resp.Components["server"] = dto.ComponentHealth{Status: HealthDown, Error: errShuttingDown.Error()}
+	}
+	return resp
+}
+
+func (s *HealthService) check(ctx context.Context, check func(context.Context) error) dto.ComponentHealth {
+	ctx, cancel := context.WithTimeout(ctx, s.Timeout)
+	defer cancel()
+
+	start := time.Now()
+	err := check(ctx)
+	component := dto.ComponentHealth{Status: HealthUp, LatencyMs: time.Since(start).Milliseconds()}
+	if err != nil {
+		component.Status = HealthDown
+		component.Error = err.Error()
+	}
+	return component
+}
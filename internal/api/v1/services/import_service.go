@@ -0,0 +1,342 @@
+package services
+
+import (
+	"backend-go/internal/api/errs"
+	"backend-go/internal/api/v1/dto"
+	repository "backend-go/internal/api/v1/repository/interfaces"
+	"backend-go/internal/api/v1/repository/models"
+	"backend-go/pkg/auth"
+	"backend-go/pkg/utils"
+	"context"
+	"encoding/csv"
+	"errors"
+	"fmt"
+	"io"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// Tempo que uma pré-visualização fica disponível para ser confirmada
+const ImportPreviewTTL = 30 * time.Minute
+
+// Limites da importação. As pré-visualizações ficam em memória, então a
+// quantidade guardada também é limitada.
+const (
+	MaxImportFileSize        int64 = 5 << 20
+	MaxImportRows                  = 1000
+	MaxImportPreviews              = 200
+	MaxImportPreviewsPerUser       = 3
+)
+
+var importColumns = []string{"title", "amount", "purchase_date", "due_date"}
+
+type importPreview struct {
+	userID      uuid.UUID
+	workspaceID uuid.UUID
+	debts       []models.Debt
+	expiresAt   time.Time
+}
+
+// ImportService guarda as pré-visualizações na memória do processo: elas são
+// perdidas ao reiniciar a API e só podem ser confirmadas na mesma instância.
+// Com mais de uma réplica, as requisições de importação precisam chegar
+// sempre à mesma instância (sticky session).
+type ImportService struct {
+	DB       repository.Database
+	Debts    *DebtService
+	mu       sync.Mutex
+	previews map[string]importPreview
+}
+
+func NewImportService(db repository.Database, debtService *DebtService) *ImportService {
+	return &ImportService{
+		DB:       db,
+		Debts:    debtService,
+		previews: make(map[string]importPreview),
+	}
+}
+
+// ReadCSV lê as linhas do extrato. Quando invoiceID é informado, ele é usado
+// nas linhas que não possuem a coluna invoice_id preenchida.
+func (s *ImportService) ReadCSV(file io.Reader, invoiceID string) ([]dto.DebtRequest, error) {
+	reader := csv.NewReader(file)
+	reader.FieldsPerRecord = -1
+	reader.TrimLeadingSpace = true
+
+	var rows [][]string
+	for {
+		row, err := reader.Read()
+		if err == io.EOF {
+			break
+		}
+		if err != nil {
+			return nil, errs.ParsingField("file", err)
+		}
+		// O cabeçalho não conta no limite
+		if len(rows) > MaxImportRows {
+			return nil, errs.InvalidParam("file", fmt.Errorf("%w: máximo de %d linhas", errs.ErrPayloadTooLarge, MaxImportRows))
+		}
+		rows = append(rows, row)
+	}
+
+	if len(rows) < 2 {
+		return nil, fmt.Errorf("arquivo CSV inválido: nenhuma linha encontrada")
+	}
+
+	columnIndex := make(map[string]int)
+	for i, header := range rows[0] {
+		columnIndex[strings.ToLower(strings.TrimSpace(header))] = i
+	}
+
+	for _, column := range importColumns {
+		if _, ok := columnIndex[column]; !ok {
+			return nil, fmt.Errorf("arquivo CSV inválido: coluna %s não encontrada", column)
+		}
+	}
+
+	value := func(row []string, column string) string {
+		i, ok := columnIndex[column]
+		if !ok || i >= len(row) {
+			return ""
+		}
+		return strings.TrimSpace(row[i])
+	}
+
+	reqs := make([]dto.DebtRequest, 0, len(rows)-1)
+	for _, row := range rows[1:] {
+		req := dto.DebtRequest{
+			InvoiceID:    value(row, "invoice_id"),
+			PurchaseDate: value(row, "purchase_date"),
+			DueDate:      value(row, "due_date"),
+			Title:        value(row, "title"),
+			Amount:       value(row, "amount"),
+			Kind:         value(row, "kind"),
+		}
+		if req.InvoiceID == "" {
+			req.InvoiceID = invoiceID
+		}
+		reqs = append(reqs, req)
+	}
+	return reqs, nil
+}
+
+// PreviewImport processa as linhas sem persistir nada e guarda o conjunto de
+// débitos válidos para ser confirmado posteriormente com o token retornado.
+func (s *ImportService) PreviewImport(ctx context.Context, reqs []dto.DebtRequest) (*dto.ImportPreviewResponse, error) {
+	userID, ok := auth.UserIDFromContext(ctx)
+	if !ok {
+		return nil, errs.ErrUnauthorized
+	}
+
+	workspace, ok := auth.WorkspaceFromContext(ctx)
+	if !ok {
+		return nil, errs.ErrUnauthorized
+	}
+
+	items := make([]dto.ImportPreviewItem, 0, len(reqs))
+	candidates := make([]models.Debt, 0, len(reqs))
+	// Índice em items de cada candidato
+	candidateItems := make([]int, 0, len(reqs))
+	invoiceTitles := make(map[uuid.UUID]*string)
+	seen := make(map[string]bool)
+
+	for i, req := range reqs {
+		item := dto.ImportPreviewItem{
+			Line:         i + 2,
+			Title:        req.Title,
+			PurchaseDate: req.PurchaseDate,
+			DueDate:      req.DueDate,
+			Warnings:     []string{},
+		}
+
+		input, err := s.Debts.ParseDebt(ctx, req)
+		if err != nil {
+			item.Amount, _ = strconv.ParseFloat(req.Amount, 64)
+			item.Skipped = true
+			item.Warnings = append(item.Warnings, err.Error())
+			items = append(items, item)
+			continue
+		}
+
+		item.Amount = input.Amount
+		item.CategoryID = input.CategoryID
+		item.Category = categorizeTransaction(input.Title)
+		item.InvoiceID = input.InvoiceID
+
+		if item.Category == nil {
+			item.Warnings = append(item.Warnings, "categoria não identificada")
+		}
+
+		if input.InvoiceID != nil {
+			title, ok := invoiceTitles[*input.InvoiceID]
+			if !ok {
+				invoice, err := s.DB.GetInvoiceByID(ctx, *input.InvoiceID)
+				if err != nil && !errors.Is(err, errs.ErrNotFound) {
+					return nil, errs.UnknownWithContext("buscar fatura", err)
+				}
+				if invoice != nil {
+					title = &invoice.Title
+				}
+				invoiceTitles[*input.InvoiceID] = title
+			}
+
+			if title == nil {
+				item.Skipped = true
+				item.Warnings = append(item.Warnings, errs.ResorceNotFound("invoice", input.InvoiceID.String()).Error())
+			}
+			item.InvoiceTitle = title
+		}
+
+		key := fmt.Sprintf("%s|%.2f|%s|%s", input.Title, input.Amount, input.PurchaseDate.Format("2006-01-02"), input.Kind)
+		if seen[key] {
+			item.Duplicate = true
+			item.Skipped = true
+			item.Warnings = append(item.Warnings, "débito duplicado no arquivo")
+		} else {
+			seen[key] = true
+
+			exists, err := s.DB.DebtExists(ctx, input)
+			if err != nil {
+				return nil, errs.UnknownWithContext("buscar débitos duplicados", err)
+			}
+			if exists {
+				item.Duplicate = true
+				item.Skipped = true
+				item.Warnings = append(item.Warnings, "débito já cadastrado")
+			}
+		}
+
+		if !item.Skipped {
+			candidates = append(candidates, input)
+			candidateItems = append(candidateItems, len(items))
+		}
+		items = append(items, item)
+	}
+
+	// Validados juntos, como no CommitImport, para que os estornos do arquivo
+	// somados não passem do valor do débito original
+	invalid, err := s.DB.ValidateDebts(ctx, candidates)
+	if err != nil {
+		return nil, errs.UnknownWithContext("validar débitos", err)
+	}
+
+	debts := make([]models.Debt, 0, len(candidates))
+	for i, input := range candidates {
+		if invalid[i] != nil {
+			item := &items[candidateItems[i]]
+			item.Skipped = true
+			item.Warnings = append(item.Warnings, invalid[i].Error())
+			continue
+		}
+		debts = append(debts, input)
+	}
+
+	token := uuid.NewString()
+	expiresAt := time.Now().Add(ImportPreviewTTL)
+
+	s.mu.Lock()
+	err = s.storePreview(token, importPreview{
+		userID:      userID,
+		workspaceID: workspace.ID,
+		debts:       debts,
+		expiresAt:   expiresAt,
+	})
+	s.mu.Unlock()
+	if err != nil {
+		return nil, err
+	}
+
+	return &dto.ImportPreviewResponse{
+		PreviewToken: token,
+		ExpiresAt:    *utils.ToFormatDateTimePointer(expiresAt),
+		Total:        len(items),
+		ToCreate:     len(debts),
+		Skipped:      len(items) - len(debts),
+		Items:        items,
+	}, nil
+}
+
+// CommitImport cria exatamente os débitos aprovados na pré-visualização.
+// O token só pode ser usado uma vez. Os débitos são validados de novo ao
+// salvar, pois os dados podem ter mudado desde a pré-visualização.
+func (s *ImportService) CommitImport(ctx context.Context, token string) (*dto.ImportResponse, error) {
+	userID, ok := auth.UserIDFromContext(ctx)
+	if !ok {
+		return nil, errs.ErrUnauthorized
+	}
+
+	workspace, ok := auth.WorkspaceFromContext(ctx)
+	if !ok {
+		return nil, errs.ErrUnauthorized
+	}
+
+	s.mu.Lock()
+	s.removeExpired()
+	preview, ok := s.previews[token]
+	// O token de outro usuário ou workspace é tratado como inexistente
+	ok = ok && preview.userID == userID && preview.workspaceID == workspace.ID
+	if ok {
+		delete(s.previews, token)
+	}
+	s.mu.Unlock()
+
+	if !ok {
+		return nil, errs.InvalidParam("preview_token", errs.ErrNotFound)
+	}
+
+	if len(preview.debts) == 0 {
+		return &dto.ImportResponse{Created: 0, Debts: []dto.DebtResponse{}}, nil
+	}
+
+	created, err := s.DB.InsertDebts(ctx, preview.debts)
+	if err != nil {
+		// Nada foi salvo, então o token volta a ficar disponível
+		s.mu.Lock()
+		s.previews[token] = preview
+		s.mu.Unlock()
+		return nil, err
+	}
+
+	return &dto.ImportResponse{Created: len(created), Debts: created}, nil
+}
+
+// storePreview guarda a pré-visualização. Cada usuário mantém apenas as
+// MaxImportPreviewsPerUser mais recentes; acima do limite geral, novas
+// pré-visualizações são recusadas até que as antigas expirem.
+func (s *ImportService) storePreview(token string, preview importPreview) error {
+	s.removeExpired()
+
+	var oldest string
+	count := 0
+	for t, p := range s.previews {
+		if p.userID != preview.userID {
+			continue
+		}
+		count++
+		if oldest == "" || p.expiresAt.Before(s.previews[oldest].expiresAt) {
+			oldest = t
+		}
+	}
+	if count >= MaxImportPreviewsPerUser {
+		delete(s.previews, oldest)
+	}
+
+	if len(s.previews) >= MaxImportPreviews {
+		return fmt.Errorf("%w: muitas importações em andamento", errs.ErrTooManyRequests)
+	}
+	s.previews[token] = preview
+	return nil
+}
+
+func (s *ImportService) removeExpired() {
+	now := time.Now()
+	for token, preview := range s.previews {
+		if now.After(preview.expiresAt) {
+			delete(s.previews, token)
+		}
+	}
+}
@@ -5,7 +5,9 @@ import (
 	"backend-go/internal/api/v1/dto"
 	repository "backend-go/internal/api/v1/repository/interfaces"
 	"backend-go/internal/api/v1/repository/models"
+	"backend-go/pkg/filter"
 	"backend-go/pkg/pagination"
+	"backend-go/pkg/utils"
 	"context"
 	"strconv"
 	"time"
@@ -37,15 +39,33 @@ func (s *InvoiceService) ParseInvoice(req dto.InvoiceRequest) (models.Invoice, e
 		return models.Invoice{}, errs.ParsingField("amount", err)
 	}
 
+	statusID, err := utils.ToUUIDPointer(req.StatusID)
+	if err != nil {
+		return models.Invoice{}, errs.ParsingField("status_id", err)
+	}
+
 	return models.Invoice{
 		Title:     req.Title,
 		Amount:    amount,
 		IssueDate: issueDate,
 		DueDate:   dueDate,
+		StatusID:  statusID,
 	}, nil
 
 }
 
+// ApplyInvoicePatch monta a requisição de atualização a partir da fatura
+// atual com as alterações do patch
+func (s *InvoiceService) ApplyInvoicePatch(current dto.InvoiceResponse, patch []byte) (dto.InvoiceRequest, error) {
+	return applyMergePatch(dto.InvoiceRequest{
+		Title:     current.Title,
+		Amount:    formatAmount(current.Amount),
+		IssueDate: current.IssueDate,
+		DueDate:   stringValue(current.DueDate),
+		StatusID:  uuidString(current.StatusID),
+	}, patch)
+}
+
 func (s *InvoiceService) CreateInvoice(ctx context.Context, input models.Invoice) (*dto.InvoiceResponse, error) {
 	return s.DB.InsertInvoice(ctx, input)
 }
@@ -54,15 +74,21 @@ func (s *InvoiceService) UpdateInvoice(ctx context.Context, input models.Invoice
 	return s.DB.UpdateInvoice(ctx, input)
 }
 
-func (s *InvoiceService) ListInvoices(ctx context.Context, flt dto.InvoiceFilters, pgn *pagination.Pagination) ([]dto.InvoiceResponse, int, error) {
-	invoices, err := s.DB.ListInvoices(ctx, flt, pgn)
+// ListInvoices lista as faturas. conds traz os filtros filter[campo][operador] e
+// flt os parâmetros antigos, convertidos também em filtros.
+func (s *InvoiceService) ListInvoices(ctx context.Context, flt dto.InvoiceFilters, conds filter.Filters, pgn *pagination.Pagination) ([]dto.InvoiceResponse, int, error) {
+	conds = append(invoiceFilterConditions(flt), conds...)
+
+	invoices, err := s.DB.ListInvoices(ctx, conds, pgn)
 	if err != nil {
 		return nil, 0, err
 	}
 
-	total, err := s.DB.CountInvoices(ctx, flt, pgn)
-	if err != nil {
-		return nil, 0, err
+	total := -1
+	if pgn.WithTotal {
+		if total, err = s.DB.CountInvoices(ctx, conds, pgn); err != nil {
+			return nil, 0, err
+		}
 	}
 
 	return invoices, total, nil
@@ -75,3 +101,23 @@ func (s *InvoiceService) GetInvoiceByID(ctx context.Context, id uuid.UUID) (*dto
 func (s *InvoiceService) DeleteInvoiceByID(ctx context.Context, id uuid.UUID) error {
 	return s.DB.DeleteInvoiceByID(ctx, id)
 }
+
+func (s *InvoiceService) ListDeletedInvoices(ctx context.Context, pgn *pagination.Pagination) ([]dto.InvoiceResponse, int, error) {
+	data, err := s.DB.ListDeletedInvoices(ctx, pgn)
+	if err != nil {
+		return nil, 0, err
+	}
+
+	total := -1
+	if pgn.WithTotal {
+		if total, err = s.DB.CountDeletedInvoices(ctx); err != nil {
+			return nil, 0, err
+		}
+	}
+
+	return data, total, nil
+}
+
+func (s *InvoiceService) RestoreInvoiceByID(ctx context.Context, id uuid.UUID) (*dto.InvoiceResponse, error) {
+	return s.DB.RestoreInvoiceByID(ctx, id)
+}
@@ -0,0 +1,27 @@
+package services
+
+import (
+	repository "backend-go/internal/api/v1/repository/interfaces"
+	"context"
+	"time"
+)
+
+// MetricsService calcula os indicadores de negócio expostos no /metrics,
+// somando todos os workspaces
+type MetricsService struct {
+	DB repository.Database
+}
+
+func NewMetricsService(db repository.Database) *MetricsService {
+	return &MetricsService{DB: db}
+}
+
+// UnpaidDebts conta os débitos que ainda não foram pagos
+func (s *MetricsService) UnpaidDebts(ctx context.Context) (int, error) {
+	return s.DB.CountUnpaidDebts(ctx)
+}
+
+// OverdueInvoices conta as faturas vencidas e não pagas
+func (s *MetricsService) OverdueInvoices(ctx context.Context) (int, error) {
+	return s.DB.CountOverdueInvoices(ctx, time.Now())
+}
@@ -0,0 +1,45 @@
+package services
+
+import (
+	"backend-go/internal/api/errs"
+	"backend-go/pkg/mergepatch"
+	"strconv"
+
+	"github.com/google/uuid"
+)
+
+// applyMergePatch aplica o JSON Merge Patch sobre a requisição montada a
+// partir do estado atual do recurso
+func applyMergePatch[T any](current T, patch []byte) (T, error) {
+	result, err := mergepatch.Apply(current, patch)
+	if err != nil {
+		return result, errs.ParsingField("patch", err)
+	}
+	return result, nil
+}
+
+func uuidString(id *uuid.UUID) string {
+	if id == nil {
+		return ""
+	}
+	return id.String()
+}
+
+func stringValue(s *string) string {
+	if s == nil {
+		return ""
+	}
+	return *s
+}
+
+// dateOnly recorta a data de um valor no formato "2006-01-02 15:04:05"
+func dateOnly(s string) string {
+	if len(s) > len("2006-01-02") {
+		return s[:len("2006-01-02")]
+	}
+	return s
+}
+
+func formatAmount(amount float64) string {
+	return strconv.FormatFloat(amount, 'f', -1, 64)
+}
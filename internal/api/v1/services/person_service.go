@@ -0,0 +1,99 @@
+package services
+
+import (
+	"backend-go/internal/api/errs"
+	"backend-go/internal/api/v1/dto"
+	repository "backend-go/internal/api/v1/repository/interfaces"
+	"backend-go/internal/api/v1/repository/models"
+	"backend-go/pkg/pagination"
+	"context"
+	"strings"
+
+	"github.com/google/uuid"
+)
+
+type PersonService struct {
+	DB repository.Database
+}
+
+func NewPersonService(db repository.Database) *PersonService {
+	return &PersonService{DB: db}
+}
+
+func (s *PersonService) ParsePerson(req dto.PersonRequest) (models.Person, error) {
+	name := strings.TrimSpace(req.Name)
+	if name == "" {
+		return models.Person{}, errs.InvalidParam("name", errs.ErrBadRequest)
+	}
+
+	input := models.Person{Name: name}
+	if email := strings.TrimSpace(req.Email); email != "" {
+		input.Email = &email
+	}
+	return input, nil
+}
+
+// ApplyPersonPatch monta a requisição de atualização a partir da pessoa
+// atual com as alterações do patch
+func (s *PersonService) ApplyPersonPatch(current dto.PersonResponse, patch []byte) (dto.PersonRequest, error) {
+	return applyMergePatch(dto.PersonRequest{
+		Name:  current.Name,
+		Email: stringValue(current.Email),
+	}, patch)
+}
+
+func (s *PersonService) CreatePerson(ctx context.Context, input models.Person) (*dto.PersonResponse, error) {
+	return s.DB.InsertPerson(ctx, input)
+}
+
+func (s *PersonService) UpdatePerson(ctx context.Context, input models.Person) (*dto.PersonResponse, error) {
+	return s.DB.UpdatePerson(ctx, input)
+}
+
+func (s *PersonService) ListPeople(ctx context.Context, pgn *pagination.Pagination) ([]dto.PersonResponse, int, error) {
+	data, err := s.DB.ListPeople(ctx, pgn)
+	if err != nil {
+		return nil, 0, err
+	}
+
+	total := -1
+	if pgn.WithTotal {
+		if total, err = s.DB.CountPeople(ctx, pgn); err != nil {
+			return nil, 0, err
+		}
+	}
+
+	return data, total, nil
+}
+
+func (s *PersonService) GetPersonByID(ctx context.Context, id uuid.UUID) (*dto.PersonResponse, error) {
+	return s.DB.GetPersonByID(ctx, id)
+}
+
+func (s *PersonService) GetPersonBalance(ctx context.Context, id uuid.UUID) (*dto.PersonBalanceResponse, error) {
+	return s.DB.GetPersonBalance(ctx, id)
+}
+
+func (s *PersonService) DeletePersonByID(ctx context.Context, id uuid.UUID) error {
+	return s.DB.DeletePersonByID(ctx, id)
+}
+
+func (s *PersonService) ListDeletedPeople(ctx context.Context, pgn *pagination.Pagination) ([]dto.PersonResponse, int, error) {
+	data, err := s.DB.ListDeletedPeople(ctx, pgn)
+	if err != nil {
+		return nil, 0, err
+	}
+
+	total := -1
+	if pgn.WithTotal {
+		if total, err = s.DB.CountDeletedPeople(ctx); err != nil {
+			return nil, 0, err
+		}
+	}
+
+	return data, total, nil
+}
+
+func (s *PersonService) RestorePersonByID(ctx context.Context, id uuid.UUID) (*dto.PersonResponse, error) {
+	return s.DB.RestorePersonByID(ctx, id)
+}
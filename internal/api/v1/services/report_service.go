@@ -0,0 +1,40 @@
+package services
+
+import (
+	"backend-go/internal/api/errs"
+	"backend-go/internal/api/v1/dto"
+	repository "backend-go/internal/api/v1/repository/interfaces"
+	"context"
+	"time"
+)
+
+type ReportService struct {
+	DB repository.Database
+}
+
+func NewReportService(db repository.Database) *ReportService {
+	return &ReportService{DB: db}
+}
+
+// ValidateFilters confere o formato das datas do período
+func (s *ReportService) ValidateFilters(flt dto.ReportFilters) error {
+	if flt.StartDate != nil && *flt.StartDate != "" {
+		if _, err := time.Parse("2006-01-02", *flt.StartDate); err != nil {
+			return errs.DateParsing("start_date")
+		}
+	}
+	if flt.EndDate != nil && *flt.EndDate != "" {
+		if _, err := time.Parse("2006-01-02", *flt.EndDate); err != nil {
+			return errs.DateParsing("end_date")
+		}
+	}
+	return nil
+}
+
+func (s *ReportService) ReportByCategory(ctx context.Context, flt dto.ReportFilters) ([]dto.ReportItem, error) {
+	return s.DB.ReportByCategory(ctx, flt)
+}
+
+func (s *ReportService) ReportByTag(ctx context.Context, flt dto.ReportFilters) ([]dto.ReportItem, error) {
+	return s.DB.ReportByTag(ctx, flt)
+}
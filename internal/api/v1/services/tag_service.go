@@ -0,0 +1,107 @@
+package services
+
+import (
+	"backend-go/internal/api/errs"
+	"backend-go/internal/api/v1/dto"
+	repository "backend-go/internal/api/v1/repository/interfaces"
+	"backend-go/internal/api/v1/repository/models"
+	"backend-go/pkg/pagination"
+	"context"
+	"fmt"
+	"strings"
+	"unicode/utf8"
+
+	"github.com/google/uuid"
+)
+
+// Tamanho máximo do nome de uma tag
+const MaxTagLength = 64
+
+type TagService struct {
+	DB repository.Database
+}
+
+func NewTagService(db repository.Database) *TagService {
+	return &TagService{DB: db}
+}
+
+func (s *TagService) ParseTag(req dto.TagRequest) (models.Tag, error) {
+	name, err := normalizeTagName(req.Name)
+	if err != nil {
+		return models.Tag{}, errs.InvalidParam("name", err)
+	}
+	return models.Tag{Name: name}, nil
+}
+
+// ApplyTagPatch monta a requisição de atualização a partir da tag atual com
+// as alterações do patch
+func (s *TagService) ApplyTagPatch(current dto.TagResponse, patch []byte) (dto.TagRequest, error) {
+	return applyMergePatch(dto.TagRequest{Name: current.Name}, patch)
+}
+
+func (s *TagService) CreateTag(ctx context.Context, input models.Tag) (*dto.TagResponse, error) {
+	return s.DB.InsertTag(ctx, input)
+}
+
+func (s *TagService) UpdateTag(ctx context.Context, input models.Tag) (*dto.TagResponse, error) {
+	return s.DB.UpdateTag(ctx, input)
+}
+
+func (s *TagService) ListTags(ctx context.Context, pgn *pagination.Pagination) ([]dto.TagResponse, int, error) {
+	data, err := s.DB.ListTags(ctx, pgn)
+	if err != nil {
+		return nil, 0, err
+	}
+
+	total := -1
+	if pgn.WithTotal {
+		if total, err = s.DB.CountTags(ctx, pgn); err != nil {
+			return nil, 0, err
+		}
+	}
+
+	return data, total, nil
+}
+
+func (s *TagService) GetTagByID(ctx context.Context, id uuid.UUID) (*dto.TagResponse, error) {
+	return s.DB.GetTagByID(ctx, id)
+}
+
+func (s *TagService) DeleteTagByID(ctx context.Context, id uuid.UUID) error {
+	return s.DB.DeleteTagByID(ctx, id)
+}
+
+// normalizeTagName remove espaços e deixa o nome em minúsculas, para que
+// "Trip-2025" e "trip-2025" sejam a mesma tag
+func normalizeTagName(name string) (string, error) {
+	name = strings.ToLower(strings.TrimSpace(name))
+	if name == "" {
+		return "", errs.ErrBadRequest
+	}
+	if utf8.RuneCountInString(name) > MaxTagLength {
+		return "", fmt.Errorf("%w: use no máximo %d caracteres", errs.ErrBadRequest, MaxTagLength)
+	}
+	return name, nil
+}
+
+// parseTagNames normaliza e remove nomes repetidos. Uma lista nil continua nil,
+// indicando que as tags não foram informadas.
+func parseTagNames(names []string) ([]string, error) {
+	if names == nil {
+		return nil, nil
+	}
+
+	tags := make([]string, 0, len(names))
+	seen := make(map[string]bool)
+	for _, name := range names {
+		tag, err := normalizeTagName(name)
+		if err != nil {
+			return nil, errs.InvalidParam("tags", err)
+		}
+		if !seen[tag] {
+			seen[tag] = true
+			tags = append(tags, tag)
+		}
+	}
+	return tags, nil
+}
@@ -0,0 +1,44 @@
+package services
+
+import (
+	repository "backend-go/internal/api/v1/repository/interfaces"
+	"backend-go/internal/api/v1/repository/models"
+	storage "backend-go/internal/api/v1/storage/interfaces"
+	"context"
+	"fmt"
+	"log/slog"
+	"time"
+)
+
+// Tempo padrão que os registros ficam na lixeira antes do purge
+const DefaultTrashRetention = 30 * 24 * time.Hour
+
+type TrashService struct {
+	DB      repository.Database
+	Storage storage.FileStorage
+}
+
+func NewTrashService(db repository.Database, fs storage.FileStorage) *TrashService {
+	return &TrashService{DB: db, Storage: fs}
+}
+
+// Purge apaga definitivamente o que está na lixeira há mais que retention,
+// junto com os arquivos dos anexos. Falhas ao remover um arquivo apenas são
+// registradas, já que as linhas do banco não existem mais.
+func (s *TrashService) Purge(ctx context.Context, retention time.Duration) (*models.PurgeResult, error) {
+	if retention < 0 {
+		return nil, fmt.Errorf("retenção inválida: %s", retention)
+	}
+
+	result, err := s.DB.PurgeDeleted(ctx, time.Now().Add(-retention))
+	if err != nil {
+		return nil, err
+	}
+
+	for _, key := range result.StorageKeys {
+		if err := s.Storage.Delete(ctx, key); err != nil {
+			slog.WarnContext(ctx, "falha ao remover arquivo do storage", "key", key, "error", err)
+		}
+	}
+	return result, nil
+}
@@ -0,0 +1,144 @@
+package services
+
+import (
+	"backend-go/internal/api/config"
+	"backend-go/internal/api/errs"
+	"backend-go/internal/api/v1/dto"
+	repository "backend-go/internal/api/v1/repository/interfaces"
+	"backend-go/internal/api/v1/repository/models"
+	"backend-go/pkg/auth"
+	"context"
+	"errors"
+	"fmt"
+	"strings"
+
+	"github.com/google/uuid"
+)
+
+type WorkspaceService struct {
+	DB repository.Database
+}
+
+func NewWorkspaceService(db repository.Database) *WorkspaceService {
+	return &WorkspaceService{DB: db}
+}
+
+// ResolveMember retorna a participação do usuário no workspace informado ou,
+// quando nenhum é informado, no seu workspace pessoal
+func (s *WorkspaceService) ResolveMember(ctx context.Context, userID uuid.UUID, workspaceID *uuid.UUID) (*models.WorkspaceMember, error) {
+	if workspaceID == nil {
+		return s.DB.GetDefaultWorkspaceMember(ctx, userID)
+	}
+
+	member, err := s.DB.GetWorkspaceMember(ctx, *workspaceID, userID)
+	if errors.Is(err, errs.ErrNotFound) {
+		// Não revela a existência de workspaces de outras pessoas
+		return nil, errs.ErrForbidden
+	}
+	return member, err
+}
+
+func (s *WorkspaceService) ParseWorkspace(req dto.WorkspaceRequest) (models.Workspace, error) {
+	name := strings.TrimSpace(req.Name)
+	if name == "" {
+		return models.Workspace{}, errs.InvalidParam("name", errs.ErrBadRequest)
+	}
+	return models.Workspace{Name: name}, nil
+}
+
+func (s *WorkspaceService) ListWorkspaces(ctx context.Context) ([]dto.WorkspaceResponse, error) {
+	return s.DB.ListWorkspaces(ctx)
+}
+
+func (s *WorkspaceService) CreateWorkspace(ctx context.Context, input models.Workspace) (*dto.WorkspaceResponse, error) {
+	return s.DB.InsertWorkspace(ctx, input, config.DefaultCategories)
+}
+
+func (s *WorkspaceService) ListMembers(ctx context.Context, workspaceID uuid.UUID) ([]dto.WorkspaceMemberResponse, error) {
+	if _, err := s.requireRole(ctx, workspaceID); err != nil {
+		return nil, err
+	}
+	return s.DB.ListWorkspaceMembers(ctx, workspaceID)
+}
+
+// AddMember convida um usuário já cadastrado para o workspace. Apenas donos podem convidar.
+func (s *WorkspaceService) AddMember(ctx context.Context, workspaceID uuid.UUID, req dto.WorkspaceMemberRequest) (*dto.WorkspaceMemberResponse, error) {
+	if _, err := s.requireRole(ctx, workspaceID, auth.RoleOwner); err != nil {
+		return nil, err
+	}
+
+	if !auth.ValidRole(req.Role) {
+		return nil, errs.InvalidParam("role", fmt.Errorf("use %s, %s ou %s", auth.RoleOwner, auth.RoleEditor, auth.RoleViewer))
+	}
+
+	user, err := s.DB.GetUserByEmail(ctx, normalizeEmail(req.Email))
+	if err != nil {
+		if errors.Is(err, errs.ErrNotFound) {
+			return nil, errs.InvalidParam("email", errs.ErrNotFound)
+		}
+		return nil, err
+	}
+
+	return s.DB.InsertWorkspaceMember(ctx, models.WorkspaceMember{
+		WorkspaceID: workspaceID,
+		UserID:      user.ID,
+		Role:        req.Role,
+	})
+}
+
+// RemoveMember remove um membro do workspace. Donos removem qualquer membro e
+// os demais só podem sair do workspace; o último dono não pode ser removido.
+func (s *WorkspaceService) RemoveMember(ctx context.Context, workspaceID, userID uuid.UUID) error {
+	current, err := s.requireRole(ctx, workspaceID)
+	if err != nil {
+		return err
+	}
+
+	if current.Role != auth.RoleOwner && current.UserID != userID {
+		return errs.ErrForbidden
+	}
+
+	member, err := s.DB.GetWorkspaceMember(ctx, workspaceID, userID)
+	if err != nil {
+		return err
+	}
+
+	if member.Role == auth.RoleOwner {
+		owners, err := s.DB.CountWorkspaceOwners(ctx, workspaceID)
+		if err != nil {
+			return err
+		}
+		if owners <= 1 {
+			return errs.ErrConflict
+		}
+	}
+
+	return s.DB.DeleteWorkspaceMember(ctx, workspaceID, userID)
+}
+
+// requireRole garante que o usuário autenticado participa do workspace e,
+// se roles for informado, que possui um desses papéis
+func (s *WorkspaceService) requireRole(ctx context.Context, workspaceID uuid.UUID, roles ...string) (*models.WorkspaceMember, error) {
+	userID, ok := auth.UserIDFromContext(ctx)
+	if !ok {
+		return nil, errs.ErrUnauthorized
+	}
+
+	member, err := s.DB.GetWorkspaceMember(ctx, workspaceID, userID)
+	if err != nil {
+		if errors.Is(err, errs.ErrNotFound) {
+			return nil, errs.ErrForbidden
+		}
+		return nil, err
+	}
+
+	if len(roles) == 0 {
+		return member, nil
+	}
+	for _, role := range roles {
+		if member.Role == role {
+			return member, nil
+		}
+	}
+	return nil, errs.ErrForbidden
+}
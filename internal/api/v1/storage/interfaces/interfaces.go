@@ -0,0 +1,18 @@
+package interfaces
+
+import (
+	"context"
+	"errors"
+	"io"
+)
+
+// ErrObjectNotFound é retornado por Get quando a chave não existe no storage
+var ErrObjectNotFound = errors.New("arquivo não encontrado no storage")
+
+// FileStorage guarda o conteúdo dos anexos. As chaves são geradas pela API,
+// no formato <workspace_id>/<attachment_id>.
+type FileStorage interface {
+	Put(ctx context.Context, key string, body io.Reader, size int64, contentType string) error
+	Get(ctx context.Context, key string) (io.ReadCloser, error)
+	Delete(ctx context.Context, key string) error
+}
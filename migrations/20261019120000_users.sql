@@ -0,0 +1,16 @@
+-- Create "users" table
+CREATE TABLE "public"."users" ("id" uuid NOT NULL, "created_at" timestamptz NOT NULL, "updated_at" timestamptz NOT NULL, "name" character varying NOT NULL, "email" character varying NOT NULL, "password_hash" character varying NOT NULL, PRIMARY KEY ("id"));
+-- Create index "users_email_key" to table: "users"
+CREATE UNIQUE INDEX "users_email_key" ON "public"."users" ("email");
+-- Modify "categories" table
+ALTER TABLE "public"."categories" ADD COLUMN "user_id" uuid NULL, ADD CONSTRAINT "categories_users_user" FOREIGN KEY ("user_id") REFERENCES "public"."users" ("id") ON UPDATE NO ACTION ON DELETE SET NULL;
+-- Create index "category_user_id" to table: "categories"
+CREATE INDEX "category_user_id" ON "public"."categories" ("user_id");
+-- Modify "debts" table
+ALTER TABLE "public"."debts" ADD COLUMN "user_id" uuid NULL, ADD CONSTRAINT "debts_users_user" FOREIGN KEY ("user_id") REFERENCES "public"."users" ("id") ON UPDATE NO ACTION ON DELETE SET NULL;
+-- Create index "debt_user_id" to table: "debts"
+CREATE INDEX "debt_user_id" ON "public"."debts" ("user_id");
+-- Modify "invoices" table
+ALTER TABLE "public"."invoices" ADD COLUMN "user_id" uuid NULL, ADD CONSTRAINT "invoices_users_user" FOREIGN KEY ("user_id") REFERENCES "public"."users" ("id") ON UPDATE NO ACTION ON DELETE SET NULL;
+-- Create index "invoice_user_id" to table: "invoices"
+CREATE INDEX "invoice_user_id" ON "public"."invoices" ("user_id");
@@ -0,0 +1,32 @@
+-- Create "workspaces" table
+CREATE TABLE "public"."workspaces" ("id" uuid NOT NULL, "created_at" timestamptz NOT NULL, "updated_at" timestamptz NOT NULL, "name" character varying NOT NULL, PRIMARY KEY ("id"));
+-- Create "workspace_members" table
+CREATE TABLE "public"."workspace_members" ("id" uuid NOT NULL, "created_at" timestamptz NOT NULL, "updated_at" timestamptz NOT NULL, "role" character varying NOT NULL DEFAULT 'viewer', "workspace_id" uuid NOT NULL, "user_id" uuid NOT NULL, PRIMARY KEY ("id"), CONSTRAINT "workspace_members_users_user" FOREIGN KEY ("user_id") REFERENCES "public"."users" ("id") ON UPDATE NO ACTION ON DELETE NO ACTION, CONSTRAINT "workspace_members_workspaces_workspace" FOREIGN KEY ("workspace_id") REFERENCES "public"."workspaces" ("id") ON UPDATE NO ACTION ON DELETE NO ACTION);
+-- Create index "workspacemember_user_id" to table: "workspace_members"
+CREATE INDEX "workspacemember_user_id" ON "public"."workspace_members" ("user_id");
+-- Create index "workspacemember_workspace_id_user_id" to table: "workspace_members"
+CREATE UNIQUE INDEX "workspacemember_workspace_id_user_id" ON "public"."workspace_members" ("workspace_id", "user_id");
+-- Create a personal workspace for every existing user, reusing the user ID
+INSERT INTO "public"."workspaces" ("id", "created_at", "updated_at", "name") SELECT "id", now(), now(), "name" FROM "public"."users";
+INSERT INTO "public"."workspace_members" ("id", "created_at", "updated_at", "role", "workspace_id", "user_id") SELECT gen_random_uuid(), now(), now(), 'owner', "id", "id" FROM "public"."users";
+-- Modify "categories" table
+ALTER TABLE "public"."categories" ADD COLUMN "workspace_id" uuid NULL, ADD CONSTRAINT "categories_workspaces_workspace" FOREIGN KEY ("workspace_id") REFERENCES "public"."workspaces" ("id") ON UPDATE NO ACTION ON DELETE SET NULL;
+UPDATE "public"."categories" SET "workspace_id" = "user_id";
+DROP INDEX "public"."category_user_id";
+ALTER TABLE "public"."categories" DROP CONSTRAINT "categories_users_user", DROP COLUMN "user_id";
+-- Create index "category_workspace_id" to table: "categories"
+CREATE INDEX "category_workspace_id" ON "public"."categories" ("workspace_id");
+-- Modify "debts" table
+ALTER TABLE "public"."debts" ADD COLUMN "workspace_id" uuid NULL, ADD CONSTRAINT "debts_workspaces_workspace" FOREIGN KEY ("workspace_id") REFERENCES "public"."workspaces" ("id") ON UPDATE NO ACTION ON DELETE SET NULL;
+UPDATE "public"."debts" SET "workspace_id" = "user_id";
+DROP INDEX "public"."debt_user_id";
+ALTER TABLE "public"."debts" DROP CONSTRAINT "debts_users_user", DROP COLUMN "user_id";
+-- Create index "debt_workspace_id" to table: "debts"
+CREATE INDEX "debt_workspace_id" ON "public"."debts" ("workspace_id");
+-- Modify "invoices" table
+ALTER TABLE "public"."invoices" ADD COLUMN "workspace_id" uuid NULL, ADD CONSTRAINT "invoices_workspaces_workspace" FOREIGN KEY ("workspace_id") REFERENCES "public"."workspaces" ("id") ON UPDATE NO ACTION ON DELETE SET NULL;
+UPDATE "public"."invoices" SET "workspace_id" = "user_id";
+DROP INDEX "public"."invoice_user_id";
+ALTER TABLE "public"."invoices" DROP CONSTRAINT "invoices_users_user", DROP COLUMN "user_id";
+-- Create index "invoice_workspace_id" to table: "invoices"
+CREATE INDEX "invoice_workspace_id" ON "public"."invoices" ("workspace_id");
@@ -0,0 +1,10 @@
+-- Create "people" table
+CREATE TABLE "public"."people" ("id" uuid NOT NULL, "created_at" timestamptz NOT NULL, "updated_at" timestamptz NOT NULL, "name" character varying NOT NULL, "email" character varying NULL, "workspace_id" uuid NOT NULL, PRIMARY KEY ("id"), CONSTRAINT "people_workspaces_workspace" FOREIGN KEY ("workspace_id") REFERENCES "public"."workspaces" ("id") ON UPDATE NO ACTION ON DELETE NO ACTION);
+-- Create index "person_workspace_id" to table: "people"
+CREATE INDEX "person_workspace_id" ON "public"."people" ("workspace_id");
+-- Create "debt_shares" table
+CREATE TABLE "public"."debt_shares" ("id" uuid NOT NULL, "created_at" timestamptz NOT NULL, "updated_at" timestamptz NOT NULL, "amount" numeric(10,2) NOT NULL, "percentage" numeric(5,2) NULL, "settled" boolean NOT NULL DEFAULT false, "debt_id" uuid NOT NULL, "person_id" uuid NOT NULL, PRIMARY KEY ("id"), CONSTRAINT "debt_shares_debts_shares" FOREIGN KEY ("debt_id") REFERENCES "public"."debts" ("id") ON UPDATE NO ACTION ON DELETE CASCADE, CONSTRAINT "debt_shares_people_person" FOREIGN KEY ("person_id") REFERENCES "public"."people" ("id") ON UPDATE NO ACTION ON DELETE NO ACTION);
+-- Create index "debtshare_debt_id_person_id" to table: "debt_shares"
+CREATE UNIQUE INDEX "debtshare_debt_id_person_id" ON "public"."debt_shares" ("debt_id", "person_id");
+-- Create index "debtshare_person_id" to table: "debt_shares"
+CREATE INDEX "debtshare_person_id" ON "public"."debt_shares" ("person_id");
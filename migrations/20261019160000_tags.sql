@@ -0,0 +1,6 @@
+-- Create "tags" table
+CREATE TABLE "public"."tags" ("id" uuid NOT NULL, "created_at" timestamptz NOT NULL, "updated_at" timestamptz NOT NULL, "name" character varying NOT NULL, "workspace_id" uuid NOT NULL, PRIMARY KEY ("id"), CONSTRAINT "tags_workspaces_workspace" FOREIGN KEY ("workspace_id") REFERENCES "public"."workspaces" ("id") ON UPDATE NO ACTION ON DELETE NO ACTION);
+-- Create index "tag_workspace_id_name" to table: "tags"
+CREATE UNIQUE INDEX "tag_workspace_id_name" ON "public"."tags" ("workspace_id", "name");
+-- Create "debt_tags" table
+CREATE TABLE "public"."debt_tags" ("debt_id" uuid NOT NULL, "tag_id" uuid NOT NULL, PRIMARY KEY ("debt_id", "tag_id"), CONSTRAINT "debt_tags_debt_id" FOREIGN KEY ("debt_id") REFERENCES "public"."debts" ("id") ON UPDATE NO ACTION ON DELETE CASCADE, CONSTRAINT "debt_tags_tag_id" FOREIGN KEY ("tag_id") REFERENCES "public"."tags" ("id") ON UPDATE NO ACTION ON DELETE CASCADE);
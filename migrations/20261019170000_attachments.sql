@@ -0,0 +1,8 @@
+-- Create "attachments" table
+CREATE TABLE "public"."attachments" ("id" uuid NOT NULL, "created_at" timestamptz NOT NULL, "updated_at" timestamptz NOT NULL, "file_name" character varying NOT NULL, "content_type" character varying NOT NULL, "size" bigint NOT NULL, "storage_key" character varying NOT NULL, "workspace_id" uuid NOT NULL, "debt_id" uuid NULL, "invoice_id" uuid NULL, PRIMARY KEY ("id"), CONSTRAINT "attachments_debts_attachments" FOREIGN KEY ("debt_id") REFERENCES "public"."debts" ("id") ON UPDATE NO ACTION ON DELETE CASCADE, CONSTRAINT "attachments_invoices_attachments" FOREIGN KEY ("invoice_id") REFERENCES "public"."invoices" ("id") ON UPDATE NO ACTION ON DELETE CASCADE, CONSTRAINT "attachments_workspaces_workspace" FOREIGN KEY ("workspace_id") REFERENCES "public"."workspaces" ("id") ON UPDATE NO ACTION ON DELETE NO ACTION);
+-- Create index "attachment_debt_id" to table: "attachments"
+CREATE INDEX "attachment_debt_id" ON "public"."attachments" ("debt_id");
+-- Create index "attachment_invoice_id" to table: "attachments"
+CREATE INDEX "attachment_invoice_id" ON "public"."attachments" ("invoice_id");
+-- Create index "attachments_storage_key_key" to table: "attachments"
+CREATE UNIQUE INDEX "attachments_storage_key_key" ON "public"."attachments" ("storage_key");
@@ -0,0 +1,6 @@
+-- Modify "debts" table
+ALTER TABLE "public"."debts" ADD COLUMN "kind" character varying NOT NULL DEFAULT 'purchase', ADD COLUMN "refund_of_id" uuid NULL, ADD CONSTRAINT "debts_debts_refunds" FOREIGN KEY ("refund_of_id") REFERENCES "public"."debts" ("id") ON UPDATE NO ACTION ON DELETE SET NULL;
+-- Create index "debt_refund_of_id" to table: "debts"
+CREATE INDEX "debt_refund_of_id" ON "public"."debts" ("refund_of_id");
+-- Linhas negativas importadas antes passam a ser estornos com valor positivo
+UPDATE "public"."debts" SET "kind" = 'refund', "amount" = -"amount" WHERE "amount" < 0;
@@ -0,0 +1,8 @@
+-- Modify "categories" table
+ALTER TABLE "public"."categories" ADD COLUMN "deleted_at" timestamptz NULL;
+-- Modify "debts" table
+ALTER TABLE "public"."debts" ADD COLUMN "deleted_at" timestamptz NULL;
+-- Modify "invoices" table
+ALTER TABLE "public"."invoices" ADD COLUMN "deleted_at" timestamptz NULL;
+-- Modify "people" table
+ALTER TABLE "public"."people" ADD COLUMN "deleted_at" timestamptz NULL;
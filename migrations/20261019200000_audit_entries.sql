@@ -0,0 +1,6 @@
+-- Create "audit_entries" table
+CREATE TABLE "public"."audit_entries" ("id" uuid NOT NULL, "entity_type" character varying NOT NULL, "entity_id" uuid NOT NULL, "action" character varying NOT NULL, "actor_id" uuid NULL, "via_api_key" boolean NOT NULL DEFAULT false, "request_id" character varying NULL, "workspace_id" uuid NULL, "old_values" jsonb NULL, "new_values" jsonb NULL, "created_at" timestamptz NOT NULL, PRIMARY KEY ("id"));
+-- Create index "auditentry_entity_type_entity_id_created_at" to table: "audit_entries"
+CREATE INDEX "auditentry_entity_type_entity_id_created_at" ON "public"."audit_entries" ("entity_type", "entity_id", "created_at");
+-- Create index "auditentry_workspace_id" to table: "audit_entries"
+CREATE INDEX "auditentry_workspace_id" ON "public"."audit_entries" ("workspace_id");
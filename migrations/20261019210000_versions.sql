@@ -0,0 +1,12 @@
+-- Modify "categories" table
+ALTER TABLE "public"."categories" ADD COLUMN "version" bigint NOT NULL DEFAULT 1;
+-- Modify "debts" table
+ALTER TABLE "public"."debts" ADD COLUMN "version" bigint NOT NULL DEFAULT 1;
+-- Modify "invoices" table
+ALTER TABLE "public"."invoices" ADD COLUMN "version" bigint NOT NULL DEFAULT 1;
+-- Modify "payment_status" table
+ALTER TABLE "public"."payment_status" ADD COLUMN "version" bigint NOT NULL DEFAULT 1;
+-- Modify "people" table
+ALTER TABLE "public"."people" ADD COLUMN "version" bigint NOT NULL DEFAULT 1;
+-- Modify "tags" table
+ALTER TABLE "public"."tags" ADD COLUMN "version" bigint NOT NULL DEFAULT 1;
@@ -0,0 +1,13 @@
+-- Add extension "unaccent"
+CREATE EXTENSION IF NOT EXISTS "unaccent" WITH SCHEMA "public";
+-- O unaccent não é IMMUTABLE e não pode ser usado em colunas geradas; a
+-- função fixa o dicionário para que possa
+CREATE OR REPLACE FUNCTION "public"."f_unaccent"(text) RETURNS text LANGUAGE sql IMMUTABLE PARALLEL SAFE STRICT AS $$ SELECT "public"."unaccent"('"public"."unaccent"'::regdictionary, $1) $$;
+-- Modify "debts" table. A coluna é mantida pelo banco e fica fora do schema do ent
+ALTER TABLE "public"."debts" ADD COLUMN "search_vector" tsvector GENERATED ALWAYS AS (to_tsvector('portuguese'::regconfig, "public"."f_unaccent"(coalesce("title", '')))) STORED;
+-- Create index "debt_search_vector" to table: "debts"
+CREATE INDEX "debt_search_vector" ON "public"."debts" USING GIN ("search_vector");
+-- Modify "invoices" table. A coluna é mantida pelo banco e fica fora do schema do ent
+ALTER TABLE "public"."invoices" ADD COLUMN "search_vector" tsvector GENERATED ALWAYS AS (to_tsvector('portuguese'::regconfig, "public"."f_unaccent"(coalesce("title", '')))) STORED;
+-- Create index "invoice_search_vector" to table: "invoices"
+CREATE INDEX "invoice_search_vector" ON "public"."invoices" USING GIN ("search_vector");
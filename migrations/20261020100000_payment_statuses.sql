@@ -0,0 +1,2 @@
+-- Payment statuses are shared by every workspace and are read-only in the API, so the ones the code depends on are created here
+INSERT INTO "public"."payment_status" ("id", "created_at", "updated_at", "name", "description") SELECT gen_random_uuid(), now(), now(), s."name", s."description" FROM (VALUES ('pending', 'Pagamento pendente'), ('paid', 'Pagamento realizado'), ('failed', 'Pagamento falhou')) AS s ("name", "description") WHERE NOT EXISTS (SELECT 1 FROM "public"."payment_status" p WHERE p."name" = s."name");
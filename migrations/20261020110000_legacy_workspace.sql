@@ -0,0 +1,12 @@
+-- Rows created before workspaces existed had no user, so the workspaces migration left them without a workspace. They are moved to a "Legado" workspace with a fixed ID, owned by the oldest user. Without users it has no members until "workspace claim-legacy" is run.
+INSERT INTO "public"."workspaces" ("id", "created_at", "updated_at", "name") SELECT '00000000-0000-0000-0000-000000000001', now(), now(), 'Legado' WHERE EXISTS (SELECT 1 FROM "public"."categories" WHERE "workspace_id" IS NULL) OR EXISTS (SELECT 1 FROM "public"."debts" WHERE "workspace_id" IS NULL) OR EXISTS (SELECT 1 FROM "public"."invoices" WHERE "workspace_id" IS NULL);
+INSERT INTO "public"."workspace_members" ("id", "created_at", "updated_at", "role", "workspace_id", "user_id") SELECT gen_random_uuid(), now(), now(), 'owner', w."id", u."id" FROM "public"."workspaces" w, (SELECT "id" FROM "public"."users" ORDER BY "created_at" LIMIT 1) u WHERE w."id" = '00000000-0000-0000-0000-000000000001';
+UPDATE "public"."categories" SET "workspace_id" = '00000000-0000-0000-0000-000000000001' WHERE "workspace_id" IS NULL;
+UPDATE "public"."debts" SET "workspace_id" = '00000000-0000-0000-0000-000000000001' WHERE "workspace_id" IS NULL;
+UPDATE "public"."invoices" SET "workspace_id" = '00000000-0000-0000-0000-000000000001' WHERE "workspace_id" IS NULL;
+-- Modify "categories" table
+ALTER TABLE "public"."categories" DROP CONSTRAINT "categories_workspaces_workspace", ALTER COLUMN "workspace_id" SET NOT NULL, ADD CONSTRAINT "categories_workspaces_workspace" FOREIGN KEY ("workspace_id") REFERENCES "public"."workspaces" ("id") ON UPDATE NO ACTION ON DELETE NO ACTION;
+-- Modify "debts" table
+ALTER TABLE "public"."debts" DROP CONSTRAINT "debts_workspaces_workspace", ALTER COLUMN "workspace_id" SET NOT NULL, ADD CONSTRAINT "debts_workspaces_workspace" FOREIGN KEY ("workspace_id") REFERENCES "public"."workspaces" ("id") ON UPDATE NO ACTION ON DELETE NO ACTION;
+-- Modify "invoices" table
+ALTER TABLE "public"."invoices" DROP CONSTRAINT "invoices_workspaces_workspace", ALTER COLUMN "workspace_id" SET NOT NULL, ADD CONSTRAINT "invoices_workspaces_workspace" FOREIGN KEY ("workspace_id") REFERENCES "public"."workspaces" ("id") ON UPDATE NO ACTION ON DELETE NO ACTION;
@@ -0,0 +1,8 @@
+// Package migrations embute as migrations versionadas do atlas no binário,
+// para que o comando migrate não dependa dos arquivos no servidor
+package migrations
+
+import "embed"
+
+//go:embed *.sql atlas.sum
+var FS embed.FS
@@ -0,0 +1,63 @@
+package auth
+
+import (
+	"crypto/rand"
+	"crypto/sha256"
+	"encoding/base64"
+	"encoding/hex"
+)
+
+// Prefixo de todas as chaves, facilita identificá-las em logs e varreduras de segredos
+const APIKeyPrefix = "bgo_"
+
+// Escopos que podem ser concedidos a uma chave de API. O gerenciamento das
+// próprias chaves não tem escopo e exige login com usuário e senha.
+var APIKeyScopes = []string{
+	"debts:read",
+	"debts:write",
+	"invoices:read",
+	"invoices:write",
+	"categories:read",
+	"categories:write",
+	"payment_status:read",
+	"attachments:read",
+	"attachments:write",
+	"tags:read",
+	"tags:write",
+	"imports:write",
+	"people:read",
+	"people:write",
+	"workspaces:read",
+	"workspaces:write",
+	"reports:read",
+}
+
+// GenerateAPIKey cria uma nova chave aleatória e retorna a chave, o prefixo
+// exibido para o usuário e o hash que deve ser salvo
+func GenerateAPIKey() (key, prefix, hash string, err error) {
+	buf := make([]byte, 32)
+	if _, err := rand.Read(buf); err != nil {
+		return "", "", "", err
+	}
+
+	key = APIKeyPrefix + base64.RawURLEncoding.EncodeToString(buf)
+	prefix = key[:len(APIKeyPrefix)+8]
+	return key, prefix, HashAPIKey(key), nil
+}
+
+// HashAPIKey calcula o hash salvo no banco. As chaves têm alta entropia,
+// então um SHA-256 é suficiente e permite a busca direta pelo hash.
+func HashAPIKey(key string) string {
+	sum := sha256.Sum256([]byte(key))
+	return hex.EncodeToString(sum[:])
+}
+
+// ValidScope indica se o escopo pode ser concedido a uma chave
+func ValidScope(scope string) bool {
+	for _, s := range APIKeyScopes {
+		if s == scope {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,86 @@
+package auth
+
+import (
+	"context"
+
+	"github.com/google/uuid"
+)
+
+// Papéis de um membro no workspace
+const (
+	RoleOwner  = "owner"
+	RoleEditor = "editor"
+	RoleViewer = "viewer"
+)
+
+type contextKey string
+
+const (
+	userIDKey    contextKey = "user_id"
+	workspaceKey contextKey = "workspace"
+	scopesKey    contextKey = "scopes"
+)
+
+// Workspace identifica o workspace ativo da requisição e o papel do usuário nele
+type Workspace struct {
+	ID   uuid.UUID
+	Role string
+}
+
+// WithUserID adiciona o usuário autenticado ao contexto
+func WithUserID(ctx context.Context, id uuid.UUID) context.Context {
+	return context.WithValue(ctx, userIDKey, id)
+}
+
+// UserIDFromContext retorna o usuário autenticado presente no contexto
+func UserIDFromContext(ctx context.Context) (uuid.UUID, bool) {
+	id, ok := ctx.Value(userIDKey).(uuid.UUID)
+	return id, ok
+}
+
+// WithWorkspace adiciona o workspace ativo ao contexto
+func WithWorkspace(ctx context.Context, workspace Workspace) context.Context {
+	return context.WithValue(ctx, workspaceKey, workspace)
+}
+
+// WorkspaceFromContext retorna o workspace ativo presente no contexto
+func WorkspaceFromContext(ctx context.Context) (Workspace, bool) {
+	workspace, ok := ctx.Value(workspaceKey).(Workspace)
+	return workspace, ok
+}
+
+// WithScopes restringe a requisição aos escopos da chave de API usada
+func WithScopes(ctx context.Context, scopes []string) context.Context {
+	return context.WithValue(ctx, scopesKey, scopes)
+}
+
+// HasScope indica se a requisição pode usar o escopo. Requisições autenticadas
+// com JWT não possuem escopos no contexto e têm acesso total.
+func HasScope(ctx context.Context, scope string) bool {
+	scopes, ok := ctx.Value(scopesKey).([]string)
+	if !ok {
+		return true
+	}
+	for _, s := range scopes {
+		if s == scope {
+			return true
+		}
+	}
+	return false
+}
+
+// IsAPIKey indica se a requisição foi autenticada com uma chave de API
+func IsAPIKey(ctx context.Context) bool {
+	_, ok := ctx.Value(scopesKey).([]string)
+	return ok
+}
+
+// CanWrite indica se o papel permite criar, alterar e remover dados
+func CanWrite(role string) bool {
+	return role == RoleOwner || role == RoleEditor
+}
+
+// ValidRole indica se o papel existe
+func ValidRole(role string) bool {
+	return role == RoleOwner || role == RoleEditor || role == RoleViewer
+}
@@ -0,0 +1,34 @@
+package auth
+
+import (
+	"sync"
+
+	"golang.org/x/crypto/bcrypt"
+)
+
+// dummyHash é gerado apenas no primeiro login com e-mail inexistente
+var dummyHash = sync.OnceValue(func() []byte {
+	hash, _ := bcrypt.GenerateFromPassword([]byte("senha-inexistente"), bcrypt.DefaultCost)
+	return hash
+})
+
+// HashPassword gera o hash bcrypt da senha
+func HashPassword(password string) (string, error) {
+	hash, err := bcrypt.GenerateFromPassword([]byte(password), bcrypt.DefaultCost)
+	if err != nil {
+		return "", err
+	}
+	return string(hash), nil
+}
+
+// CheckPassword compara a senha com o hash salvo
+func CheckPassword(hash, password string) bool {
+	return bcrypt.CompareHashAndPassword([]byte(hash), []byte(password)) == nil
+}
+
+// CheckDummyPassword faz uma comparação bcrypt que sempre falha. É usada
+// quando o usuário não existe, para que a resposta leve o mesmo tempo de uma
+// senha errada e não revele quais e-mails têm conta.
+func CheckDummyPassword(password string) {
+	_ = bcrypt.CompareHashAndPassword(dummyHash(), []byte(password))
+}
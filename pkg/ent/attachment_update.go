@@ -0,0 +1,738 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"backend-go/pkg/ent/attachment"
+	"backend-go/pkg/ent/debt"
+	"backend-go/pkg/ent/invoice"
+	"backend-go/pkg/ent/predicate"
+	"backend-go/pkg/ent/workspace"
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/google/uuid"
+)
+
+// AttachmentUpdate is the builder for updating Attachment entities.
+type AttachmentUpdate struct {
+	config
+	hooks    []Hook
+	mutation *AttachmentMutation
+}
+
+// Where appends a list predicates to the AttachmentUpdate builder.
+func (au *AttachmentUpdate) Where(ps ...predicate.Attachment) *AttachmentUpdate {
+	au.mutation.Where(ps...)
+	return au
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (au *AttachmentUpdate) SetUpdatedAt(t time.Time) *AttachmentUpdate {
+	au.mutation.SetUpdatedAt(t)
+	return au
+}
+
+// SetFileName sets the "file_name" field.
+func (au *AttachmentUpdate) SetFileName(s string) *AttachmentUpdate {
+	au.mutation.SetFileName(s)
+	return au
+}
+
+// SetNillableFileName sets the "file_name" field if the given value is not nil.
+func (au *AttachmentUpdate) SetNillableFileName(s *string) *AttachmentUpdate {
+	if s != nil {
+		au.SetFileName(*s)
+	}
+	return au
+}
+
+// SetContentType sets the "content_type" field.
+func (au *AttachmentUpdate) SetContentType(s string) *AttachmentUpdate {
+	au.mutation.SetContentType(s)
+	return au
+}
+
+// SetNillableContentType sets the "content_type" field if the given value is not nil.
+func (au *AttachmentUpdate) SetNillableContentType(s *string) *AttachmentUpdate {
+	if s != nil {
+		au.SetContentType(*s)
+	}
+	return au
+}
+
+// SetSize sets the "size" field.
+func (au *AttachmentUpdate) SetSize(i int64) *AttachmentUpdate {
+	au.mutation.ResetSize()
+	au.mutation.SetSize(i)
+	return au
+}
+
+// SetNillableSize sets the "size" field if the given value is not nil.
+func (au *AttachmentUpdate) SetNillableSize(i *int64) *AttachmentUpdate {
+	if i != nil {
+		au.SetSize(*i)
+	}
+	return au
+}
+
+// AddSize adds i to the "size" field.
+func (au *AttachmentUpdate) AddSize(i int64) *AttachmentUpdate {
+	au.mutation.AddSize(i)
+	return au
+}
+
+// SetWorkspaceID sets the "workspace_id" field.
+func (au *AttachmentUpdate) SetWorkspaceID(u uuid.UUID) *AttachmentUpdate {
+	au.mutation.SetWorkspaceID(u)
+	return au
+}
+
+// SetNillableWorkspaceID sets the "workspace_id" field if the given value is not nil.
+func (au *AttachmentUpdate) SetNillableWorkspaceID(u *uuid.UUID) *AttachmentUpdate {
+	if u != nil {
+		au.SetWorkspaceID(*u)
+	}
+	return au
+}
+
+// SetDebtID sets the "debt_id" field.
+func (au *AttachmentUpdate) SetDebtID(u uuid.UUID) *AttachmentUpdate {
+	au.mutation.SetDebtID(u)
+	return au
+}
+
+// SetNillableDebtID sets the "debt_id" field if the given value is not nil.
+func (au *AttachmentUpdate) SetNillableDebtID(u *uuid.UUID) *AttachmentUpdate {
+	if u != nil {
+		au.SetDebtID(*u)
+	}
+	return au
+}
+
+// ClearDebtID clears the value of the "debt_id" field.
+func (au *AttachmentUpdate) ClearDebtID() *AttachmentUpdate {
+	au.mutation.ClearDebtID()
+	return au
+}
+
+// SetInvoiceID sets the "invoice_id" field.
+func (au *AttachmentUpdate) SetInvoiceID(u uuid.UUID) *AttachmentUpdate {
+	au.mutation.SetInvoiceID(u)
+	return au
+}
+
+// SetNillableInvoiceID sets the "invoice_id" field if the given value is not nil.
+func (au *AttachmentUpdate) SetNillableInvoiceID(u *uuid.UUID) *AttachmentUpdate {
+	if u != nil {
+		au.SetInvoiceID(*u)
+	}
+	return au
+}
+
+// ClearInvoiceID clears the value of the "invoice_id" field.
+func (au *AttachmentUpdate) ClearInvoiceID() *AttachmentUpdate {
+	au.mutation.ClearInvoiceID()
+	return au
+}
+
+// SetWorkspace sets the "workspace" edge to the Workspace entity.
+func (au *AttachmentUpdate) SetWorkspace(w *Workspace) *AttachmentUpdate {
+	return au.SetWorkspaceID(w.ID)
+}
+
+// SetDebt sets the "debt" edge to the Debt entity.
+func (au *AttachmentUpdate) SetDebt(d *Debt) *AttachmentUpdate {
+	return au.SetDebtID(d.ID)
+}
+
+// SetInvoice sets the "invoice" edge to the Invoice entity.
+func (au *AttachmentUpdate) SetInvoice(i *Invoice) *AttachmentUpdate {
+	return au.SetInvoiceID(i.ID)
+}
+
+// Mutation returns the AttachmentMutation object of the builder.
+func (au *AttachmentUpdate) Mutation() *AttachmentMutation {
+	return au.mutation
+}
+
+// ClearWorkspace clears the "workspace" edge to the Workspace entity.
+func (au *AttachmentUpdate) ClearWorkspace() *AttachmentUpdate {
+	au.mutation.ClearWorkspace()
+	return au
+}
+
+// ClearDebt clears the "debt" edge to the Debt entity.
+func (au *AttachmentUpdate) ClearDebt() *AttachmentUpdate {
+	au.mutation.ClearDebt()
+	return au
+}
+
+// ClearInvoice clears the "invoice" edge to the Invoice entity.
+func (au *AttachmentUpdate) ClearInvoice() *AttachmentUpdate {
+	au.mutation.ClearInvoice()
+	return au
+}
+
+// Save executes the query and returns the number of nodes affected by the update operation.
+func (au *AttachmentUpdate) Save(ctx context.Context) (int, error) {
+	au.defaults()
+	return withHooks(ctx, au.sqlSave, au.mutation, au.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (au *AttachmentUpdate) SaveX(ctx context.Context) int {
+	affected, err := au.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return affected
+}
+
+// Exec executes the query.
+func (au *AttachmentUpdate) Exec(ctx context.Context) error {
+	_, err := au.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (au *AttachmentUpdate) ExecX(ctx context.Context) {
+	if err := au.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (au *AttachmentUpdate) defaults() {
+	if _, ok := au.mutation.UpdatedAt(); !ok {
+		v := attachment.UpdateDefaultUpdatedAt()
+		au.mutation.SetUpdatedAt(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (au *AttachmentUpdate) check() error {
+	if v, ok := au.mutation.FileName(); ok {
+		if err := attachment.FileNameValidator(v); err != nil {
+			return &ValidationError{Name: "file_name", err: fmt.Errorf(`ent: validator failed for field "Attachment.file_name": %w`, err)}
+		}
+	}
+	if v, ok := au.mutation.ContentType(); ok {
+		if err := attachment.ContentTypeValidator(v); err != nil {
+			return &ValidationError{Name: "content_type", err: fmt.Errorf(`ent: validator failed for field "Attachment.content_type": %w`, err)}
+		}
+	}
+	if v, ok := au.mutation.Size(); ok {
+		if err := attachment.SizeValidator(v); err != nil {
+			return &ValidationError{Name: "size", err: fmt.Errorf(`ent: validator failed for field "Attachment.size": %w`, err)}
+		}
+	}
+	if au.mutation.WorkspaceCleared() && len(au.mutation.WorkspaceIDs()) > 0 {
+		return errors.New(`ent: clearing a required unique edge "Attachment.workspace"`)
+	}
+	return nil
+}
+
+func (au *AttachmentUpdate) sqlSave(ctx context.Context) (n int, err error) {
+	if err := au.check(); err != nil {
+		return n, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(attachment.Table, attachment.Columns, sqlgraph.NewFieldSpec(attachment.FieldID, field.TypeUUID))
+	if ps := au.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := au.mutation.UpdatedAt(); ok {
+		_spec.SetField(attachment.FieldUpdatedAt, field.TypeTime, value)
+	}
+	if value, ok := au.mutation.FileName(); ok {
+		_spec.SetField(attachment.FieldFileName, field.TypeString, value)
+	}
+	if value, ok := au.mutation.ContentType(); ok {
+		_spec.SetField(attachment.FieldContentType, field.TypeString, value)
+	}
+	if value, ok := au.mutation.Size(); ok {
+		_spec.SetField(attachment.FieldSize, field.TypeInt64, value)
+	}
+	if value, ok := au.mutation.AddedSize(); ok {
+		_spec.AddField(attachment.FieldSize, field.TypeInt64, value)
+	}
+	if au.mutation.WorkspaceCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: false,
+			Table:   attachment.WorkspaceTable,
+			Columns: []string{attachment.WorkspaceColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(workspace.FieldID, field.TypeUUID),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := au.mutation.WorkspaceIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: false,
+			Table:   attachment.WorkspaceTable,
+			Columns: []string{attachment.WorkspaceColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(workspace.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if au.mutation.DebtCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   attachment.DebtTable,
+			Columns: []string{attachment.DebtColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(debt.FieldID, field.TypeUUID),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := au.mutation.DebtIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   attachment.DebtTable,
+			Columns: []string{attachment.DebtColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(debt.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if au.mutation.InvoiceCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   attachment.InvoiceTable,
+			Columns: []string{attachment.InvoiceColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(invoice.FieldID, field.TypeUUID),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := au.mutation.InvoiceIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   attachment.InvoiceTable,
+			Columns: []string{attachment.InvoiceColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(invoice.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if n, err = sqlgraph.UpdateNodes(ctx, au.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{attachment.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return 0, err
+	}
+	au.mutation.done = true
+	return n, nil
+}
+
+// AttachmentUpdateOne is the builder for updating a single Attachment entity.
+type AttachmentUpdateOne struct {
+	config
+	fields   []string
+	hooks    []Hook
+	mutation *AttachmentMutation
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (auo *AttachmentUpdateOne) SetUpdatedAt(t time.Time) *AttachmentUpdateOne {
+	auo.mutation.SetUpdatedAt(t)
+	return auo
+}
+
+// SetFileName sets the "file_name" field.
+func (auo *AttachmentUpdateOne) SetFileName(s string) *AttachmentUpdateOne {
+	auo.mutation.SetFileName(s)
+	return auo
+}
+
+// SetNillableFileName sets the "file_name" field if the given value is not nil.
+func (auo *AttachmentUpdateOne) SetNillableFileName(s *string) *AttachmentUpdateOne {
+	if s != nil {
+		auo.SetFileName(*s)
+	}
+	return auo
+}
+
+// SetContentType sets the "content_type" field.
+func (auo *AttachmentUpdateOne) SetContentType(s string) *AttachmentUpdateOne {
+	auo.mutation.SetContentType(s)
+	return auo
+}
+
+// SetNillableContentType sets the "content_type" field if the given value is not nil.
+func (auo *AttachmentUpdateOne) SetNillableContentType(s *string) *AttachmentUpdateOne {
+	if s != nil {
+		auo.SetContentType(*s)
+	}
+	return auo
+}
+
+// SetSize sets the "size" field.
+func (auo *AttachmentUpdateOne) SetSize(i int64) *AttachmentUpdateOne {
+	auo.mutation.ResetSize()
+	auo.mutation.SetSize(i)
+	return auo
+}
+
+// SetNillableSize sets the "size" field if the given value is not nil.
+func (auo *AttachmentUpdateOne) SetNillableSize(i *int64) *AttachmentUpdateOne {
+	if i != nil {
+		auo.SetSize(*i)
+	}
+	return auo
+}
+
+// AddSize adds i to the "size" field.
+func (auo *AttachmentUpdateOne) AddSize(i int64) *AttachmentUpdateOne {
+	auo.mutation.AddSize(i)
+	return auo
+}
+
+// SetWorkspaceID sets the "workspace_id" field.
+func (auo *AttachmentUpdateOne) SetWorkspaceID(u uuid.UUID) *AttachmentUpdateOne {
+	auo.mutation.SetWorkspaceID(u)
+	return auo
+}
+
+// SetNillableWorkspaceID sets the "workspace_id" field if the given value is not nil.
+func (auo *AttachmentUpdateOne) SetNillableWorkspaceID(u *uuid.UUID) *AttachmentUpdateOne {
+	if u != nil {
+		auo.SetWorkspaceID(*u)
+	}
+	return auo
+}
+
+// SetDebtID sets the "debt_id" field.
+func (auo *AttachmentUpdateOne) SetDebtID(u uuid.UUID) *AttachmentUpdateOne {
+	auo.mutation.SetDebtID(u)
+	return auo
+}
+
+// SetNillableDebtID sets the "debt_id" field if the given value is not nil.
+func (auo *AttachmentUpdateOne) SetNillableDebtID(u *uuid.UUID) *AttachmentUpdateOne {
+	if u != nil {
+		auo.SetDebtID(*u)
+	}
+	return auo
+}
+
+// ClearDebtID clears the value of the "debt_id" field.
+func (auo *AttachmentUpdateOne) ClearDebtID() *AttachmentUpdateOne {
+	auo.mutation.ClearDebtID()
+	return auo
+}
+
+// SetInvoiceID sets the "invoice_id" field.
+func (auo *AttachmentUpdateOne) SetInvoiceID(u uuid.UUID) *AttachmentUpdateOne {
+	auo.mutation.SetInvoiceID(u)
+	return auo
+}
+
+// SetNillableInvoiceID sets the "invoice_id" field if the given value is not nil.
+func (auo *AttachmentUpdateOne) SetNillableInvoiceID(u *uuid.UUID) *AttachmentUpdateOne {
+	if u != nil {
+		auo.SetInvoiceID(*u)
+	}
+	return auo
+}
+
+// ClearInvoiceID clears the value of the "invoice_id" field.
+func (auo *AttachmentUpdateOne) ClearInvoiceID() *AttachmentUpdateOne {
+	auo.mutation.ClearInvoiceID()
+	return auo
+}
+
+// SetWorkspace sets the "workspace" edge to the Workspace entity.
+func (auo *AttachmentUpdateOne) SetWorkspace(w *Workspace) *AttachmentUpdateOne {
+	return auo.SetWorkspaceID(w.ID)
+}
+
+// SetDebt sets the "debt" edge to the Debt entity.
+func (auo *AttachmentUpdateOne) SetDebt(d *Debt) *AttachmentUpdateOne {
+	return auo.SetDebtID(d.ID)
+}
+
+// SetInvoice sets the "invoice" edge to the Invoice entity.
+func (auo *AttachmentUpdateOne) SetInvoice(i *Invoice) *AttachmentUpdateOne {
+	return auo.SetInvoiceID(i.ID)
+}
+
+// Mutation returns the AttachmentMutation object of the builder.
+func (auo *AttachmentUpdateOne) Mutation() *AttachmentMutation {
+	return auo.mutation
+}
+
+// ClearWorkspace clears the "workspace" edge to the Workspace entity.
+func (auo *AttachmentUpdateOne) ClearWorkspace() *AttachmentUpdateOne {
+	auo.mutation.ClearWorkspace()
+	return auo
+}
+
+// ClearDebt clears the "debt" edge to the Debt entity.
+func (auo *AttachmentUpdateOne) ClearDebt() *AttachmentUpdateOne {
+	auo.mutation.ClearDebt()
+	return auo
+}
+
+// ClearInvoice clears the "invoice" edge to the Invoice entity.
+func (auo *AttachmentUpdateOne) ClearInvoice() *AttachmentUpdateOne {
+	auo.mutation.ClearInvoice()
+	return auo
+}
+
+// Where appends a list predicates to the AttachmentUpdate builder.
+func (auo *AttachmentUpdateOne) Where(ps ...predicate.Attachment) *AttachmentUpdateOne {
+	auo.mutation.Where(ps...)
+	return auo
+}
+
+// Select allows selecting one or more fields (columns) of the returned entity.
+// The default is selecting all fields defined in the entity schema.
+func (auo *AttachmentUpdateOne) Select(field string, fields ...string) *AttachmentUpdateOne {
+	auo.fields = append([]string{field}, fields...)
+	return auo
+}
+
+// Save executes the query and returns the updated Attachment entity.
+func (auo *AttachmentUpdateOne) Save(ctx context.Context) (*Attachment, error) {
+	auo.defaults()
+	return withHooks(ctx, auo.sqlSave, auo.mutation, auo.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (auo *AttachmentUpdateOne) SaveX(ctx context.Context) *Attachment {
+	node, err := auo.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// Exec executes the query on the entity.
+func (auo *AttachmentUpdateOne) Exec(ctx context.Context) error {
+	_, err := auo.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (auo *AttachmentUpdateOne) ExecX(ctx context.Context) {
+	if err := auo.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (auo *AttachmentUpdateOne) defaults() {
+	if _, ok := auo.mutation.UpdatedAt(); !ok {
+		v := attachment.UpdateDefaultUpdatedAt()
+		auo.mutation.SetUpdatedAt(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (auo *AttachmentUpdateOne) check() error {
+	if v, ok := auo.mutation.FileName(); ok {
+		if err := attachment.FileNameValidator(v); err != nil {
+			return &ValidationError{Name: "file_name", err: fmt.Errorf(`ent: validator failed for field "Attachment.file_name": %w`, err)}
+		}
+	}
+	if v, ok := auo.mutation.ContentType(); ok {
+		if err := attachment.ContentTypeValidator(v); err != nil {
+			return &ValidationError{Name: "content_type", err: fmt.Errorf(`ent: validator failed for field "Attachment.content_type": %w`, err)}
+		}
+	}
+	if v, ok := auo.mutation.Size(); ok {
+		if err := attachment.SizeValidator(v); err != nil {
+			return &ValidationError{Name: "size", err: fmt.Errorf(`ent: validator failed for field "Attachment.size": %w`, err)}
+		}
+	}
+	if auo.mutation.WorkspaceCleared() && len(auo.mutation.WorkspaceIDs()) > 0 {
+		return errors.New(`ent: clearing a required unique edge "Attachment.workspace"`)
+	}
+	return nil
+}
+
+func (auo *AttachmentUpdateOne) sqlSave(ctx context.Context) (_node *Attachment, err error) {
+	if err := auo.check(); err != nil {
+		return _node, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(attachment.Table, attachment.Columns, sqlgraph.NewFieldSpec(attachment.FieldID, field.TypeUUID))
+	id, ok := auo.mutation.ID()
+	if !ok {
+		return nil, &ValidationError{Name: "id", err: errors.New(`ent: missing "Attachment.id" for update`)}
+	}
+	_spec.Node.ID.Value = id
+	if fields := auo.fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields))
+		_spec.Node.Columns = append(_spec.Node.Columns, attachment.FieldID)
+		for _, f := range fields {
+			if !attachment.ValidColumn(f) {
+				return nil, &ValidationError{Name: f, err: fmt.Errorf("ent: invalid field %q for query", f)}
+			}
+			if f != attachment.FieldID {
+				_spec.Node.Columns = append(_spec.Node.Columns, f)
+			}
+		}
+	}
+	if ps := auo.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := auo.mutation.UpdatedAt(); ok {
+		_spec.SetField(attachment.FieldUpdatedAt, field.TypeTime, value)
+	}
+	if value, ok := auo.mutation.FileName(); ok {
+		_spec.SetField(attachment.FieldFileName, field.TypeString, value)
+	}
+	if value, ok := auo.mutation.ContentType(); ok {
+		_spec.SetField(attachment.FieldContentType, field.TypeString, value)
+	}
+	if value, ok := auo.mutation.Size(); ok {
+		_spec.SetField(attachment.FieldSize, field.TypeInt64, value)
+	}
+	if value, ok := auo.mutation.AddedSize(); ok {
+		_spec.AddField(attachment.FieldSize, field.TypeInt64, value)
+	}
+	if auo.mutation.WorkspaceCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: false,
+			Table:   attachment.WorkspaceTable,
+			Columns: []string{attachment.WorkspaceColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(workspace.FieldID, field.TypeUUID),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := auo.mutation.WorkspaceIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: false,
+			Table:   attachment.WorkspaceTable,
+			Columns: []string{attachment.WorkspaceColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(workspace.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if auo.mutation.DebtCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   attachment.DebtTable,
+			Columns: []string{attachment.DebtColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(debt.FieldID, field.TypeUUID),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := auo.mutation.DebtIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   attachment.DebtTable,
+			Columns: []string{attachment.DebtColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(debt.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if auo.mutation.InvoiceCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   attachment.InvoiceTable,
+			Columns: []string{attachment.InvoiceColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(invoice.FieldID, field.TypeUUID),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := auo.mutation.InvoiceIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   attachment.InvoiceTable,
+			Columns: []string{attachment.InvoiceColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(invoice.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	_node = &Attachment{config: auo.config}
+	_spec.Assign = _node.assignValues
+	_spec.ScanValues = _node.scanValues
+	if err = sqlgraph.UpdateNode(ctx, auo.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{attachment.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	auo.mutation.done = true
+	return _node, nil
+}
@@ -4,6 +4,8 @@ package ent
 
 import (
 	"backend-go/pkg/ent/category"
+	"backend-go/pkg/ent/debt"
+	"backend-go/pkg/ent/workspace"
 	"context"
 	"errors"
 	"fmt"
@@ -49,6 +51,34 @@ func (cc *CategoryCreate) SetNillableUpdatedAt(t *time.Time) *CategoryCreate {
 	return cc
 }
 
+// SetVersion sets the "version" field.
+func (cc *CategoryCreate) SetVersion(i int) *CategoryCreate {
+	cc.mutation.SetVersion(i)
+	return cc
+}
+
+// SetNillableVersion sets the "version" field if the given value is not nil.
+func (cc *CategoryCreate) SetNillableVersion(i *int) *CategoryCreate {
+	if i != nil {
+		cc.SetVersion(*i)
+	}
+	return cc
+}
+
+// SetDeletedAt sets the "deleted_at" field.
+func (cc *CategoryCreate) SetDeletedAt(t time.Time) *CategoryCreate {
+	cc.mutation.SetDeletedAt(t)
+	return cc
+}
+
+// SetNillableDeletedAt sets the "deleted_at" field if the given value is not nil.
+func (cc *CategoryCreate) SetNillableDeletedAt(t *time.Time) *CategoryCreate {
+	if t != nil {
+		cc.SetDeletedAt(*t)
+	}
+	return cc
+}
+
 // SetName sets the "name" field.
 func (cc *CategoryCreate) SetName(s string) *CategoryCreate {
 	cc.mutation.SetName(s)
@@ -69,6 +99,12 @@ func (cc *CategoryCreate) SetNillableDescription(s *string) *CategoryCreate {
 	return cc
 }
 
+// SetWorkspaceID sets the "workspace_id" field.
+func (cc *CategoryCreate) SetWorkspaceID(u uuid.UUID) *CategoryCreate {
+	cc.mutation.SetWorkspaceID(u)
+	return cc
+}
+
 // SetID sets the "id" field.
 func (cc *CategoryCreate) SetID(u uuid.UUID) *CategoryCreate {
 	cc.mutation.SetID(u)
@@ -83,6 +119,26 @@ func (cc *CategoryCreate) SetNillableID(u *uuid.UUID) *CategoryCreate {
 	return cc
 }
 
+// SetWorkspace sets the "workspace" edge to the Workspace entity.
+func (cc *CategoryCreate) SetWorkspace(w *Workspace) *CategoryCreate {
+	return cc.SetWorkspaceID(w.ID)
+}
+
+// AddDebtIDs adds the "debts" edge to the Debt entity by IDs.
+func (cc *CategoryCreate) AddDebtIDs(ids ...uuid.UUID) *CategoryCreate {
+	cc.mutation.AddDebtIDs(ids...)
+	return cc
+}
+
+// AddDebts adds the "debts" edges to the Debt entity.
+func (cc *CategoryCreate) AddDebts(d ...*Debt) *CategoryCreate {
+	ids := make([]uuid.UUID, len(d))
+	for i := range d {
+		ids[i] = d[i].ID
+	}
+	return cc.AddDebtIDs(ids...)
+}
+
 // Mutation returns the CategoryMutation object of the builder.
 func (cc *CategoryCreate) Mutation() *CategoryMutation {
 	return cc.mutation
@@ -126,6 +182,10 @@ func (cc *CategoryCreate) defaults() {
 		v := category.DefaultUpdatedAt()
 		cc.mutation.SetUpdatedAt(v)
 	}
+	if _, ok := cc.mutation.Version(); !ok {
+		v := category.DefaultVersion
+		cc.mutation.SetVersion(v)
+	}
 	if _, ok := cc.mutation.ID(); !ok {
 		v := category.DefaultID()
 		cc.mutation.SetID(v)
@@ -140,6 +200,9 @@ func (cc *CategoryCreate) check() error {
 	if _, ok := cc.mutation.UpdatedAt(); !ok {
 		return &ValidationError{Name: "updated_at", err: errors.New(`ent: missing required field "Category.updated_at"`)}
 	}
+	if _, ok := cc.mutation.Version(); !ok {
+		return &ValidationError{Name: "version", err: errors.New(`ent: missing required field "Category.version"`)}
+	}
 	if _, ok := cc.mutation.Name(); !ok {
 		return &ValidationError{Name: "name", err: errors.New(`ent: missing required field "Category.name"`)}
 	}
@@ -148,6 +211,12 @@ func (cc *CategoryCreate) check() error {
 			return &ValidationError{Name: "name", err: fmt.Errorf(`ent: validator failed for field "Category.name": %w`, err)}
 		}
 	}
+	if _, ok := cc.mutation.WorkspaceID(); !ok {
+		return &ValidationError{Name: "workspace_id", err: errors.New(`ent: missing required field "Category.workspace_id"`)}
+	}
+	if len(cc.mutation.WorkspaceIDs()) == 0 {
+		return &ValidationError{Name: "workspace", err: errors.New(`ent: missing required edge "Category.workspace"`)}
+	}
 	return nil
 }
 
@@ -191,6 +260,14 @@ func (cc *CategoryCreate) createSpec() (*Category, *sqlgraph.CreateSpec) {
 		_spec.SetField(category.FieldUpdatedAt, field.TypeTime, value)
 		_node.UpdatedAt = value
 	}
+	if value, ok := cc.mutation.Version(); ok {
+		_spec.SetField(category.FieldVersion, field.TypeInt, value)
+		_node.Version = value
+	}
+	if value, ok := cc.mutation.DeletedAt(); ok {
+		_spec.SetField(category.FieldDeletedAt, field.TypeTime, value)
+		_node.DeletedAt = &value
+	}
 	if value, ok := cc.mutation.Name(); ok {
 		_spec.SetField(category.FieldName, field.TypeString, value)
 		_node.Name = value
@@ -199,6 +276,39 @@ func (cc *CategoryCreate) createSpec() (*Category, *sqlgraph.CreateSpec) {
 		_spec.SetField(category.FieldDescription, field.TypeString, value)
 		_node.Description = &value
 	}
+	if nodes := cc.mutation.WorkspaceIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: false,
+			Table:   category.WorkspaceTable,
+			Columns: []string{category.WorkspaceColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(workspace.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_node.WorkspaceID = nodes[0]
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	if nodes := cc.mutation.DebtsIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: true,
+			Table:   category.DebtsTable,
+			Columns: []string{category.DebtsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(debt.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges = append(_spec.Edges, edge)
+	}
 	return _node, _spec
 }
 
@@ -4,7 +4,9 @@ package ent
 
 import (
 	"backend-go/pkg/ent/category"
+	"backend-go/pkg/ent/debt"
 	"backend-go/pkg/ent/predicate"
+	"backend-go/pkg/ent/workspace"
 	"context"
 	"errors"
 	"fmt"
@@ -13,6 +15,7 @@ import (
 	"entgo.io/ent/dialect/sql"
 	"entgo.io/ent/dialect/sql/sqlgraph"
 	"entgo.io/ent/schema/field"
+	"github.com/google/uuid"
 )
 
 // CategoryUpdate is the builder for updating Category entities.
@@ -34,6 +37,47 @@ func (cu *CategoryUpdate) SetUpdatedAt(t time.Time) *CategoryUpdate {
 	return cu
 }
 
+// SetVersion sets the "version" field.
+func (cu *CategoryUpdate) SetVersion(i int) *CategoryUpdate {
+	cu.mutation.ResetVersion()
+	cu.mutation.SetVersion(i)
+	return cu
+}
+
+// SetNillableVersion sets the "version" field if the given value is not nil.
+func (cu *CategoryUpdate) SetNillableVersion(i *int) *CategoryUpdate {
+	if i != nil {
+		cu.SetVersion(*i)
+	}
+	return cu
+}
+
+// AddVersion adds i to the "version" field.
+func (cu *CategoryUpdate) AddVersion(i int) *CategoryUpdate {
+	cu.mutation.AddVersion(i)
+	return cu
+}
+
+// SetDeletedAt sets the "deleted_at" field.
+func (cu *CategoryUpdate) SetDeletedAt(t time.Time) *CategoryUpdate {
+	cu.mutation.SetDeletedAt(t)
+	return cu
+}
+
+// SetNillableDeletedAt sets the "deleted_at" field if the given value is not nil.
+func (cu *CategoryUpdate) SetNillableDeletedAt(t *time.Time) *CategoryUpdate {
+	if t != nil {
+		cu.SetDeletedAt(*t)
+	}
+	return cu
+}
+
+// ClearDeletedAt clears the value of the "deleted_at" field.
+func (cu *CategoryUpdate) ClearDeletedAt() *CategoryUpdate {
+	cu.mutation.ClearDeletedAt()
+	return cu
+}
+
 // SetName sets the "name" field.
 func (cu *CategoryUpdate) SetName(s string) *CategoryUpdate {
 	cu.mutation.SetName(s)
@@ -68,11 +112,72 @@ func (cu *CategoryUpdate) ClearDescription() *CategoryUpdate {
 	return cu
 }
 
+// SetWorkspaceID sets the "workspace_id" field.
+func (cu *CategoryUpdate) SetWorkspaceID(u uuid.UUID) *CategoryUpdate {
+	cu.mutation.SetWorkspaceID(u)
+	return cu
+}
+
+// SetNillableWorkspaceID sets the "workspace_id" field if the given value is not nil.
+func (cu *CategoryUpdate) SetNillableWorkspaceID(u *uuid.UUID) *CategoryUpdate {
+	if u != nil {
+		cu.SetWorkspaceID(*u)
+	}
+	return cu
+}
+
+// SetWorkspace sets the "workspace" edge to the Workspace entity.
+func (cu *CategoryUpdate) SetWorkspace(w *Workspace) *CategoryUpdate {
+	return cu.SetWorkspaceID(w.ID)
+}
+
+// AddDebtIDs adds the "debts" edge to the Debt entity by IDs.
+func (cu *CategoryUpdate) AddDebtIDs(ids ...uuid.UUID) *CategoryUpdate {
+	cu.mutation.AddDebtIDs(ids...)
+	return cu
+}
+
+// AddDebts adds the "debts" edges to the Debt entity.
+func (cu *CategoryUpdate) AddDebts(d ...*Debt) *CategoryUpdate {
+	ids := make([]uuid.UUID, len(d))
+	for i := range d {
+		ids[i] = d[i].ID
+	}
+	return cu.AddDebtIDs(ids...)
+}
+
 // Mutation returns the CategoryMutation object of the builder.
 func (cu *CategoryUpdate) Mutation() *CategoryMutation {
 	return cu.mutation
 }
 
+// ClearWorkspace clears the "workspace" edge to the Workspace entity.
+func (cu *CategoryUpdate) ClearWorkspace() *CategoryUpdate {
+	cu.mutation.ClearWorkspace()
+	return cu
+}
+
+// ClearDebts clears all "debts" edges to the Debt entity.
+func (cu *CategoryUpdate) ClearDebts() *CategoryUpdate {
+	cu.mutation.ClearDebts()
+	return cu
+}
+
+// RemoveDebtIDs removes the "debts" edge to Debt entities by IDs.
+func (cu *CategoryUpdate) RemoveDebtIDs(ids ...uuid.UUID) *CategoryUpdate {
+	cu.mutation.RemoveDebtIDs(ids...)
+	return cu
+}
+
+// RemoveDebts removes "debts" edges to Debt entities.
+func (cu *CategoryUpdate) RemoveDebts(d ...*Debt) *CategoryUpdate {
+	ids := make([]uuid.UUID, len(d))
+	for i := range d {
+		ids[i] = d[i].ID
+	}
+	return cu.RemoveDebtIDs(ids...)
+}
+
 // Save executes the query and returns the number of nodes affected by the update operation.
 func (cu *CategoryUpdate) Save(ctx context.Context) (int, error) {
 	cu.defaults()
@@ -116,6 +221,9 @@ func (cu *CategoryUpdate) check() error {
 			return &ValidationError{Name: "name", err: fmt.Errorf(`ent: validator failed for field "Category.name": %w`, err)}
 		}
 	}
+	if cu.mutation.WorkspaceCleared() && len(cu.mutation.WorkspaceIDs()) > 0 {
+		return errors.New(`ent: clearing a required unique edge "Category.workspace"`)
+	}
 	return nil
 }
 
@@ -134,6 +242,18 @@ func (cu *CategoryUpdate) sqlSave(ctx context.Context) (n int, err error) {
 	if value, ok := cu.mutation.UpdatedAt(); ok {
 		_spec.SetField(category.FieldUpdatedAt, field.TypeTime, value)
 	}
+	if value, ok := cu.mutation.Version(); ok {
+		_spec.SetField(category.FieldVersion, field.TypeInt, value)
+	}
+	if value, ok := cu.mutation.AddedVersion(); ok {
+		_spec.AddField(category.FieldVersion, field.TypeInt, value)
+	}
+	if value, ok := cu.mutation.DeletedAt(); ok {
+		_spec.SetField(category.FieldDeletedAt, field.TypeTime, value)
+	}
+	if cu.mutation.DeletedAtCleared() {
+		_spec.ClearField(category.FieldDeletedAt, field.TypeTime)
+	}
 	if value, ok := cu.mutation.Name(); ok {
 		_spec.SetField(category.FieldName, field.TypeString, value)
 	}
@@ -143,6 +263,80 @@ func (cu *CategoryUpdate) sqlSave(ctx context.Context) (n int, err error) {
 	if cu.mutation.DescriptionCleared() {
 		_spec.ClearField(category.FieldDescription, field.TypeString)
 	}
+	if cu.mutation.WorkspaceCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: false,
+			Table:   category.WorkspaceTable,
+			Columns: []string{category.WorkspaceColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(workspace.FieldID, field.TypeUUID),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := cu.mutation.WorkspaceIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: false,
+			Table:   category.WorkspaceTable,
+			Columns: []string{category.WorkspaceColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(workspace.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if cu.mutation.DebtsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: true,
+			Table:   category.DebtsTable,
+			Columns: []string{category.DebtsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(debt.FieldID, field.TypeUUID),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := cu.mutation.RemovedDebtsIDs(); len(nodes) > 0 && !cu.mutation.DebtsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: true,
+			Table:   category.DebtsTable,
+			Columns: []string{category.DebtsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(debt.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := cu.mutation.DebtsIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: true,
+			Table:   category.DebtsTable,
+			Columns: []string{category.DebtsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(debt.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
 	if n, err = sqlgraph.UpdateNodes(ctx, cu.driver, _spec); err != nil {
 		if _, ok := err.(*sqlgraph.NotFoundError); ok {
 			err = &NotFoundError{category.Label}
@@ -169,6 +363,47 @@ func (cuo *CategoryUpdateOne) SetUpdatedAt(t time.Time) *CategoryUpdateOne {
 	return cuo
 }
 
+// SetVersion sets the "version" field.
+func (cuo *CategoryUpdateOne) SetVersion(i int) *CategoryUpdateOne {
+	cuo.mutation.ResetVersion()
+	cuo.mutation.SetVersion(i)
+	return cuo
+}
+
+// SetNillableVersion sets the "version" field if the given value is not nil.
+func (cuo *CategoryUpdateOne) SetNillableVersion(i *int) *CategoryUpdateOne {
+	if i != nil {
+		cuo.SetVersion(*i)
+	}
+	return cuo
+}
+
+// AddVersion adds i to the "version" field.
+func (cuo *CategoryUpdateOne) AddVersion(i int) *CategoryUpdateOne {
+	cuo.mutation.AddVersion(i)
+	return cuo
+}
+
+// SetDeletedAt sets the "deleted_at" field.
+func (cuo *CategoryUpdateOne) SetDeletedAt(t time.Time) *CategoryUpdateOne {
+	cuo.mutation.SetDeletedAt(t)
+	return cuo
+}
+
+// SetNillableDeletedAt sets the "deleted_at" field if the given value is not nil.
+func (cuo *CategoryUpdateOne) SetNillableDeletedAt(t *time.Time) *CategoryUpdateOne {
+	if t != nil {
+		cuo.SetDeletedAt(*t)
+	}
+	return cuo
+}
+
+// ClearDeletedAt clears the value of the "deleted_at" field.
+func (cuo *CategoryUpdateOne) ClearDeletedAt() *CategoryUpdateOne {
+	cuo.mutation.ClearDeletedAt()
+	return cuo
+}
+
 // SetName sets the "name" field.
 func (cuo *CategoryUpdateOne) SetName(s string) *CategoryUpdateOne {
 	cuo.mutation.SetName(s)
@@ -203,11 +438,72 @@ func (cuo *CategoryUpdateOne) ClearDescription() *CategoryUpdateOne {
 	return cuo
 }
 
+// SetWorkspaceID sets the "workspace_id" field.
+func (cuo *CategoryUpdateOne) SetWorkspaceID(u uuid.UUID) *CategoryUpdateOne {
+	cuo.mutation.SetWorkspaceID(u)
+	return cuo
+}
+
+// SetNillableWorkspaceID sets the "workspace_id" field if the given value is not nil.
+func (cuo *CategoryUpdateOne) SetNillableWorkspaceID(u *uuid.UUID) *CategoryUpdateOne {
+	if u != nil {
+		cuo.SetWorkspaceID(*u)
+	}
+	return cuo
+}
+
+// SetWorkspace sets the "workspace" edge to the Workspace entity.
+func (cuo *CategoryUpdateOne) SetWorkspace(w *Workspace) *CategoryUpdateOne {
+	return cuo.SetWorkspaceID(w.ID)
+}
+
+// AddDebtIDs adds the "debts" edge to the Debt entity by IDs.
+func (cuo *CategoryUpdateOne) AddDebtIDs(ids ...uuid.UUID) *CategoryUpdateOne {
+	cuo.mutation.AddDebtIDs(ids...)
+	return cuo
+}
+
+// AddDebts adds the "debts" edges to the Debt entity.
+func (cuo *CategoryUpdateOne) AddDebts(d ...*Debt) *CategoryUpdateOne {
+	ids := make([]uuid.UUID, len(d))
+	for i := range d {
+		ids[i] = d[i].ID
+	}
+	return cuo.AddDebtIDs(ids...)
+}
+
 // Mutation returns the CategoryMutation object of the builder.
 func (cuo *CategoryUpdateOne) Mutation() *CategoryMutation {
 	return cuo.mutation
 }
 
+// ClearWorkspace clears the "workspace" edge to the Workspace entity.
+func (cuo *CategoryUpdateOne) ClearWorkspace() *CategoryUpdateOne {
+	cuo.mutation.ClearWorkspace()
+	return cuo
+}
+
+// ClearDebts clears all "debts" edges to the Debt entity.
+func (cuo *CategoryUpdateOne) ClearDebts() *CategoryUpdateOne {
+	cuo.mutation.ClearDebts()
+	return cuo
+}
+
+// RemoveDebtIDs removes the "debts" edge to Debt entities by IDs.
+func (cuo *CategoryUpdateOne) RemoveDebtIDs(ids ...uuid.UUID) *CategoryUpdateOne {
+	cuo.mutation.RemoveDebtIDs(ids...)
+	return cuo
+}
+
+// RemoveDebts removes "debts" edges to Debt entities.
+func (cuo *CategoryUpdateOne) RemoveDebts(d ...*Debt) *CategoryUpdateOne {
+	ids := make([]uuid.UUID, len(d))
+	for i := range d {
+		ids[i] = d[i].ID
+	}
+	return cuo.RemoveDebtIDs(ids...)
+}
+
 // Where appends a list predicates to the CategoryUpdate builder.
 func (cuo *CategoryUpdateOne) Where(ps ...predicate.Category) *CategoryUpdateOne {
 	cuo.mutation.Where(ps...)
@@ -264,6 +560,9 @@ func (cuo *CategoryUpdateOne) check() error {
 			return &ValidationError{Name: "name", err: fmt.Errorf(`ent: validator failed for field "Category.name": %w`, err)}
 		}
 	}
+	if cuo.mutation.WorkspaceCleared() && len(cuo.mutation.WorkspaceIDs()) > 0 {
+		return errors.New(`ent: clearing a required unique edge "Category.workspace"`)
+	}
 	return nil
 }
 
@@ -299,6 +598,18 @@ func (cuo *CategoryUpdateOne) sqlSave(ctx context.Context) (_node *Category, err
 	if value, ok := cuo.mutation.UpdatedAt(); ok {
 		_spec.SetField(category.FieldUpdatedAt, field.TypeTime, value)
 	}
+	if value, ok := cuo.mutation.Version(); ok {
+		_spec.SetField(category.FieldVersion, field.TypeInt, value)
+	}
+	if value, ok := cuo.mutation.AddedVersion(); ok {
+		_spec.AddField(category.FieldVersion, field.TypeInt, value)
+	}
+	if value, ok := cuo.mutation.DeletedAt(); ok {
+		_spec.SetField(category.FieldDeletedAt, field.TypeTime, value)
+	}
+	if cuo.mutation.DeletedAtCleared() {
+		_spec.ClearField(category.FieldDeletedAt, field.TypeTime)
+	}
 	if value, ok := cuo.mutation.Name(); ok {
 		_spec.SetField(category.FieldName, field.TypeString, value)
 	}
@@ -308,6 +619,80 @@ func (cuo *CategoryUpdateOne) sqlSave(ctx context.Context) (_node *Category, err
 	if cuo.mutation.DescriptionCleared() {
 		_spec.ClearField(category.FieldDescription, field.TypeString)
 	}
+	if cuo.mutation.WorkspaceCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: false,
+			Table:   category.WorkspaceTable,
+			Columns: []string{category.WorkspaceColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(workspace.FieldID, field.TypeUUID),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := cuo.mutation.WorkspaceIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: false,
+			Table:   category.WorkspaceTable,
+			Columns: []string{category.WorkspaceColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(workspace.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if cuo.mutation.DebtsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: true,
+			Table:   category.DebtsTable,
+			Columns: []string{category.DebtsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(debt.FieldID, field.TypeUUID),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := cuo.mutation.RemovedDebtsIDs(); len(nodes) > 0 && !cuo.mutation.DebtsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: true,
+			Table:   category.DebtsTable,
+			Columns: []string{category.DebtsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(debt.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := cuo.mutation.DebtsIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: true,
+			Table:   category.DebtsTable,
+			Columns: []string{category.DebtsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(debt.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
 	_node = &Category{config: cuo.config}
 	_spec.Assign = _node.assignValues
 	_spec.ScanValues = _node.scanValues
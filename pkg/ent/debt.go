@@ -7,6 +7,7 @@ import (
 	"backend-go/pkg/ent/debt"
 	"backend-go/pkg/ent/invoice"
 	"backend-go/pkg/ent/paymentstatus"
+	"backend-go/pkg/ent/workspace"
 	"fmt"
 	"strings"
 	"time"
@@ -25,6 +26,10 @@ type Debt struct {
 	CreatedAt time.Time `json:"created_at,omitempty"`
 	// UpdatedAt holds the value of the "updated_at" field.
 	UpdatedAt time.Time `json:"updated_at,omitempty"`
+	// Version holds the value of the "version" field.
+	Version int `json:"version,omitempty"`
+	// DeletedAt holds the value of the "deleted_at" field.
+	DeletedAt *time.Time `json:"deleted_at,omitempty"`
 	// Amount holds the value of the "amount" field.
 	Amount float64 `json:"amount,omitempty"`
 	// Title holds the value of the "title" field.
@@ -33,6 +38,12 @@ type Debt struct {
 	PurchaseDate time.Time `json:"purchase_date,omitempty"`
 	// DueDate holds the value of the "due_date" field.
 	DueDate time.Time `json:"due_date,omitempty"`
+	// WorkspaceID holds the value of the "workspace_id" field.
+	WorkspaceID uuid.UUID `json:"workspace_id,omitempty"`
+	// Kind holds the value of the "kind" field.
+	Kind debt.Kind `json:"kind,omitempty"`
+	// RefundOfID holds the value of the "refund_of_id" field.
+	RefundOfID *uuid.UUID `json:"refund_of_id,omitempty"`
 	// Edges holds the relations/edges for other nodes in the graph.
 	// The values are being populated by the DebtQuery when eager-loading is set.
 	Edges        DebtEdges `json:"edges"`
@@ -50,9 +61,21 @@ type DebtEdges struct {
 	Category *Category `json:"category,omitempty"`
 	// Status holds the value of the status edge.
 	Status *PaymentStatus `json:"status,omitempty"`
+	// Workspace holds the value of the workspace edge.
+	Workspace *Workspace `json:"workspace,omitempty"`
+	// Shares holds the value of the shares edge.
+	Shares []*DebtShare `json:"shares,omitempty"`
+	// Tags holds the value of the tags edge.
+	Tags []*Tag `json:"tags,omitempty"`
+	// Attachments holds the value of the attachments edge.
+	Attachments []*Attachment `json:"attachments,omitempty"`
+	// RefundOf holds the value of the refund_of edge.
+	RefundOf *Debt `json:"refund_of,omitempty"`
+	// Refunds holds the value of the refunds edge.
+	Refunds []*Debt `json:"refunds,omitempty"`
 	// loadedTypes holds the information for reporting if a
 	// type was loaded (or requested) in eager-loading or not.
-	loadedTypes [3]bool
+	loadedTypes [9]bool
 }
 
 // InvoiceOrErr returns the Invoice value or an error if the edge
@@ -88,18 +111,80 @@ func (e DebtEdges) StatusOrErr() (*PaymentStatus, error) {
 	return nil, &NotLoadedError{edge: "status"}
 }
 
+// WorkspaceOrErr returns the Workspace value or an error if the edge
+// was not loaded in eager-loading, or loaded but was not found.
+func (e DebtEdges) WorkspaceOrErr() (*Workspace, error) {
+	if e.Workspace != nil {
+		return e.Workspace, nil
+	} else if e.loadedTypes[3] {
+		return nil, &NotFoundError{label: workspace.Label}
+	}
+	return nil, &NotLoadedError{edge: "workspace"}
+}
+
+// SharesOrErr returns the Shares value or an error if the edge
+// was not loaded in eager-loading.
+func (e DebtEdges) SharesOrErr() ([]*DebtShare, error) {
+	if e.loadedTypes[4] {
+		return e.Shares, nil
+	}
+	return nil, &NotLoadedError{edge: "shares"}
+}
+
+// TagsOrErr returns the Tags value or an error if the edge
+// was not loaded in eager-loading.
+func (e DebtEdges) TagsOrErr() ([]*Tag, error) {
+	if e.loadedTypes[5] {
+		return e.Tags, nil
+	}
+	return nil, &NotLoadedError{edge: "tags"}
+}
+
+// AttachmentsOrErr returns the Attachments value or an error if the edge
+// was not loaded in eager-loading.
+func (e DebtEdges) AttachmentsOrErr() ([]*Attachment, error) {
+	if e.loadedTypes[6] {
+		return e.Attachments, nil
+	}
+	return nil, &NotLoadedError{edge: "attachments"}
+}
+
+// RefundOfOrErr returns the RefundOf value or an error if the edge
+// was not loaded in eager-loading, or loaded but was not found.
+func (e DebtEdges) RefundOfOrErr() (*Debt, error) {
+	if e.RefundOf != nil {
+		return e.RefundOf, nil
+	} else if e.loadedTypes[7] {
+		return nil, &NotFoundError{label: debt.Label}
+	}
+	return nil, &NotLoadedError{edge: "refund_of"}
+}
+
+// RefundsOrErr returns the Refunds value or an error if the edge
+// was not loaded in eager-loading.
+func (e DebtEdges) RefundsOrErr() ([]*Debt, error) {
+	if e.loadedTypes[8] {
+		return e.Refunds, nil
+	}
+	return nil, &NotLoadedError{edge: "refunds"}
+}
+
 // scanValues returns the types for scanning values from sql.Rows.
 func (*Debt) scanValues(columns []string) ([]any, error) {
 	values := make([]any, len(columns))
 	for i := range columns {
 		switch columns[i] {
+		case debt.FieldRefundOfID:
+			values[i] = &sql.NullScanner{S: new(uuid.UUID)}
 		case debt.FieldAmount:
 			values[i] = new(sql.NullFloat64)
-		case debt.FieldTitle:
+		case debt.FieldVersion:
+			values[i] = new(sql.NullInt64)
+		case debt.FieldTitle, debt.FieldKind:
 			values[i] = new(sql.NullString)
-		case debt.FieldCreatedAt, debt.FieldUpdatedAt, debt.FieldPurchaseDate, debt.FieldDueDate:
+		case debt.FieldCreatedAt, debt.FieldUpdatedAt, debt.FieldDeletedAt, debt.FieldPurchaseDate, debt.FieldDueDate:
 			values[i] = new(sql.NullTime)
-		case debt.FieldID:
+		case debt.FieldID, debt.FieldWorkspaceID:
 			values[i] = new(uuid.UUID)
 		case debt.ForeignKeys[0]: // invoice_id
 			values[i] = &sql.NullScanner{S: new(uuid.UUID)}
@@ -140,6 +225,19 @@ func (d *Debt) assignValues(columns []string, values []any) error {
 			} else if value.Valid {
 				d.UpdatedAt = value.Time
 			}
+		case debt.FieldVersion:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field version", values[i])
+			} else if value.Valid {
+				d.Version = int(value.Int64)
+			}
+		case debt.FieldDeletedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field deleted_at", values[i])
+			} else if value.Valid {
+				d.DeletedAt = new(time.Time)
+				*d.DeletedAt = value.Time
+			}
 		case debt.FieldAmount:
 			if value, ok := values[i].(*sql.NullFloat64); !ok {
 				return fmt.Errorf("unexpected type %T for field amount", values[i])
@@ -164,6 +262,25 @@ func (d *Debt) assignValues(columns []string, values []any) error {
 			} else if value.Valid {
 				d.DueDate = value.Time
 			}
+		case debt.FieldWorkspaceID:
+			if value, ok := values[i].(*uuid.UUID); !ok {
+				return fmt.Errorf("unexpected type %T for field workspace_id", values[i])
+			} else if value != nil {
+				d.WorkspaceID = *value
+			}
+		case debt.FieldKind:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field kind", values[i])
+			} else if value.Valid {
+				d.Kind = debt.Kind(value.String)
+			}
+		case debt.FieldRefundOfID:
+			if value, ok := values[i].(*sql.NullScanner); !ok {
+				return fmt.Errorf("unexpected type %T for field refund_of_id", values[i])
+			} else if value.Valid {
+				d.RefundOfID = new(uuid.UUID)
+				*d.RefundOfID = *value.S.(*uuid.UUID)
+			}
 		case debt.ForeignKeys[0]:
 			if value, ok := values[i].(*sql.NullScanner); !ok {
 				return fmt.Errorf("unexpected type %T for field invoice_id", values[i])
@@ -213,6 +330,36 @@ func (d *Debt) QueryStatus() *PaymentStatusQuery {
 	return NewDebtClient(d.config).QueryStatus(d)
 }
 
+// QueryWorkspace queries the "workspace" edge of the Debt entity.
+func (d *Debt) QueryWorkspace() *WorkspaceQuery {
+	return NewDebtClient(d.config).QueryWorkspace(d)
+}
+
+// QueryShares queries the "shares" edge of the Debt entity.
+func (d *Debt) QueryShares() *DebtShareQuery {
+	return NewDebtClient(d.config).QueryShares(d)
+}
+
+// QueryTags queries the "tags" edge of the Debt entity.
+func (d *Debt) QueryTags() *TagQuery {
+	return NewDebtClient(d.config).QueryTags(d)
+}
+
+// QueryAttachments queries the "attachments" edge of the Debt entity.
+func (d *Debt) QueryAttachments() *AttachmentQuery {
+	return NewDebtClient(d.config).QueryAttachments(d)
+}
+
+// QueryRefundOf queries the "refund_of" edge of the Debt entity.
+func (d *Debt) QueryRefundOf() *DebtQuery {
+	return NewDebtClient(d.config).QueryRefundOf(d)
+}
+
+// QueryRefunds queries the "refunds" edge of the Debt entity.
+func (d *Debt) QueryRefunds() *DebtQuery {
+	return NewDebtClient(d.config).QueryRefunds(d)
+}
+
 // Update returns a builder for updating this Debt.
 // Note that you need to call Debt.Unwrap() before calling this method if this Debt
 // was returned from a transaction, and the transaction was committed or rolled back.
@@ -242,6 +389,14 @@ func (d *Debt) String() string {
 	builder.WriteString("updated_at=")
 	builder.WriteString(d.UpdatedAt.Format(time.ANSIC))
 	builder.WriteString(", ")
+	builder.WriteString("version=")
+	builder.WriteString(fmt.Sprintf("%v", d.Version))
+	builder.WriteString(", ")
+	if v := d.DeletedAt; v != nil {
+		builder.WriteString("deleted_at=")
+		builder.WriteString(v.Format(time.ANSIC))
+	}
+	builder.WriteString(", ")
 	builder.WriteString("amount=")
 	builder.WriteString(fmt.Sprintf("%v", d.Amount))
 	builder.WriteString(", ")
@@ -253,6 +408,17 @@ func (d *Debt) String() string {
 	builder.WriteString(", ")
 	builder.WriteString("due_date=")
 	builder.WriteString(d.DueDate.Format(time.ANSIC))
+	builder.WriteString(", ")
+	builder.WriteString("workspace_id=")
+	builder.WriteString(fmt.Sprintf("%v", d.WorkspaceID))
+	builder.WriteString(", ")
+	builder.WriteString("kind=")
+	builder.WriteString(fmt.Sprintf("%v", d.Kind))
+	builder.WriteString(", ")
+	if v := d.RefundOfID; v != nil {
+		builder.WriteString("refund_of_id=")
+		builder.WriteString(fmt.Sprintf("%v", *v))
+	}
 	builder.WriteByte(')')
 	return builder.String()
 }
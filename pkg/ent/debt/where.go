@@ -66,6 +66,16 @@ func UpdatedAt(v time.Time) predicate.Debt {
 	return predicate.Debt(sql.FieldEQ(FieldUpdatedAt, v))
 }
 
+// Version applies equality check predicate on the "version" field. It's identical to VersionEQ.
+func Version(v int) predicate.Debt {
+	return predicate.Debt(sql.FieldEQ(FieldVersion, v))
+}
+
+// DeletedAt applies equality check predicate on the "deleted_at" field. It's identical to DeletedAtEQ.
+func DeletedAt(v time.Time) predicate.Debt {
+	return predicate.Debt(sql.FieldEQ(FieldDeletedAt, v))
+}
+
 // Amount applies equality check predicate on the "amount" field. It's identical to AmountEQ.
 func Amount(v float64) predicate.Debt {
 	return predicate.Debt(sql.FieldEQ(FieldAmount, v))
@@ -86,6 +96,16 @@ func DueDate(v time.Time) predicate.Debt {
 	return predicate.Debt(sql.FieldEQ(FieldDueDate, v))
 }
 
+// WorkspaceID applies equality check predicate on the "workspace_id" field. It's identical to WorkspaceIDEQ.
+func WorkspaceID(v uuid.UUID) predicate.Debt {
+	return predicate.Debt(sql.FieldEQ(FieldWorkspaceID, v))
+}
+
+// RefundOfID applies equality check predicate on the "refund_of_id" field. It's identical to RefundOfIDEQ.
+func RefundOfID(v uuid.UUID) predicate.Debt {
+	return predicate.Debt(sql.FieldEQ(FieldRefundOfID, v))
+}
+
 // CreatedAtEQ applies the EQ predicate on the "created_at" field.
 func CreatedAtEQ(v time.Time) predicate.Debt {
 	return predicate.Debt(sql.FieldEQ(FieldCreatedAt, v))
@@ -166,6 +186,96 @@ func UpdatedAtLTE(v time.Time) predicate.Debt {
 	return predicate.Debt(sql.FieldLTE(FieldUpdatedAt, v))
 }
 
+// VersionEQ applies the EQ predicate on the "version" field.
+func VersionEQ(v int) predicate.Debt {
+	return predicate.Debt(sql.FieldEQ(FieldVersion, v))
+}
+
+// VersionNEQ applies the NEQ predicate on the "version" field.
+func VersionNEQ(v int) predicate.Debt {
+	return predicate.Debt(sql.FieldNEQ(FieldVersion, v))
+}
+
+// VersionIn applies the In predicate on the "version" field.
+func VersionIn(vs ...int) predicate.Debt {
+	return predicate.Debt(sql.FieldIn(FieldVersion, vs...))
+}
+
+// VersionNotIn applies the NotIn predicate on the "version" field.
+func VersionNotIn(vs ...int) predicate.Debt {
+	return predicate.Debt(sql.FieldNotIn(FieldVersion, vs...))
+}
+
+// VersionGT applies the GT predicate on the "version" field.
+func VersionGT(v int) predicate.Debt {
+	return predicate.Debt(sql.FieldGT(FieldVersion, v))
+}
+
+// VersionGTE applies the GTE predicate on the "version" field.
+func VersionGTE(v int) predicate.Debt {
+	return predicate.Debt(sql.FieldGTE(FieldVersion, v))
+}
+
+// VersionLT applies the LT predicate on the "version" field.
+func VersionLT(v int) predicate.Debt {
+	return predicate.Debt(sql.FieldLT(FieldVersion, v))
+}
+
+// VersionLTE applies the LTE predicate on the "version" field.
+func VersionLTE(v int) predicate.Debt {
+	return predicate.Debt(sql.FieldLTE(FieldVersion, v))
+}
+
+// DeletedAtEQ applies the EQ predicate on the "deleted_at" field.
+func DeletedAtEQ(v time.Time) predicate.Debt {
+	return predicate.Debt(sql.FieldEQ(FieldDeletedAt, v))
+}
+
+// DeletedAtNEQ applies the NEQ predicate on the "deleted_at" field.
+func DeletedAtNEQ(v time.Time) predicate.Debt {
+	return predicate.Debt(sql.FieldNEQ(FieldDeletedAt, v))
+}
+
+// DeletedAtIn applies the In predicate on the "deleted_at" field.
+func DeletedAtIn(vs ...time.Time) predicate.Debt {
+	return predicate.Debt(sql.FieldIn(FieldDeletedAt, vs...))
+}
+
+// DeletedAtNotIn applies the NotIn predicate on the "deleted_at" field.
+func DeletedAtNotIn(vs ...time.Time) predicate.Debt {
+	return predicate.Debt(sql.FieldNotIn(FieldDeletedAt, vs...))
+}
+
+// DeletedAtGT applies the GT predicate on the "deleted_at" field.
+func DeletedAtGT(v time.Time) predicate.Debt {
+	return predicate.Debt(sql.FieldGT(FieldDeletedAt, v))
+}
+
+// DeletedAtGTE applies the GTE predicate on the "deleted_at" field.
+func DeletedAtGTE(v time.Time) predicate.Debt {
+	return predicate.Debt(sql.FieldGTE(FieldDeletedAt, v))
+}
+
+// DeletedAtLT applies the LT predicate on the "deleted_at" field.
+func DeletedAtLT(v time.Time) predicate.Debt {
+	return predicate.Debt(sql.FieldLT(FieldDeletedAt, v))
+}
+
+// DeletedAtLTE applies the LTE predicate on the "deleted_at" field.
+func DeletedAtLTE(v time.Time) predicate.Debt {
+	return predicate.Debt(sql.FieldLTE(FieldDeletedAt, v))
+}
+
+// DeletedAtIsNil applies the IsNil predicate on the "deleted_at" field.
+func DeletedAtIsNil() predicate.Debt {
+	return predicate.Debt(sql.FieldIsNull(FieldDeletedAt))
+}
+
+// DeletedAtNotNil applies the NotNil predicate on the "deleted_at" field.
+func DeletedAtNotNil() predicate.Debt {
+	return predicate.Debt(sql.FieldNotNull(FieldDeletedAt))
+}
+
 // AmountEQ applies the EQ predicate on the "amount" field.
 func AmountEQ(v float64) predicate.Debt {
 	return predicate.Debt(sql.FieldEQ(FieldAmount, v))
@@ -351,6 +461,76 @@ func DueDateLTE(v time.Time) predicate.Debt {
 	return predicate.Debt(sql.FieldLTE(FieldDueDate, v))
 }
 
+// WorkspaceIDEQ applies the EQ predicate on the "workspace_id" field.
+func WorkspaceIDEQ(v uuid.UUID) predicate.Debt {
+	return predicate.Debt(sql.FieldEQ(FieldWorkspaceID, v))
+}
+
+// WorkspaceIDNEQ applies the NEQ predicate on the "workspace_id" field.
+func WorkspaceIDNEQ(v uuid.UUID) predicate.Debt {
+	return predicate.Debt(sql.FieldNEQ(FieldWorkspaceID, v))
+}
+
+// WorkspaceIDIn applies the In predicate on the "workspace_id" field.
+func WorkspaceIDIn(vs ...uuid.UUID) predicate.Debt {
+	return predicate.Debt(sql.FieldIn(FieldWorkspaceID, vs...))
+}
+
+// WorkspaceIDNotIn applies the NotIn predicate on the "workspace_id" field.
+func WorkspaceIDNotIn(vs ...uuid.UUID) predicate.Debt {
+	return predicate.Debt(sql.FieldNotIn(FieldWorkspaceID, vs...))
+}
+
+// KindEQ applies the EQ predicate on the "kind" field.
+func KindEQ(v Kind) predicate.Debt {
+	return predicate.Debt(sql.FieldEQ(FieldKind, v))
+}
+
+// KindNEQ applies the NEQ predicate on the "kind" field.
+func KindNEQ(v Kind) predicate.Debt {
+	return predicate.Debt(sql.FieldNEQ(FieldKind, v))
+}
+
+// KindIn applies the In predicate on the "kind" field.
+func KindIn(vs ...Kind) predicate.Debt {
+	return predicate.Debt(sql.FieldIn(FieldKind, vs...))
+}
+
+// KindNotIn applies the NotIn predicate on the "kind" field.
+func KindNotIn(vs ...Kind) predicate.Debt {
+	return predicate.Debt(sql.FieldNotIn(FieldKind, vs...))
+}
+
+// RefundOfIDEQ applies the EQ predicate on the "refund_of_id" field.
+func RefundOfIDEQ(v uuid.UUID) predicate.Debt {
+	return predicate.Debt(sql.FieldEQ(FieldRefundOfID, v))
+}
+
+// RefundOfIDNEQ applies the NEQ predicate on the "refund_of_id" field.
+func RefundOfIDNEQ(v uuid.UUID) predicate.Debt {
+	return predicate.Debt(sql.FieldNEQ(FieldRefundOfID, v))
+}
+
+// RefundOfIDIn applies the In predicate on the "refund_of_id" field.
+func RefundOfIDIn(vs ...uuid.UUID) predicate.Debt {
+	return predicate.Debt(sql.FieldIn(FieldRefundOfID, vs...))
+}
+
+// RefundOfIDNotIn applies the NotIn predicate on the "refund_of_id" field.
+func RefundOfIDNotIn(vs ...uuid.UUID) predicate.Debt {
+	return predicate.Debt(sql.FieldNotIn(FieldRefundOfID, vs...))
+}
+
+// RefundOfIDIsNil applies the IsNil predicate on the "refund_of_id" field.
+func RefundOfIDIsNil() predicate.Debt {
+	return predicate.Debt(sql.FieldIsNull(FieldRefundOfID))
+}
+
+// RefundOfIDNotNil applies the NotNil predicate on the "refund_of_id" field.
+func RefundOfIDNotNil() predicate.Debt {
+	return predicate.Debt(sql.FieldNotNull(FieldRefundOfID))
+}
+
 // HasInvoice applies the HasEdge predicate on the "invoice" edge.
 func HasInvoice() predicate.Debt {
 	return predicate.Debt(func(s *sql.Selector) {
@@ -420,6 +600,144 @@ func HasStatusWith(preds ...predicate.PaymentStatus) predicate.Debt {
 	})
 }
 
+// HasWorkspace applies the HasEdge predicate on the "workspace" edge.
+func HasWorkspace() predicate.Debt {
+	return predicate.Debt(func(s *sql.Selector) {
+		step := sqlgraph.NewStep(
+			sqlgraph.From(Table, FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, false, WorkspaceTable, WorkspaceColumn),
+		)
+		sqlgraph.HasNeighbors(s, step)
+	})
+}
+
+// HasWorkspaceWith applies the HasEdge predicate on the "workspace" edge with a given conditions (other predicates).
+func HasWorkspaceWith(preds ...predicate.Workspace) predicate.Debt {
+	return predicate.Debt(func(s *sql.Selector) {
+		step := newWorkspaceStep()
+		sqlgraph.HasNeighborsWith(s, step, func(s *sql.Selector) {
+			for _, p := range preds {
+				p(s)
+			}
+		})
+	})
+}
+
+// HasShares applies the HasEdge predicate on the "shares" edge.
+func HasShares() predicate.Debt {
+	return predicate.Debt(func(s *sql.Selector) {
+		step := sqlgraph.NewStep(
+			sqlgraph.From(Table, FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, SharesTable, SharesColumn),
+		)
+		sqlgraph.HasNeighbors(s, step)
+	})
+}
+
+// HasSharesWith applies the HasEdge predicate on the "shares" edge with a given conditions (other predicates).
+func HasSharesWith(preds ...predicate.DebtShare) predicate.Debt {
+	return predicate.Debt(func(s *sql.Selector) {
+		step := newSharesStep()
+		sqlgraph.HasNeighborsWith(s, step, func(s *sql.Selector) {
+			for _, p := range preds {
+				p(s)
+			}
+		})
+	})
+}
+
+// HasTags applies the HasEdge predicate on the "tags" edge.
+func HasTags() predicate.Debt {
+	return predicate.Debt(func(s *sql.Selector) {
+		step := sqlgraph.NewStep(
+			sqlgraph.From(Table, FieldID),
+			sqlgraph.Edge(sqlgraph.M2M, false, TagsTable, TagsPrimaryKey...),
+		)
+		sqlgraph.HasNeighbors(s, step)
+	})
+}
+
+// HasTagsWith applies the HasEdge predicate on the "tags" edge with a given conditions (other predicates).
+func HasTagsWith(preds ...predicate.Tag) predicate.Debt {
+	return predicate.Debt(func(s *sql.Selector) {
+		step := newTagsStep()
+		sqlgraph.HasNeighborsWith(s, step, func(s *sql.Selector) {
+			for _, p := range preds {
+				p(s)
+			}
+		})
+	})
+}
+
+// HasAttachments applies the HasEdge predicate on the "attachments" edge.
+func HasAttachments() predicate.Debt {
+	return predicate.Debt(func(s *sql.Selector) {
+		step := sqlgraph.NewStep(
+			sqlgraph.From(Table, FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, AttachmentsTable, AttachmentsColumn),
+		)
+		sqlgraph.HasNeighbors(s, step)
+	})
+}
+
+// HasAttachmentsWith applies the HasEdge predicate on the "attachments" edge with a given conditions (other predicates).
+func HasAttachmentsWith(preds ...predicate.Attachment) predicate.Debt {
+	return predicate.Debt(func(s *sql.Selector) {
+		step := newAttachmentsStep()
+		sqlgraph.HasNeighborsWith(s, step, func(s *sql.Selector) {
+			for _, p := range preds {
+				p(s)
+			}
+		})
+	})
+}
+
+// HasRefundOf applies the HasEdge predicate on the "refund_of" edge.
+func HasRefundOf() predicate.Debt {
+	return predicate.Debt(func(s *sql.Selector) {
+		step := sqlgraph.NewStep(
+			sqlgraph.From(Table, FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, RefundOfTable, RefundOfColumn),
+		)
+		sqlgraph.HasNeighbors(s, step)
+	})
+}
+
+// HasRefundOfWith applies the HasEdge predicate on the "refund_of" edge with a given conditions (other predicates).
+func HasRefundOfWith(preds ...predicate.Debt) predicate.Debt {
+	return predicate.Debt(func(s *sql.Selector) {
+		step := newRefundOfStep()
+		sqlgraph.HasNeighborsWith(s, step, func(s *sql.Selector) {
+			for _, p := range preds {
+				p(s)
+			}
+		})
+	})
+}
+
+// HasRefunds applies the HasEdge predicate on the "refunds" edge.
+func HasRefunds() predicate.Debt {
+	return predicate.Debt(func(s *sql.Selector) {
+		step := sqlgraph.NewStep(
+			sqlgraph.From(Table, FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, RefundsTable, RefundsColumn),
+		)
+		sqlgraph.HasNeighbors(s, step)
+	})
+}
+
+// HasRefundsWith applies the HasEdge predicate on the "refunds" edge with a given conditions (other predicates).
+func HasRefundsWith(preds ...predicate.Debt) predicate.Debt {
+	return predicate.Debt(func(s *sql.Selector) {
+		step := newRefundsStep()
+		sqlgraph.HasNeighborsWith(s, step, func(s *sql.Selector) {
+			for _, p := range preds {
+				p(s)
+			}
+		})
+	})
+}
+
 // And groups predicates with the AND operator between them.
 func And(predicates ...predicate.Debt) predicate.Debt {
 	return predicate.Debt(sql.AndPredicates(predicates...))
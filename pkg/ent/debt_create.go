@@ -3,10 +3,14 @@
 package ent
 
 import (
+	"backend-go/pkg/ent/attachment"
 	"backend-go/pkg/ent/category"
 	"backend-go/pkg/ent/debt"
+	"backend-go/pkg/ent/debtshare"
 	"backend-go/pkg/ent/invoice"
 	"backend-go/pkg/ent/paymentstatus"
+	"backend-go/pkg/ent/tag"
+	"backend-go/pkg/ent/workspace"
 	"context"
 	"errors"
 	"fmt"
@@ -52,6 +56,34 @@ func (dc *DebtCreate) SetNillableUpdatedAt(t *time.Time) *DebtCreate {
 	return dc
 }
 
+// SetVersion sets the "version" field.
+func (dc *DebtCreate) SetVersion(i int) *DebtCreate {
+	dc.mutation.SetVersion(i)
+	return dc
+}
+
+// SetNillableVersion sets the "version" field if the given value is not nil.
+func (dc *DebtCreate) SetNillableVersion(i *int) *DebtCreate {
+	if i != nil {
+		dc.SetVersion(*i)
+	}
+	return dc
+}
+
+// SetDeletedAt sets the "deleted_at" field.
+func (dc *DebtCreate) SetDeletedAt(t time.Time) *DebtCreate {
+	dc.mutation.SetDeletedAt(t)
+	return dc
+}
+
+// SetNillableDeletedAt sets the "deleted_at" field if the given value is not nil.
+func (dc *DebtCreate) SetNillableDeletedAt(t *time.Time) *DebtCreate {
+	if t != nil {
+		dc.SetDeletedAt(*t)
+	}
+	return dc
+}
+
 // SetAmount sets the "amount" field.
 func (dc *DebtCreate) SetAmount(f float64) *DebtCreate {
 	dc.mutation.SetAmount(f)
@@ -76,6 +108,40 @@ func (dc *DebtCreate) SetDueDate(t time.Time) *DebtCreate {
 	return dc
 }
 
+// SetWorkspaceID sets the "workspace_id" field.
+func (dc *DebtCreate) SetWorkspaceID(u uuid.UUID) *DebtCreate {
+	dc.mutation.SetWorkspaceID(u)
+	return dc
+}
+
+// SetKind sets the "kind" field.
+func (dc *DebtCreate) SetKind(d debt.Kind) *DebtCreate {
+	dc.mutation.SetKind(d)
+	return dc
+}
+
+// SetNillableKind sets the "kind" field if the given value is not nil.
+func (dc *DebtCreate) SetNillableKind(d *debt.Kind) *DebtCreate {
+	if d != nil {
+		dc.SetKind(*d)
+	}
+	return dc
+}
+
+// SetRefundOfID sets the "refund_of_id" field.
+func (dc *DebtCreate) SetRefundOfID(u uuid.UUID) *DebtCreate {
+	dc.mutation.SetRefundOfID(u)
+	return dc
+}
+
+// SetNillableRefundOfID sets the "refund_of_id" field if the given value is not nil.
+func (dc *DebtCreate) SetNillableRefundOfID(u *uuid.UUID) *DebtCreate {
+	if u != nil {
+		dc.SetRefundOfID(*u)
+	}
+	return dc
+}
+
 // SetID sets the "id" field.
 func (dc *DebtCreate) SetID(u uuid.UUID) *DebtCreate {
 	dc.mutation.SetID(u)
@@ -147,6 +213,76 @@ func (dc *DebtCreate) SetStatus(p *PaymentStatus) *DebtCreate {
 	return dc.SetStatusID(p.ID)
 }
 
+// SetWorkspace sets the "workspace" edge to the Workspace entity.
+func (dc *DebtCreate) SetWorkspace(w *Workspace) *DebtCreate {
+	return dc.SetWorkspaceID(w.ID)
+}
+
+// AddShareIDs adds the "shares" edge to the DebtShare entity by IDs.
+func (dc *DebtCreate) AddShareIDs(ids ...uuid.UUID) *DebtCreate {
+	dc.mutation.AddShareIDs(ids...)
+	return dc
+}
+
+// AddShares adds the "shares" edges to the DebtShare entity.
+func (dc *DebtCreate) AddShares(d ...*DebtShare) *DebtCreate {
+	ids := make([]uuid.UUID, len(d))
+	for i := range d {
+		ids[i] = d[i].ID
+	}
+	return dc.AddShareIDs(ids...)
+}
+
+// AddTagIDs adds the "tags" edge to the Tag entity by IDs.
+func (dc *DebtCreate) AddTagIDs(ids ...uuid.UUID) *DebtCreate {
+	dc.mutation.AddTagIDs(ids...)
+	return dc
+}
+
+// AddTags adds the "tags" edges to the Tag entity.
+func (dc *DebtCreate) AddTags(t ...*Tag) *DebtCreate {
+	ids := make([]uuid.UUID, len(t))
+	for i := range t {
+		ids[i] = t[i].ID
+	}
+	return dc.AddTagIDs(ids...)
+}
+
+// AddAttachmentIDs adds the "attachments" edge to the Attachment entity by IDs.
+func (dc *DebtCreate) AddAttachmentIDs(ids ...uuid.UUID) *DebtCreate {
+	dc.mutation.AddAttachmentIDs(ids...)
+	return dc
+}
+
+// AddAttachments adds the "attachments" edges to the Attachment entity.
+func (dc *DebtCreate) AddAttachments(a ...*Attachment) *DebtCreate {
+	ids := make([]uuid.UUID, len(a))
+	for i := range a {
+		ids[i] = a[i].ID
+	}
+	return dc.AddAttachmentIDs(ids...)
+}
+
+// SetRefundOf sets the "refund_of" edge to the Debt entity.
+func (dc *DebtCreate) SetRefundOf(d *Debt) *DebtCreate {
+	return dc.SetRefundOfID(d.ID)
+}
+
+// AddRefundIDs adds the "refunds" edge to the Debt entity by IDs.
+func (dc *DebtCreate) AddRefundIDs(ids ...uuid.UUID) *DebtCreate {
+	dc.mutation.AddRefundIDs(ids...)
+	return dc
+}
+
+// AddRefunds adds the "refunds" edges to the Debt entity.
+func (dc *DebtCreate) AddRefunds(d ...*Debt) *DebtCreate {
+	ids := make([]uuid.UUID, len(d))
+	for i := range d {
+		ids[i] = d[i].ID
+	}
+	return dc.AddRefundIDs(ids...)
+}
+
 // Mutation returns the DebtMutation object of the builder.
 func (dc *DebtCreate) Mutation() *DebtMutation {
 	return dc.mutation
@@ -190,6 +326,14 @@ func (dc *DebtCreate) defaults() {
 		v := debt.DefaultUpdatedAt()
 		dc.mutation.SetUpdatedAt(v)
 	}
+	if _, ok := dc.mutation.Version(); !ok {
+		v := debt.DefaultVersion
+		dc.mutation.SetVersion(v)
+	}
+	if _, ok := dc.mutation.Kind(); !ok {
+		v := debt.DefaultKind
+		dc.mutation.SetKind(v)
+	}
 	if _, ok := dc.mutation.ID(); !ok {
 		v := debt.DefaultID()
 		dc.mutation.SetID(v)
@@ -204,6 +348,9 @@ func (dc *DebtCreate) check() error {
 	if _, ok := dc.mutation.UpdatedAt(); !ok {
 		return &ValidationError{Name: "updated_at", err: errors.New(`ent: missing required field "Debt.updated_at"`)}
 	}
+	if _, ok := dc.mutation.Version(); !ok {
+		return &ValidationError{Name: "version", err: errors.New(`ent: missing required field "Debt.version"`)}
+	}
 	if _, ok := dc.mutation.Amount(); !ok {
 		return &ValidationError{Name: "amount", err: errors.New(`ent: missing required field "Debt.amount"`)}
 	}
@@ -221,6 +368,20 @@ func (dc *DebtCreate) check() error {
 	if _, ok := dc.mutation.DueDate(); !ok {
 		return &ValidationError{Name: "due_date", err: errors.New(`ent: missing required field "Debt.due_date"`)}
 	}
+	if _, ok := dc.mutation.WorkspaceID(); !ok {
+		return &ValidationError{Name: "workspace_id", err: errors.New(`ent: missing required field "Debt.workspace_id"`)}
+	}
+	if _, ok := dc.mutation.Kind(); !ok {
+		return &ValidationError{Name: "kind", err: errors.New(`ent: missing required field "Debt.kind"`)}
+	}
+	if v, ok := dc.mutation.Kind(); ok {
+		if err := debt.KindValidator(v); err != nil {
+			return &ValidationError{Name: "kind", err: fmt.Errorf(`ent: validator failed for field "Debt.kind": %w`, err)}
+		}
+	}
+	if len(dc.mutation.WorkspaceIDs()) == 0 {
+		return &ValidationError{Name: "workspace", err: errors.New(`ent: missing required edge "Debt.workspace"`)}
+	}
 	return nil
 }
 
@@ -264,6 +425,14 @@ func (dc *DebtCreate) createSpec() (*Debt, *sqlgraph.CreateSpec) {
 		_spec.SetField(debt.FieldUpdatedAt, field.TypeTime, value)
 		_node.UpdatedAt = value
 	}
+	if value, ok := dc.mutation.Version(); ok {
+		_spec.SetField(debt.FieldVersion, field.TypeInt, value)
+		_node.Version = value
+	}
+	if value, ok := dc.mutation.DeletedAt(); ok {
+		_spec.SetField(debt.FieldDeletedAt, field.TypeTime, value)
+		_node.DeletedAt = &value
+	}
 	if value, ok := dc.mutation.Amount(); ok {
 		_spec.SetField(debt.FieldAmount, field.TypeFloat64, value)
 		_node.Amount = value
@@ -280,6 +449,10 @@ func (dc *DebtCreate) createSpec() (*Debt, *sqlgraph.CreateSpec) {
 		_spec.SetField(debt.FieldDueDate, field.TypeTime, value)
 		_node.DueDate = value
 	}
+	if value, ok := dc.mutation.Kind(); ok {
+		_spec.SetField(debt.FieldKind, field.TypeEnum, value)
+		_node.Kind = value
+	}
 	if nodes := dc.mutation.InvoiceIDs(); len(nodes) > 0 {
 		edge := &sqlgraph.EdgeSpec{
 			Rel:     sqlgraph.M2O,
@@ -331,6 +504,104 @@ func (dc *DebtCreate) createSpec() (*Debt, *sqlgraph.CreateSpec) {
 		_node.status_id = &nodes[0]
 		_spec.Edges = append(_spec.Edges, edge)
 	}
+	if nodes := dc.mutation.WorkspaceIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: false,
+			Table:   debt.WorkspaceTable,
+			Columns: []string{debt.WorkspaceColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(workspace.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_node.WorkspaceID = nodes[0]
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	if nodes := dc.mutation.SharesIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   debt.SharesTable,
+			Columns: []string{debt.SharesColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(debtshare.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	if nodes := dc.mutation.TagsIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2M,
+			Inverse: false,
+			Table:   debt.TagsTable,
+			Columns: debt.TagsPrimaryKey,
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(tag.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	if nodes := dc.mutation.AttachmentsIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   debt.AttachmentsTable,
+			Columns: []string{debt.AttachmentsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(attachment.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	if nodes := dc.mutation.RefundOfIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   debt.RefundOfTable,
+			Columns: []string{debt.RefundOfColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(debt.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_node.RefundOfID = &nodes[0]
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	if nodes := dc.mutation.RefundsIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   debt.RefundsTable,
+			Columns: []string{debt.RefundsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(debt.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges = append(_spec.Edges, edge)
+	}
 	return _node, _spec
 }
 
@@ -3,12 +3,17 @@
 package ent
 
 import (
+	"backend-go/pkg/ent/attachment"
 	"backend-go/pkg/ent/category"
 	"backend-go/pkg/ent/debt"
+	"backend-go/pkg/ent/debtshare"
 	"backend-go/pkg/ent/invoice"
 	"backend-go/pkg/ent/paymentstatus"
 	"backend-go/pkg/ent/predicate"
+	"backend-go/pkg/ent/tag"
+	"backend-go/pkg/ent/workspace"
 	"context"
+	"database/sql/driver"
 	"fmt"
 	"math"
 
@@ -22,14 +27,20 @@ import (
 // DebtQuery is the builder for querying Debt entities.
 type DebtQuery struct {
 	config
-	ctx          *QueryContext
-	order        []debt.OrderOption
-	inters       []Interceptor
-	predicates   []predicate.Debt
-	withInvoice  *InvoiceQuery
-	withCategory *CategoryQuery
-	withStatus   *PaymentStatusQuery
-	withFKs      bool
+	ctx             *QueryContext
+	order           []debt.OrderOption
+	inters          []Interceptor
+	predicates      []predicate.Debt
+	withInvoice     *InvoiceQuery
+	withCategory    *CategoryQuery
+	withStatus      *PaymentStatusQuery
+	withWorkspace   *WorkspaceQuery
+	withShares      *DebtShareQuery
+	withTags        *TagQuery
+	withAttachments *AttachmentQuery
+	withRefundOf    *DebtQuery
+	withRefunds     *DebtQuery
+	withFKs         bool
 	// intermediate query (i.e. traversal path).
 	sql  *sql.Selector
 	path func(context.Context) (*sql.Selector, error)
@@ -132,6 +143,138 @@ func (dq *DebtQuery) QueryStatus() *PaymentStatusQuery {
 	return query
 }
 
+// QueryWorkspace chains the current query on the "workspace" edge.
+func (dq *DebtQuery) QueryWorkspace() *WorkspaceQuery {
+	query := (&WorkspaceClient{config: dq.config}).Query()
+	query.path = func(ctx context.Context) (fromU *sql.Selector, err error) {
+		if err := dq.prepareQuery(ctx); err != nil {
+			return nil, err
+		}
+		selector := dq.sqlQuery(ctx)
+		if err := selector.Err(); err != nil {
+			return nil, err
+		}
+		step := sqlgraph.NewStep(
+			sqlgraph.From(debt.Table, debt.FieldID, selector),
+			sqlgraph.To(workspace.Table, workspace.FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, false, debt.WorkspaceTable, debt.WorkspaceColumn),
+		)
+		fromU = sqlgraph.SetNeighbors(dq.driver.Dialect(), step)
+		return fromU, nil
+	}
+	return query
+}
+
+// QueryShares chains the current query on the "shares" edge.
+func (dq *DebtQuery) QueryShares() *DebtShareQuery {
+	query := (&DebtShareClient{config: dq.config}).Query()
+	query.path = func(ctx context.Context) (fromU *sql.Selector, err error) {
+		if err := dq.prepareQuery(ctx); err != nil {
+			return nil, err
+		}
+		selector := dq.sqlQuery(ctx)
+		if err := selector.Err(); err != nil {
+			return nil, err
+		}
+		step := sqlgraph.NewStep(
+			sqlgraph.From(debt.Table, debt.FieldID, selector),
+			sqlgraph.To(debtshare.Table, debtshare.FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, debt.SharesTable, debt.SharesColumn),
+		)
+		fromU = sqlgraph.SetNeighbors(dq.driver.Dialect(), step)
+		return fromU, nil
+	}
+	return query
+}
+
+// QueryTags chains the current query on the "tags" edge.
+func (dq *DebtQuery) QueryTags() *TagQuery {
+	query := (&TagClient{config: dq.config}).Query()
+	query.path = func(ctx context.Context) (fromU *sql.Selector, err error) {
+		if err := dq.prepareQuery(ctx); err != nil {
+			return nil, err
+		}
+		selector := dq.sqlQuery(ctx)
+		if err := selector.Err(); err != nil {
+			return nil, err
+		}
+		step := sqlgraph.NewStep(
+			sqlgraph.From(debt.Table, debt.FieldID, selector),
+			sqlgraph.To(tag.Table, tag.FieldID),
+			sqlgraph.Edge(sqlgraph.M2M, false, debt.TagsTable, debt.TagsPrimaryKey...),
+		)
+		fromU = sqlgraph.SetNeighbors(dq.driver.Dialect(), step)
+		return fromU, nil
+	}
+	return query
+}
+
+// QueryAttachments chains the current query on the "attachments" edge.
+func (dq *DebtQuery) QueryAttachments() *AttachmentQuery {
+	query := (&AttachmentClient{config: dq.config}).Query()
+	query.path = func(ctx context.Context) (fromU *sql.Selector, err error) {
+		if err := dq.prepareQuery(ctx); err != nil {
+			return nil, err
+		}
+		selector := dq.sqlQuery(ctx)
+		if err := selector.Err(); err != nil {
+			return nil, err
+		}
+		step := sqlgraph.NewStep(
+			sqlgraph.From(debt.Table, debt.FieldID, selector),
+			sqlgraph.To(attachment.Table, attachment.FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, debt.AttachmentsTable, debt.AttachmentsColumn),
+		)
+		fromU = sqlgraph.SetNeighbors(dq.driver.Dialect(), step)
+		return fromU, nil
+	}
+	return query
+}
+
+// QueryRefundOf chains the current query on the "refund_of" edge.
+func (dq *DebtQuery) QueryRefundOf() *DebtQuery {
+	query := (&DebtClient{config: dq.config}).Query()
+	query.path = func(ctx context.Context) (fromU *sql.Selector, err error) {
+		if err := dq.prepareQuery(ctx); err != nil {
+			return nil, err
+		}
+		selector := dq.sqlQuery(ctx)
+		if err := selector.Err(); err != nil {
+			return nil, err
+		}
+		step := sqlgraph.NewStep(
+			sqlgraph.From(debt.Table, debt.FieldID, selector),
+			sqlgraph.To(debt.Table, debt.FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, debt.RefundOfTable, debt.RefundOfColumn),
+		)
+		fromU = sqlgraph.SetNeighbors(dq.driver.Dialect(), step)
+		return fromU, nil
+	}
+	return query
+}
+
+// QueryRefunds chains the current query on the "refunds" edge.
+func (dq *DebtQuery) QueryRefunds() *DebtQuery {
+	query := (&DebtClient{config: dq.config}).Query()
+	query.path = func(ctx context.Context) (fromU *sql.Selector, err error) {
+		if err := dq.prepareQuery(ctx); err != nil {
+			return nil, err
+		}
+		selector := dq.sqlQuery(ctx)
+		if err := selector.Err(); err != nil {
+			return nil, err
+		}
+		step := sqlgraph.NewStep(
+			sqlgraph.From(debt.Table, debt.FieldID, selector),
+			sqlgraph.To(debt.Table, debt.FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, debt.RefundsTable, debt.RefundsColumn),
+		)
+		fromU = sqlgraph.SetNeighbors(dq.driver.Dialect(), step)
+		return fromU, nil
+	}
+	return query
+}
+
 // First returns the first Debt entity from the query.
 // Returns a *NotFoundError when no Debt was found.
 func (dq *DebtQuery) First(ctx context.Context) (*Debt, error) {
@@ -319,14 +462,20 @@ func (dq *DebtQuery) Clone() *DebtQuery {
 		return nil
 	}
 	return &DebtQuery{
-		config:       dq.config,
-		ctx:          dq.ctx.Clone(),
-		order:        append([]debt.OrderOption{}, dq.order...),
-		inters:       append([]Interceptor{}, dq.inters...),
-		predicates:   append([]predicate.Debt{}, dq.predicates...),
-		withInvoice:  dq.withInvoice.Clone(),
-		withCategory: dq.withCategory.Clone(),
-		withStatus:   dq.withStatus.Clone(),
+		config:          dq.config,
+		ctx:             dq.ctx.Clone(),
+		order:           append([]debt.OrderOption{}, dq.order...),
+		inters:          append([]Interceptor{}, dq.inters...),
+		predicates:      append([]predicate.Debt{}, dq.predicates...),
+		withInvoice:     dq.withInvoice.Clone(),
+		withCategory:    dq.withCategory.Clone(),
+		withStatus:      dq.withStatus.Clone(),
+		withWorkspace:   dq.withWorkspace.Clone(),
+		withShares:      dq.withShares.Clone(),
+		withTags:        dq.withTags.Clone(),
+		withAttachments: dq.withAttachments.Clone(),
+		withRefundOf:    dq.withRefundOf.Clone(),
+		withRefunds:     dq.withRefunds.Clone(),
 		// clone intermediate query.
 		sql:  dq.sql.Clone(),
 		path: dq.path,
@@ -366,6 +515,72 @@ func (dq *DebtQuery) WithStatus(opts ...func(*PaymentStatusQuery)) *DebtQuery {
 	return dq
 }
 
+// WithWorkspace tells the query-builder to eager-load the nodes that are connected to
+// the "workspace" edge. The optional arguments are used to configure the query builder of the edge.
+func (dq *DebtQuery) WithWorkspace(opts ...func(*WorkspaceQuery)) *DebtQuery {
+	query := (&WorkspaceClient{config: dq.config}).Query()
+	for _, opt := range opts {
+		opt(query)
+	}
+	dq.withWorkspace = query
+	return dq
+}
+
+// WithShares tells the query-builder to eager-load the nodes that are connected to
+// the "shares" edge. The optional arguments are used to configure the query builder of the edge.
+func (dq *DebtQuery) WithShares(opts ...func(*DebtShareQuery)) *DebtQuery {
+	query := (&DebtShareClient{config: dq.config}).Query()
+	for _, opt := range opts {
+		opt(query)
+	}
+	dq.withShares = query
+	return dq
+}
+
+// WithTags tells the query-builder to eager-load the nodes that are connected to
+// the "tags" edge. The optional arguments are used to configure the query builder of the edge.
+func (dq *DebtQuery) WithTags(opts ...func(*TagQuery)) *DebtQuery {
+	query := (&TagClient{config: dq.config}).Query()
+	for _, opt := range opts {
+		opt(query)
+	}
+	dq.withTags = query
+	return dq
+}
+
+// WithAttachments tells the query-builder to eager-load the nodes that are connected to
+// the "attachments" edge. The optional arguments are used to configure the query builder of the edge.
+func (dq *DebtQuery) WithAttachments(opts ...func(*AttachmentQuery)) *DebtQuery {
+	query := (&AttachmentClient{config: dq.config}).Query()
+	for _, opt := range opts {
+		opt(query)
+	}
+	dq.withAttachments = query
+	return dq
+}
+
+// WithRefundOf tells the query-builder to eager-load the nodes that are connected to
+// the "refund_of" edge. The optional arguments are used to configure the query builder of the edge.
+func (dq *DebtQuery) WithRefundOf(opts ...func(*DebtQuery)) *DebtQuery {
+	query := (&DebtClient{config: dq.config}).Query()
+	for _, opt := range opts {
+		opt(query)
+	}
+	dq.withRefundOf = query
+	return dq
+}
+
+// WithRefunds tells the query-builder to eager-load the nodes that are connected to
+// the "refunds" edge. The optional arguments are used to configure the query builder of the edge.
+func (dq *DebtQuery) WithRefunds(opts ...func(*DebtQuery)) *DebtQuery {
+	query := (&DebtClient{config: dq.config}).Query()
+	for _, opt := range opts {
+		opt(query)
+	}
+	dq.withRefunds = query
+	return dq
+}
+
 // GroupBy is used to group vertices by one or more fields/columns.
 // It is often used with aggregate functions, like: count, max, mean, min, sum.
 //
@@ -445,10 +660,16 @@ func (dq *DebtQuery) sqlAll(ctx context.Context, hooks ...queryHook) ([]*Debt, e
 		nodes       = []*Debt{}
 		withFKs     = dq.withFKs
 		_spec       = dq.querySpec()
-		loadedTypes = [3]bool{
+		loadedTypes = [9]bool{
 			dq.withInvoice != nil,
 			dq.withCategory != nil,
 			dq.withStatus != nil,
+			dq.withWorkspace != nil,
+			dq.withShares != nil,
+			dq.withTags != nil,
+			dq.withAttachments != nil,
+			dq.withRefundOf != nil,
+			dq.withRefunds != nil,
 		}
 	)
 	if dq.withInvoice != nil || dq.withCategory != nil || dq.withStatus != nil {
@@ -493,6 +714,46 @@ func (dq *DebtQuery) sqlAll(ctx context.Context, hooks ...queryHook) ([]*Debt, e
 			return nil, err
 		}
 	}
+	if query := dq.withWorkspace; query != nil {
+		if err := dq.loadWorkspace(ctx, query, nodes, nil,
+			func(n *Debt, e *Workspace) { n.Edges.Workspace = e }); err != nil {
+			return nil, err
+		}
+	}
+	if query := dq.withShares; query != nil {
+		if err := dq.loadShares(ctx, query, nodes,
+			func(n *Debt) { n.Edges.Shares = []*DebtShare{} },
+			func(n *Debt, e *DebtShare) { n.Edges.Shares = append(n.Edges.Shares, e) }); err != nil {
+			return nil, err
+		}
+	}
+	if query := dq.withTags; query != nil {
+		if err := dq.loadTags(ctx, query, nodes,
+			func(n *Debt) { n.Edges.Tags = []*Tag{} },
+			func(n *Debt, e *Tag) { n.Edges.Tags = append(n.Edges.Tags, e) }); err != nil {
+			return nil, err
+		}
+	}
+	if query := dq.withAttachments; query != nil {
+		if err := dq.loadAttachments(ctx, query, nodes,
+			func(n *Debt) { n.Edges.Attachments = []*Attachment{} },
+			func(n *Debt, e *Attachment) { n.Edges.Attachments = append(n.Edges.Attachments, e) }); err != nil {
+			return nil, err
+		}
+	}
+	if query := dq.withRefundOf; query != nil {
+		if err := dq.loadRefundOf(ctx, query, nodes, nil,
+			func(n *Debt, e *Debt) { n.Edges.RefundOf = e }); err != nil {
+			return nil, err
+		}
+	}
+	if query := dq.withRefunds; query != nil {
+		if err := dq.loadRefunds(ctx, query, nodes,
+			func(n *Debt) { n.Edges.Refunds = []*Debt{} },
+			func(n *Debt, e *Debt) { n.Edges.Refunds = append(n.Edges.Refunds, e) }); err != nil {
+			return nil, err
+		}
+	}
 	return nodes, nil
 }
 
@@ -592,6 +853,225 @@ func (dq *DebtQuery) loadStatus(ctx context.Context, query *PaymentStatusQuery,
 	}
 	return nil
 }
+func (dq *DebtQuery) loadWorkspace(ctx context.Context, query *WorkspaceQuery, nodes []*Debt, init func(*Debt), assign func(*Debt, *Workspace)) error {
+	ids := make([]uuid.UUID, 0, len(nodes))
+	nodeids := make(map[uuid.UUID][]*Debt)
+	for i := range nodes {
+		fk := nodes[i].WorkspaceID
+		if _, ok := nodeids[fk]; !ok {
+			ids = append(ids, fk)
+		}
+		nodeids[fk] = append(nodeids[fk], nodes[i])
+	}
+	if len(ids) == 0 {
+		return nil
+	}
+	query.Where(workspace.IDIn(ids...))
+	neighbors, err := query.All(ctx)
+	if err != nil {
+		return err
+	}
+	for _, n := range neighbors {
+		nodes, ok := nodeids[n.ID]
+		if !ok {
+			return fmt.Errorf(`unexpected foreign-key "workspace_id" returned %v`, n.ID)
+		}
+		for i := range nodes {
+			assign(nodes[i], n)
+		}
+	}
+	return nil
+}
+func (dq *DebtQuery) loadShares(ctx context.Context, query *DebtShareQuery, nodes []*Debt, init func(*Debt), assign func(*Debt, *DebtShare)) error {
+	fks := make([]driver.Value, 0, len(nodes))
+	nodeids := make(map[uuid.UUID]*Debt)
+	for i := range nodes {
+		fks = append(fks, nodes[i].ID)
+		nodeids[nodes[i].ID] = nodes[i]
+		if init != nil {
+			init(nodes[i])
+		}
+	}
+	if len(query.ctx.Fields) > 0 {
+		query.ctx.AppendFieldOnce(debtshare.FieldDebtID)
+	}
+	query.Where(predicate.DebtShare(func(s *sql.Selector) {
+		s.Where(sql.InValues(s.C(debt.SharesColumn), fks...))
+	}))
+	neighbors, err := query.All(ctx)
+	if err != nil {
+		return err
+	}
+	for _, n := range neighbors {
+		fk := n.DebtID
+		node, ok := nodeids[fk]
+		if !ok {
+			return fmt.Errorf(`unexpected referenced foreign-key "debt_id" returned %v for node %v`, fk, n.ID)
+		}
+		assign(node, n)
+	}
+	return nil
+}
+func (dq *DebtQuery) loadTags(ctx context.Context, query *TagQuery, nodes []*Debt, init func(*Debt), assign func(*Debt, *Tag)) error {
+	edgeIDs := make([]driver.Value, len(nodes))
+	byID := make(map[uuid.UUID]*Debt)
+	nids := make(map[uuid.UUID]map[*Debt]struct{})
+	for i, node := range nodes {
+		edgeIDs[i] = node.ID
+		byID[node.ID] = node
+		if init != nil {
+			init(node)
+		}
+	}
+	query.Where(func(s *sql.Selector) {
+		joinT := sql.Table(debt.TagsTable)
+		s.Join(joinT).On(s.C(tag.FieldID), joinT.C(debt.TagsPrimaryKey[1]))
+		s.Where(sql.InValues(joinT.C(debt.TagsPrimaryKey[0]), edgeIDs...))
+		columns := s.SelectedColumns()
+		s.Select(joinT.C(debt.TagsPrimaryKey[0]))
+		s.AppendSelect(columns...)
+		s.SetDistinct(false)
+	})
+	if err := query.prepareQuery(ctx); err != nil {
+		return err
+	}
+	qr := QuerierFunc(func(ctx context.Context, q Query) (Value, error) {
+		return query.sqlAll(ctx, func(_ context.Context, spec *sqlgraph.QuerySpec) {
+			assign := spec.Assign
+			values := spec.ScanValues
+			spec.ScanValues = func(columns []string) ([]any, error) {
+				values, err := values(columns[1:])
+				if err != nil {
+					return nil, err
+				}
+				return append([]any{new(uuid.UUID)}, values...), nil
+			}
+			spec.Assign = func(columns []string, values []any) error {
+				outValue := *values[0].(*uuid.UUID)
+				inValue := *values[1].(*uuid.UUID)
+				if nids[inValue] == nil {
+					nids[inValue] = map[*Debt]struct{}{byID[outValue]: {}}
+					return assign(columns[1:], values[1:])
+				}
+				nids[inValue][byID[outValue]] = struct{}{}
+				return nil
+			}
+		})
+	})
+	neighbors, err := withInterceptors[[]*Tag](ctx, query, qr, query.inters)
+	if err != nil {
+		return err
+	}
+	for _, n := range neighbors {
+		nodes, ok := nids[n.ID]
+		if !ok {
+			return fmt.Errorf(`unexpected "tags" node returned %v`, n.ID)
+		}
+		for kn := range nodes {
+			assign(kn, n)
+		}
+	}
+	return nil
+}
+func (dq *DebtQuery) loadAttachments(ctx context.Context, query *AttachmentQuery, nodes []*Debt, init func(*Debt), assign func(*Debt, *Attachment)) error {
+	fks := make([]driver.Value, 0, len(nodes))
+	nodeids := make(map[uuid.UUID]*Debt)
+	for i := range nodes {
+		fks = append(fks, nodes[i].ID)
+		nodeids[nodes[i].ID] = nodes[i]
+		if init != nil {
+			init(nodes[i])
+		}
+	}
+	if len(query.ctx.Fields) > 0 {
+		query.ctx.AppendFieldOnce(attachment.FieldDebtID)
+	}
+	query.Where(predicate.Attachment(func(s *sql.Selector) {
+		s.Where(sql.InValues(s.C(debt.AttachmentsColumn), fks...))
+	}))
+	neighbors, err := query.All(ctx)
+	if err != nil {
+		return err
+	}
+	for _, n := range neighbors {
+		fk := n.DebtID
+		if fk == nil {
+			return fmt.Errorf(`foreign-key "debt_id" is nil for node %v`, n.ID)
+		}
+		node, ok := nodeids[*fk]
+		if !ok {
+			return fmt.Errorf(`unexpected referenced foreign-key "debt_id" returned %v for node %v`, *fk, n.ID)
+		}
+		assign(node, n)
+	}
+	return nil
+}
+func (dq *DebtQuery) loadRefundOf(ctx context.Context, query *DebtQuery, nodes []*Debt, init func(*Debt), assign func(*Debt, *Debt)) error {
+	ids := make([]uuid.UUID, 0, len(nodes))
+	nodeids := make(map[uuid.UUID][]*Debt)
+	for i := range nodes {
+		if nodes[i].RefundOfID == nil {
+			continue
+		}
+		fk := *nodes[i].RefundOfID
+		if _, ok := nodeids[fk]; !ok {
+			ids = append(ids, fk)
+		}
+		nodeids[fk] = append(nodeids[fk], nodes[i])
+	}
+	if len(ids) == 0 {
+		return nil
+	}
+	query.Where(debt.IDIn(ids...))
+	neighbors, err := query.All(ctx)
+	if err != nil {
+		return err
+	}
+	for _, n := range neighbors {
+		nodes, ok := nodeids[n.ID]
+		if !ok {
+			return fmt.Errorf(`unexpected foreign-key "refund_of_id" returned %v`, n.ID)
+		}
+		for i := range nodes {
+			assign(nodes[i], n)
+		}
+	}
+	return nil
+}
+func (dq *DebtQuery) loadRefunds(ctx context.Context, query *DebtQuery, nodes []*Debt, init func(*Debt), assign func(*Debt, *Debt)) error {
+	fks := make([]driver.Value, 0, len(nodes))
+	nodeids := make(map[uuid.UUID]*Debt)
+	for i := range nodes {
+		fks = append(fks, nodes[i].ID)
+		nodeids[nodes[i].ID] = nodes[i]
+		if init != nil {
+			init(nodes[i])
+		}
+	}
+	query.withFKs = true
+	if len(query.ctx.Fields) > 0 {
+		query.ctx.AppendFieldOnce(debt.FieldRefundOfID)
+	}
+	query.Where(predicate.Debt(func(s *sql.Selector) {
+		s.Where(sql.InValues(s.C(debt.RefundsColumn), fks...))
+	}))
+	neighbors, err := query.All(ctx)
+	if err != nil {
+		return err
+	}
+	for _, n := range neighbors {
+		fk := n.RefundOfID
+		if fk == nil {
+			return fmt.Errorf(`foreign-key "refund_of_id" is nil for node %v`, n.ID)
+		}
+		node, ok := nodeids[*fk]
+		if !ok {
+			return fmt.Errorf(`unexpected referenced foreign-key "refund_of_id" returned %v for node %v`, *fk, n.ID)
+		}
+		assign(node, n)
+	}
+	return nil
+}
 
 func (dq *DebtQuery) sqlCount(ctx context.Context) (int, error) {
 	_spec := dq.querySpec()
@@ -618,6 +1098,12 @@ func (dq *DebtQuery) querySpec() *sqlgraph.QuerySpec {
 				_spec.Node.Columns = append(_spec.Node.Columns, fields[i])
 			}
 		}
+		if dq.withWorkspace != nil {
+			_spec.Node.AddColumnOnce(debt.FieldWorkspaceID)
+		}
+		if dq.withRefundOf != nil {
+			_spec.Node.AddColumnOnce(debt.FieldRefundOfID)
+		}
 	}
 	if ps := dq.predicates; len(ps) > 0 {
 		_spec.Predicate = func(selector *sql.Selector) {
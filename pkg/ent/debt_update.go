@@ -3,11 +3,15 @@
 package ent
 
 import (
+	"backend-go/pkg/ent/attachment"
 	"backend-go/pkg/ent/category"
 	"backend-go/pkg/ent/debt"
+	"backend-go/pkg/ent/debtshare"
 	"backend-go/pkg/ent/invoice"
 	"backend-go/pkg/ent/paymentstatus"
 	"backend-go/pkg/ent/predicate"
+	"backend-go/pkg/ent/tag"
+	"backend-go/pkg/ent/workspace"
 	"context"
 	"errors"
 	"fmt"
@@ -38,6 +42,47 @@ func (du *DebtUpdate) SetUpdatedAt(t time.Time) *DebtUpdate {
 	return du
 }
 
+// SetVersion sets the "version" field.
+func (du *DebtUpdate) SetVersion(i int) *DebtUpdate {
+	du.mutation.ResetVersion()
+	du.mutation.SetVersion(i)
+	return du
+}
+
+// SetNillableVersion sets the "version" field if the given value is not nil.
+func (du *DebtUpdate) SetNillableVersion(i *int) *DebtUpdate {
+	if i != nil {
+		du.SetVersion(*i)
+	}
+	return du
+}
+
+// AddVersion adds i to the "version" field.
+func (du *DebtUpdate) AddVersion(i int) *DebtUpdate {
+	du.mutation.AddVersion(i)
+	return du
+}
+
+// SetDeletedAt sets the "deleted_at" field.
+func (du *DebtUpdate) SetDeletedAt(t time.Time) *DebtUpdate {
+	du.mutation.SetDeletedAt(t)
+	return du
+}
+
+// SetNillableDeletedAt sets the "deleted_at" field if the given value is not nil.
+func (du *DebtUpdate) SetNillableDeletedAt(t *time.Time) *DebtUpdate {
+	if t != nil {
+		du.SetDeletedAt(*t)
+	}
+	return du
+}
+
+// ClearDeletedAt clears the value of the "deleted_at" field.
+func (du *DebtUpdate) ClearDeletedAt() *DebtUpdate {
+	du.mutation.ClearDeletedAt()
+	return du
+}
+
 // SetAmount sets the "amount" field.
 func (du *DebtUpdate) SetAmount(f float64) *DebtUpdate {
 	du.mutation.ResetAmount()
@@ -101,6 +146,54 @@ func (du *DebtUpdate) SetNillableDueDate(t *time.Time) *DebtUpdate {
 	return du
 }
 
+// SetWorkspaceID sets the "workspace_id" field.
+func (du *DebtUpdate) SetWorkspaceID(u uuid.UUID) *DebtUpdate {
+	du.mutation.SetWorkspaceID(u)
+	return du
+}
+
+// SetNillableWorkspaceID sets the "workspace_id" field if the given value is not nil.
+func (du *DebtUpdate) SetNillableWorkspaceID(u *uuid.UUID) *DebtUpdate {
+	if u != nil {
+		du.SetWorkspaceID(*u)
+	}
+	return du
+}
+
+// SetKind sets the "kind" field.
+func (du *DebtUpdate) SetKind(d debt.Kind) *DebtUpdate {
+	du.mutation.SetKind(d)
+	return du
+}
+
+// SetNillableKind sets the "kind" field if the given value is not nil.
+func (du *DebtUpdate) SetNillableKind(d *debt.Kind) *DebtUpdate {
+	if d != nil {
+		du.SetKind(*d)
+	}
+	return du
+}
+
+// SetRefundOfID sets the "refund_of_id" field.
+func (du *DebtUpdate) SetRefundOfID(u uuid.UUID) *DebtUpdate {
+	du.mutation.SetRefundOfID(u)
+	return du
+}
+
+// SetNillableRefundOfID sets the "refund_of_id" field if the given value is not nil.
+func (du *DebtUpdate) SetNillableRefundOfID(u *uuid.UUID) *DebtUpdate {
+	if u != nil {
+		du.SetRefundOfID(*u)
+	}
+	return du
+}
+
+// ClearRefundOfID clears the value of the "refund_of_id" field.
+func (du *DebtUpdate) ClearRefundOfID() *DebtUpdate {
+	du.mutation.ClearRefundOfID()
+	return du
+}
+
 // SetInvoiceID sets the "invoice" edge to the Invoice entity by ID.
 func (du *DebtUpdate) SetInvoiceID(id uuid.UUID) *DebtUpdate {
 	du.mutation.SetInvoiceID(id)
@@ -158,6 +251,76 @@ func (du *DebtUpdate) SetStatus(p *PaymentStatus) *DebtUpdate {
 	return du.SetStatusID(p.ID)
 }
 
+// SetWorkspace sets the "workspace" edge to the Workspace entity.
+func (du *DebtUpdate) SetWorkspace(w *Workspace) *DebtUpdate {
+	return du.SetWorkspaceID(w.ID)
+}
+
+// AddShareIDs adds the "shares" edge to the DebtShare entity by IDs.
+func (du *DebtUpdate) AddShareIDs(ids ...uuid.UUID) *DebtUpdate {
+	du.mutation.AddShareIDs(ids...)
+	return du
+}
+
+// AddShares adds the "shares" edges to the DebtShare entity.
+func (du *DebtUpdate) AddShares(d ...*DebtShare) *DebtUpdate {
+	ids := make([]uuid.UUID, len(d))
+	for i := range d {
+		ids[i] = d[i].ID
+	}
+	return du.AddShareIDs(ids...)
+}
+
+// AddTagIDs adds the "tags" edge to the Tag entity by IDs.
+func (du *DebtUpdate) AddTagIDs(ids ...uuid.UUID) *DebtUpdate {
+	du.mutation.AddTagIDs(ids...)
+	return du
+}
+
+// AddTags adds the "tags" edges to the Tag entity.
+func (du *DebtUpdate) AddTags(t ...*Tag) *DebtUpdate {
+	ids := make([]uuid.UUID, len(t))
+	for i := range t {
+		ids[i] = t[i].ID
+	}
+	return du.AddTagIDs(ids...)
+}
+
+// AddAttachmentIDs adds the "attachments" edge to the Attachment entity by IDs.
+func (du *DebtUpdate) AddAttachmentIDs(ids ...uuid.UUID) *DebtUpdate {
+	du.mutation.AddAttachmentIDs(ids...)
+	return du
+}
+
+// AddAttachments adds the "attachments" edges to the Attachment entity.
+func (du *DebtUpdate) AddAttachments(a ...*Attachment) *DebtUpdate {
+	ids := make([]uuid.UUID, len(a))
+	for i := range a {
+		ids[i] = a[i].ID
+	}
+	return du.AddAttachmentIDs(ids...)
+}
+
+// SetRefundOf sets the "refund_of" edge to the Debt entity.
+func (du *DebtUpdate) SetRefundOf(d *Debt) *DebtUpdate {
+	return du.SetRefundOfID(d.ID)
+}
+
+// AddRefundIDs adds the "refunds" edge to the Debt entity by IDs.
+func (du *DebtUpdate) AddRefundIDs(ids ...uuid.UUID) *DebtUpdate {
+	du.mutation.AddRefundIDs(ids...)
+	return du
+}
+
+// AddRefunds adds the "refunds" edges to the Debt entity.
+func (du *DebtUpdate) AddRefunds(d ...*Debt) *DebtUpdate {
+	ids := make([]uuid.UUID, len(d))
+	for i := range d {
+		ids[i] = d[i].ID
+	}
+	return du.AddRefundIDs(ids...)
+}
+
 // Mutation returns the DebtMutation object of the builder.
 func (du *DebtUpdate) Mutation() *DebtMutation {
 	return du.mutation
@@ -181,6 +344,102 @@ func (du *DebtUpdate) ClearStatus() *DebtUpdate {
 	return du
 }
 
+// ClearWorkspace clears the "workspace" edge to the Workspace entity.
+func (du *DebtUpdate) ClearWorkspace() *DebtUpdate {
+	du.mutation.ClearWorkspace()
+	return du
+}
+
+// ClearShares clears all "shares" edges to the DebtShare entity.
+func (du *DebtUpdate) ClearShares() *DebtUpdate {
+	du.mutation.ClearShares()
+	return du
+}
+
+// RemoveShareIDs removes the "shares" edge to DebtShare entities by IDs.
+func (du *DebtUpdate) RemoveShareIDs(ids ...uuid.UUID) *DebtUpdate {
+	du.mutation.RemoveShareIDs(ids...)
+	return du
+}
+
+// RemoveShares removes "shares" edges to DebtShare entities.
+func (du *DebtUpdate) RemoveShares(d ...*DebtShare) *DebtUpdate {
+	ids := make([]uuid.UUID, len(d))
+	for i := range d {
+		ids[i] = d[i].ID
+	}
+	return du.RemoveShareIDs(ids...)
+}
+
+// ClearTags clears all "tags" edges to the Tag entity.
+func (du *DebtUpdate) ClearTags() *DebtUpdate {
+	du.mutation.ClearTags()
+	return du
+}
+
+// RemoveTagIDs removes the "tags" edge to Tag entities by IDs.
+func (du *DebtUpdate) RemoveTagIDs(ids ...uuid.UUID) *DebtUpdate {
+	du.mutation.RemoveTagIDs(ids...)
+	return du
+}
+
+// RemoveTags removes "tags" edges to Tag entities.
+func (du *DebtUpdate) RemoveTags(t ...*Tag) *DebtUpdate {
+	ids := make([]uuid.UUID, len(t))
+	for i := range t {
+		ids[i] = t[i].ID
+	}
+	return du.RemoveTagIDs(ids...)
+}
+
+// ClearAttachments clears all "attachments" edges to the Attachment entity.
+func (du *DebtUpdate) ClearAttachments() *DebtUpdate {
+	du.mutation.ClearAttachments()
+	return du
+}
+
+// RemoveAttachmentIDs removes the "attachments" edge to Attachment entities by IDs.
+func (du *DebtUpdate) RemoveAttachmentIDs(ids ...uuid.UUID) *DebtUpdate {
+	du.mutation.RemoveAttachmentIDs(ids...)
+	return du
+}
+
+// RemoveAttachments removes "attachments" edges to Attachment entities.
+func (du *DebtUpdate) RemoveAttachments(a ...*Attachment) *DebtUpdate {
+	ids := make([]uuid.UUID, len(a))
+	for i := range a {
+		ids[i] = a[i].ID
+	}
+	return du.RemoveAttachmentIDs(ids...)
+}
+
+// ClearRefundOf clears the "refund_of" edge to the Debt entity.
+func (du *DebtUpdate) ClearRefundOf() *DebtUpdate {
+	du.mutation.ClearRefundOf()
+	return du
+}
+
+// ClearRefunds clears all "refunds" edges to the Debt entity.
+func (du *DebtUpdate) ClearRefunds() *DebtUpdate {
+	du.mutation.ClearRefunds()
+	return du
+}
+
+// RemoveRefundIDs removes the "refunds" edge to Debt entities by IDs.
+func (du *DebtUpdate) RemoveRefundIDs(ids ...uuid.UUID) *DebtUpdate {
+	du.mutation.RemoveRefundIDs(ids...)
+	return du
+}
+
+// RemoveRefunds removes "refunds" edges to Debt entities.
+func (du *DebtUpdate) RemoveRefunds(d ...*Debt) *DebtUpdate {
+	ids := make([]uuid.UUID, len(d))
+	for i := range d {
+		ids[i] = d[i].ID
+	}
+	return du.RemoveRefundIDs(ids...)
+}
+
 // Save executes the query and returns the number of nodes affected by the update operation.
 func (du *DebtUpdate) Save(ctx context.Context) (int, error) {
 	du.defaults()
@@ -224,6 +483,14 @@ func (du *DebtUpdate) check() error {
 			return &ValidationError{Name: "title", err: fmt.Errorf(`ent: validator failed for field "Debt.title": %w`, err)}
 		}
 	}
+	if v, ok := du.mutation.Kind(); ok {
+		if err := debt.KindValidator(v); err != nil {
+			return &ValidationError{Name: "kind", err: fmt.Errorf(`ent: validator failed for field "Debt.kind": %w`, err)}
+		}
+	}
+	if du.mutation.WorkspaceCleared() && len(du.mutation.WorkspaceIDs()) > 0 {
+		return errors.New(`ent: clearing a required unique edge "Debt.workspace"`)
+	}
 	return nil
 }
 
@@ -242,6 +509,18 @@ func (du *DebtUpdate) sqlSave(ctx context.Context) (n int, err error) {
 	if value, ok := du.mutation.UpdatedAt(); ok {
 		_spec.SetField(debt.FieldUpdatedAt, field.TypeTime, value)
 	}
+	if value, ok := du.mutation.Version(); ok {
+		_spec.SetField(debt.FieldVersion, field.TypeInt, value)
+	}
+	if value, ok := du.mutation.AddedVersion(); ok {
+		_spec.AddField(debt.FieldVersion, field.TypeInt, value)
+	}
+	if value, ok := du.mutation.DeletedAt(); ok {
+		_spec.SetField(debt.FieldDeletedAt, field.TypeTime, value)
+	}
+	if du.mutation.DeletedAtCleared() {
+		_spec.ClearField(debt.FieldDeletedAt, field.TypeTime)
+	}
 	if value, ok := du.mutation.Amount(); ok {
 		_spec.SetField(debt.FieldAmount, field.TypeFloat64, value)
 	}
@@ -257,6 +536,9 @@ func (du *DebtUpdate) sqlSave(ctx context.Context) (n int, err error) {
 	if value, ok := du.mutation.DueDate(); ok {
 		_spec.SetField(debt.FieldDueDate, field.TypeTime, value)
 	}
+	if value, ok := du.mutation.Kind(); ok {
+		_spec.SetField(debt.FieldKind, field.TypeEnum, value)
+	}
 	if du.mutation.InvoiceCleared() {
 		edge := &sqlgraph.EdgeSpec{
 			Rel:     sqlgraph.M2O,
@@ -344,100 +626,427 @@ func (du *DebtUpdate) sqlSave(ctx context.Context) (n int, err error) {
 		}
 		_spec.Edges.Add = append(_spec.Edges.Add, edge)
 	}
-	if n, err = sqlgraph.UpdateNodes(ctx, du.driver, _spec); err != nil {
-		if _, ok := err.(*sqlgraph.NotFoundError); ok {
-			err = &NotFoundError{debt.Label}
-		} else if sqlgraph.IsConstraintError(err) {
-			err = &ConstraintError{msg: err.Error(), wrap: err}
+	if du.mutation.WorkspaceCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: false,
+			Table:   debt.WorkspaceTable,
+			Columns: []string{debt.WorkspaceColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(workspace.FieldID, field.TypeUUID),
+			},
 		}
-		return 0, err
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
 	}
-	du.mutation.done = true
-	return n, nil
-}
-
-// DebtUpdateOne is the builder for updating a single Debt entity.
-type DebtUpdateOne struct {
-	config
-	fields   []string
-	hooks    []Hook
-	mutation *DebtMutation
-}
-
-// SetUpdatedAt sets the "updated_at" field.
-func (duo *DebtUpdateOne) SetUpdatedAt(t time.Time) *DebtUpdateOne {
-	duo.mutation.SetUpdatedAt(t)
-	return duo
-}
-
-// SetAmount sets the "amount" field.
-func (duo *DebtUpdateOne) SetAmount(f float64) *DebtUpdateOne {
-	duo.mutation.ResetAmount()
-	duo.mutation.SetAmount(f)
-	return duo
-}
-
-// SetNillableAmount sets the "amount" field if the given value is not nil.
-func (duo *DebtUpdateOne) SetNillableAmount(f *float64) *DebtUpdateOne {
-	if f != nil {
-		duo.SetAmount(*f)
+	if nodes := du.mutation.WorkspaceIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: false,
+			Table:   debt.WorkspaceTable,
+			Columns: []string{debt.WorkspaceColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(workspace.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
 	}
-	return duo
-}
-
-// AddAmount adds f to the "amount" field.
-func (duo *DebtUpdateOne) AddAmount(f float64) *DebtUpdateOne {
-	duo.mutation.AddAmount(f)
-	return duo
-}
-
-// SetTitle sets the "title" field.
-func (duo *DebtUpdateOne) SetTitle(s string) *DebtUpdateOne {
-	duo.mutation.SetTitle(s)
-	return duo
-}
-
-// SetNillableTitle sets the "title" field if the given value is not nil.
-func (duo *DebtUpdateOne) SetNillableTitle(s *string) *DebtUpdateOne {
-	if s != nil {
-		duo.SetTitle(*s)
+	if du.mutation.SharesCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   debt.SharesTable,
+			Columns: []string{debt.SharesColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(debtshare.FieldID, field.TypeUUID),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
 	}
-	return duo
-}
-
-// SetPurchaseDate sets the "purchase_date" field.
-func (duo *DebtUpdateOne) SetPurchaseDate(t time.Time) *DebtUpdateOne {
-	duo.mutation.SetPurchaseDate(t)
-	return duo
-}
-
-// SetNillablePurchaseDate sets the "purchase_date" field if the given value is not nil.
-func (duo *DebtUpdateOne) SetNillablePurchaseDate(t *time.Time) *DebtUpdateOne {
-	if t != nil {
-		duo.SetPurchaseDate(*t)
+	if nodes := du.mutation.RemovedSharesIDs(); len(nodes) > 0 && !du.mutation.SharesCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   debt.SharesTable,
+			Columns: []string{debt.SharesColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(debtshare.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
 	}
-	return duo
-}
-
-// SetDueDate sets the "due_date" field.
-func (duo *DebtUpdateOne) SetDueDate(t time.Time) *DebtUpdateOne {
-	duo.mutation.SetDueDate(t)
-	return duo
-}
-
-// SetNillableDueDate sets the "due_date" field if the given value is not nil.
-func (duo *DebtUpdateOne) SetNillableDueDate(t *time.Time) *DebtUpdateOne {
-	if t != nil {
-		duo.SetDueDate(*t)
+	if nodes := du.mutation.SharesIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   debt.SharesTable,
+			Columns: []string{debt.SharesColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(debtshare.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
 	}
-	return duo
-}
-
-// SetInvoiceID sets the "invoice" edge to the Invoice entity by ID.
-func (duo *DebtUpdateOne) SetInvoiceID(id uuid.UUID) *DebtUpdateOne {
-	duo.mutation.SetInvoiceID(id)
-	return duo
-}
+	if du.mutation.TagsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2M,
+			Inverse: false,
+			Table:   debt.TagsTable,
+			Columns: debt.TagsPrimaryKey,
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(tag.FieldID, field.TypeUUID),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := du.mutation.RemovedTagsIDs(); len(nodes) > 0 && !du.mutation.TagsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2M,
+			Inverse: false,
+			Table:   debt.TagsTable,
+			Columns: debt.TagsPrimaryKey,
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(tag.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := du.mutation.TagsIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2M,
+			Inverse: false,
+			Table:   debt.TagsTable,
+			Columns: debt.TagsPrimaryKey,
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(tag.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if du.mutation.AttachmentsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   debt.AttachmentsTable,
+			Columns: []string{debt.AttachmentsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(attachment.FieldID, field.TypeUUID),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := du.mutation.RemovedAttachmentsIDs(); len(nodes) > 0 && !du.mutation.AttachmentsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   debt.AttachmentsTable,
+			Columns: []string{debt.AttachmentsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(attachment.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := du.mutation.AttachmentsIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   debt.AttachmentsTable,
+			Columns: []string{debt.AttachmentsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(attachment.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if du.mutation.RefundOfCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   debt.RefundOfTable,
+			Columns: []string{debt.RefundOfColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(debt.FieldID, field.TypeUUID),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := du.mutation.RefundOfIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   debt.RefundOfTable,
+			Columns: []string{debt.RefundOfColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(debt.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if du.mutation.RefundsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   debt.RefundsTable,
+			Columns: []string{debt.RefundsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(debt.FieldID, field.TypeUUID),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := du.mutation.RemovedRefundsIDs(); len(nodes) > 0 && !du.mutation.RefundsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   debt.RefundsTable,
+			Columns: []string{debt.RefundsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(debt.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := du.mutation.RefundsIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   debt.RefundsTable,
+			Columns: []string{debt.RefundsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(debt.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if n, err = sqlgraph.UpdateNodes(ctx, du.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{debt.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return 0, err
+	}
+	du.mutation.done = true
+	return n, nil
+}
+
+// DebtUpdateOne is the builder for updating a single Debt entity.
+type DebtUpdateOne struct {
+	config
+	fields   []string
+	hooks    []Hook
+	mutation *DebtMutation
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (duo *DebtUpdateOne) SetUpdatedAt(t time.Time) *DebtUpdateOne {
+	duo.mutation.SetUpdatedAt(t)
+	return duo
+}
+
+// SetVersion sets the "version" field.
+func (duo *DebtUpdateOne) SetVersion(i int) *DebtUpdateOne {
+	duo.mutation.ResetVersion()
+	duo.mutation.SetVersion(i)
+	return duo
+}
+
+// SetNillableVersion sets the "version" field if the given value is not nil.
+func (duo *DebtUpdateOne) SetNillableVersion(i *int) *DebtUpdateOne {
+	if i != nil {
+		duo.SetVersion(*i)
+	}
+	return duo
+}
+
+// AddVersion adds i to the "version" field.
+func (duo *DebtUpdateOne) AddVersion(i int) *DebtUpdateOne {
+	duo.mutation.AddVersion(i)
+	return duo
+}
+
+// SetDeletedAt sets the "deleted_at" field.
+func (duo *DebtUpdateOne) SetDeletedAt(t time.Time) *DebtUpdateOne {
+	duo.mutation.SetDeletedAt(t)
+	return duo
+}
+
+// SetNillableDeletedAt sets the "deleted_at" field if the given value is not nil.
+func (duo *DebtUpdateOne) SetNillableDeletedAt(t *time.Time) *DebtUpdateOne {
+	if t != nil {
+		duo.SetDeletedAt(*t)
+	}
+	return duo
+}
+
+// ClearDeletedAt clears the value of the "deleted_at" field.
+func (duo *DebtUpdateOne) ClearDeletedAt() *DebtUpdateOne {
+	duo.mutation.ClearDeletedAt()
+	return duo
+}
+
+// SetAmount sets the "amount" field.
+func (duo *DebtUpdateOne) SetAmount(f float64) *DebtUpdateOne {
+	duo.mutation.ResetAmount()
+	duo.mutation.SetAmount(f)
+	return duo
+}
+
+// SetNillableAmount sets the "amount" field if the given value is not nil.
+func (duo *DebtUpdateOne) SetNillableAmount(f *float64) *DebtUpdateOne {
+	if f != nil {
+		duo.SetAmount(*f)
+	}
+	return duo
+}
+
+// AddAmount adds f to the "amount" field.
+func (duo *DebtUpdateOne) AddAmount(f float64) *DebtUpdateOne {
+	duo.mutation.AddAmount(f)
+	return duo
+}
+
+// SetTitle sets the "title" field.
+func (duo *DebtUpdateOne) SetTitle(s string) *DebtUpdateOne {
+	duo.mutation.SetTitle(s)
+	return duo
+}
+
+// SetNillableTitle sets the "title" field if the given value is not nil.
+func (duo *DebtUpdateOne) SetNillableTitle(s *string) *DebtUpdateOne {
+	if s != nil {
+		duo.SetTitle(*s)
+	}
+	return duo
+}
+
+// SetPurchaseDate sets the "purchase_date" field.
+func (duo *DebtUpdateOne) SetPurchaseDate(t time.Time) *DebtUpdateOne {
+	duo.mutation.SetPurchaseDate(t)
+	return duo
+}
+
+// SetNillablePurchaseDate sets the "purchase_date" field if the given value is not nil.
+func (duo *DebtUpdateOne) SetNillablePurchaseDate(t *time.Time) *DebtUpdateOne {
+	if t != nil {
+		duo.SetPurchaseDate(*t)
+	}
+	return duo
+}
+
+// SetDueDate sets the "due_date" field.
+func (duo *DebtUpdateOne) SetDueDate(t time.Time) *DebtUpdateOne {
+	duo.mutation.SetDueDate(t)
+	return duo
+}
+
+// SetNillableDueDate sets the "due_date" field if the given value is not nil.
+func (duo *DebtUpdateOne) SetNillableDueDate(t *time.Time) *DebtUpdateOne {
+	if t != nil {
+		duo.SetDueDate(*t)
+	}
+	return duo
+}
+
+// SetWorkspaceID sets the "workspace_id" field.
+func (duo *DebtUpdateOne) SetWorkspaceID(u uuid.UUID) *DebtUpdateOne {
+	duo.mutation.SetWorkspaceID(u)
+	return duo
+}
+
+// SetNillableWorkspaceID sets the "workspace_id" field if the given value is not nil.
+func (duo *DebtUpdateOne) SetNillableWorkspaceID(u *uuid.UUID) *DebtUpdateOne {
+	if u != nil {
+		duo.SetWorkspaceID(*u)
+	}
+	return duo
+}
+
+// SetKind sets the "kind" field.
+func (duo *DebtUpdateOne) SetKind(d debt.Kind) *DebtUpdateOne {
+	duo.mutation.SetKind(d)
+	return duo
+}
+
+// SetNillableKind sets the "kind" field if the given value is not nil.
+func (duo *DebtUpdateOne) SetNillableKind(d *debt.Kind) *DebtUpdateOne {
+	if d != nil {
+		duo.SetKind(*d)
+	}
+	return duo
+}
+
+// SetRefundOfID sets the "refund_of_id" field.
+func (duo *DebtUpdateOne) SetRefundOfID(u uuid.UUID) *DebtUpdateOne {
+	duo.mutation.SetRefundOfID(u)
+	return duo
+}
+
+// SetNillableRefundOfID sets the "refund_of_id" field if the given value is not nil.
+func (duo *DebtUpdateOne) SetNillableRefundOfID(u *uuid.UUID) *DebtUpdateOne {
+	if u != nil {
+		duo.SetRefundOfID(*u)
+	}
+	return duo
+}
+
+// ClearRefundOfID clears the value of the "refund_of_id" field.
+func (duo *DebtUpdateOne) ClearRefundOfID() *DebtUpdateOne {
+	duo.mutation.ClearRefundOfID()
+	return duo
+}
+
+// SetInvoiceID sets the "invoice" edge to the Invoice entity by ID.
+func (duo *DebtUpdateOne) SetInvoiceID(id uuid.UUID) *DebtUpdateOne {
+	duo.mutation.SetInvoiceID(id)
+	return duo
+}
 
 // SetNillableInvoiceID sets the "invoice" edge to the Invoice entity by ID if the given value is not nil.
 func (duo *DebtUpdateOne) SetNillableInvoiceID(id *uuid.UUID) *DebtUpdateOne {
@@ -490,6 +1099,76 @@ func (duo *DebtUpdateOne) SetStatus(p *PaymentStatus) *DebtUpdateOne {
 	return duo.SetStatusID(p.ID)
 }
 
+// SetWorkspace sets the "workspace" edge to the Workspace entity.
+func (duo *DebtUpdateOne) SetWorkspace(w *Workspace) *DebtUpdateOne {
+	return duo.SetWorkspaceID(w.ID)
+}
+
+// AddShareIDs adds the "shares" edge to the DebtShare entity by IDs.
+func (duo *DebtUpdateOne) AddShareIDs(ids ...uuid.UUID) *DebtUpdateOne {
+	duo.mutation.AddShareIDs(ids...)
+	return duo
+}
+
+// AddShares adds the "shares" edges to the DebtShare entity.
+func (duo *DebtUpdateOne) AddShares(d ...*DebtShare) *DebtUpdateOne {
+	ids := make([]uuid.UUID, len(d))
+	for i := range d {
+		ids[i] = d[i].ID
+	}
+	return duo.AddShareIDs(ids...)
+}
+
+// AddTagIDs adds the "tags" edge to the Tag entity by IDs.
+func (duo *DebtUpdateOne) AddTagIDs(ids ...uuid.UUID) *DebtUpdateOne {
+	duo.mutation.AddTagIDs(ids...)
+	return duo
+}
+
+// AddTags adds the "tags" edges to the Tag entity.
+func (duo *DebtUpdateOne) AddTags(t ...*Tag) *DebtUpdateOne {
+	ids := make([]uuid.UUID, len(t))
+	for i := range t {
+		ids[i] = t[i].ID
+	}
+	return duo.AddTagIDs(ids...)
+}
+
+// AddAttachmentIDs adds the "attachments" edge to the Attachment entity by IDs.
+func (duo *DebtUpdateOne) AddAttachmentIDs(ids ...uuid.UUID) *DebtUpdateOne {
+	duo.mutation.AddAttachmentIDs(ids...)
+	return duo
+}
+
+// AddAttachments adds the "attachments" edges to the Attachment entity.
+func (duo *DebtUpdateOne) AddAttachments(a ...*Attachment) *DebtUpdateOne {
+	ids := make([]uuid.UUID, len(a))
+	for i := range a {
+		ids[i] = a[i].ID
+	}
+	return duo.AddAttachmentIDs(ids...)
+}
+
+// SetRefundOf sets the "refund_of" edge to the Debt entity.
+func (duo *DebtUpdateOne) SetRefundOf(d *Debt) *DebtUpdateOne {
+	return duo.SetRefundOfID(d.ID)
+}
+
+// AddRefundIDs adds the "refunds" edge to the Debt entity by IDs.
+func (duo *DebtUpdateOne) AddRefundIDs(ids ...uuid.UUID) *DebtUpdateOne {
+	duo.mutation.AddRefundIDs(ids...)
+	return duo
+}
+
+// AddRefunds adds the "refunds" edges to the Debt entity.
+func (duo *DebtUpdateOne) AddRefunds(d ...*Debt) *DebtUpdateOne {
+	ids := make([]uuid.UUID, len(d))
+	for i := range d {
+		ids[i] = d[i].ID
+	}
+	return duo.AddRefundIDs(ids...)
+}
+
 // Mutation returns the DebtMutation object of the builder.
 func (duo *DebtUpdateOne) Mutation() *DebtMutation {
 	return duo.mutation
@@ -513,6 +1192,102 @@ func (duo *DebtUpdateOne) ClearStatus() *DebtUpdateOne {
 	return duo
 }
 
+// ClearWorkspace clears the "workspace" edge to the Workspace entity.
+func (duo *DebtUpdateOne) ClearWorkspace() *DebtUpdateOne {
+	duo.mutation.ClearWorkspace()
+	return duo
+}
+
+// ClearShares clears all "shares" edges to the DebtShare entity.
+func (duo *DebtUpdateOne) ClearShares() *DebtUpdateOne {
+	duo.mutation.ClearShares()
+	return duo
+}
+
+// RemoveShareIDs removes the "shares" edge to DebtShare entities by IDs.
+func (duo *DebtUpdateOne) RemoveShareIDs(ids ...uuid.UUID) *DebtUpdateOne {
+	duo.mutation.RemoveShareIDs(ids...)
+	return duo
+}
+
+// RemoveShares removes "shares" edges to DebtShare entities.
+func (duo *DebtUpdateOne) RemoveShares(d ...*DebtShare) *DebtUpdateOne {
+	ids := make([]uuid.UUID, len(d))
+	for i := range d {
+		ids[i] = d[i].ID
+	}
+	return duo.RemoveShareIDs(ids...)
+}
+
+// ClearTags clears all "tags" edges to the Tag entity.
+func (duo *DebtUpdateOne) ClearTags() *DebtUpdateOne {
+	duo.mutation.ClearTags()
+	return duo
+}
+
+// RemoveTagIDs removes the "tags" edge to Tag entities by IDs.
+func (duo *DebtUpdateOne) RemoveTagIDs(ids ...uuid.UUID) *DebtUpdateOne {
+	duo.mutation.RemoveTagIDs(ids...)
+	return duo
+}
+
+// RemoveTags removes "tags" edges to Tag entities.
+func (duo *DebtUpdateOne) RemoveTags(t ...*Tag) *DebtUpdateOne {
+	ids := make([]uuid.UUID, len(t))
+	for i := range t {
+		ids[i] = t[i].ID
+	}
+	return duo.RemoveTagIDs(ids...)
+}
+
+// ClearAttachments clears all "attachments" edges to the Attachment entity.
+func (duo *DebtUpdateOne) ClearAttachments() *DebtUpdateOne {
+	duo.mutation.ClearAttachments()
+	return duo
+}
+
+// RemoveAttachmentIDs removes the "attachments" edge to Attachment entities by IDs.
+func (duo *DebtUpdateOne) RemoveAttachmentIDs(ids ...uuid.UUID) *DebtUpdateOne {
+	duo.mutation.RemoveAttachmentIDs(ids...)
+	return duo
+}
+
+// RemoveAttachments removes "attachments" edges to Attachment entities.
+func (duo *DebtUpdateOne) RemoveAttachments(a ...*Attachment) *DebtUpdateOne {
+	ids := make([]uuid.UUID, len(a))
+	for i := range a {
+		ids[i] = a[i].ID
+	}
+	return duo.RemoveAttachmentIDs(ids...)
+}
+
+// ClearRefundOf clears the "refund_of" edge to the Debt entity.
+func (duo *DebtUpdateOne) ClearRefundOf() *DebtUpdateOne {
+	duo.mutation.ClearRefundOf()
+	return duo
+}
+
+// ClearRefunds clears all "refunds" edges to the Debt entity.
+func (duo *DebtUpdateOne) ClearRefunds() *DebtUpdateOne {
+	duo.mutation.ClearRefunds()
+	return duo
+}
+
+// RemoveRefundIDs removes the "refunds" edge to Debt entities by IDs.
+func (duo *DebtUpdateOne) RemoveRefundIDs(ids ...uuid.UUID) *DebtUpdateOne {
+	duo.mutation.RemoveRefundIDs(ids...)
+	return duo
+}
+
+// RemoveRefunds removes "refunds" edges to Debt entities.
+func (duo *DebtUpdateOne) RemoveRefunds(d ...*Debt) *DebtUpdateOne {
+	ids := make([]uuid.UUID, len(d))
+	for i := range d {
+		ids[i] = d[i].ID
+	}
+	return duo.RemoveRefundIDs(ids...)
+}
+
 // Where appends a list predicates to the DebtUpdate builder.
 func (duo *DebtUpdateOne) Where(ps ...predicate.Debt) *DebtUpdateOne {
 	duo.mutation.Where(ps...)
@@ -569,6 +1344,14 @@ func (duo *DebtUpdateOne) check() error {
 			return &ValidationError{Name: "title", err: fmt.Errorf(`ent: validator failed for field "Debt.title": %w`, err)}
 		}
 	}
+	if v, ok := duo.mutation.Kind(); ok {
+		if err := debt.KindValidator(v); err != nil {
+			return &ValidationError{Name: "kind", err: fmt.Errorf(`ent: validator failed for field "Debt.kind": %w`, err)}
+		}
+	}
+	if duo.mutation.WorkspaceCleared() && len(duo.mutation.WorkspaceIDs()) > 0 {
+		return errors.New(`ent: clearing a required unique edge "Debt.workspace"`)
+	}
 	return nil
 }
 
@@ -604,6 +1387,18 @@ func (duo *DebtUpdateOne) sqlSave(ctx context.Context) (_node *Debt, err error)
 	if value, ok := duo.mutation.UpdatedAt(); ok {
 		_spec.SetField(debt.FieldUpdatedAt, field.TypeTime, value)
 	}
+	if value, ok := duo.mutation.Version(); ok {
+		_spec.SetField(debt.FieldVersion, field.TypeInt, value)
+	}
+	if value, ok := duo.mutation.AddedVersion(); ok {
+		_spec.AddField(debt.FieldVersion, field.TypeInt, value)
+	}
+	if value, ok := duo.mutation.DeletedAt(); ok {
+		_spec.SetField(debt.FieldDeletedAt, field.TypeTime, value)
+	}
+	if duo.mutation.DeletedAtCleared() {
+		_spec.ClearField(debt.FieldDeletedAt, field.TypeTime)
+	}
 	if value, ok := duo.mutation.Amount(); ok {
 		_spec.SetField(debt.FieldAmount, field.TypeFloat64, value)
 	}
@@ -619,6 +1414,9 @@ func (duo *DebtUpdateOne) sqlSave(ctx context.Context) (_node *Debt, err error)
 	if value, ok := duo.mutation.DueDate(); ok {
 		_spec.SetField(debt.FieldDueDate, field.TypeTime, value)
 	}
+	if value, ok := duo.mutation.Kind(); ok {
+		_spec.SetField(debt.FieldKind, field.TypeEnum, value)
+	}
 	if duo.mutation.InvoiceCleared() {
 		edge := &sqlgraph.EdgeSpec{
 			Rel:     sqlgraph.M2O,
@@ -706,6 +1504,244 @@ func (duo *DebtUpdateOne) sqlSave(ctx context.Context) (_node *Debt, err error)
 		}
 		_spec.Edges.Add = append(_spec.Edges.Add, edge)
 	}
+	if duo.mutation.WorkspaceCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: false,
+			Table:   debt.WorkspaceTable,
+			Columns: []string{debt.WorkspaceColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(workspace.FieldID, field.TypeUUID),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := duo.mutation.WorkspaceIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: false,
+			Table:   debt.WorkspaceTable,
+			Columns: []string{debt.WorkspaceColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(workspace.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if duo.mutation.SharesCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   debt.SharesTable,
+			Columns: []string{debt.SharesColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(debtshare.FieldID, field.TypeUUID),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := duo.mutation.RemovedSharesIDs(); len(nodes) > 0 && !duo.mutation.SharesCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   debt.SharesTable,
+			Columns: []string{debt.SharesColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(debtshare.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := duo.mutation.SharesIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   debt.SharesTable,
+			Columns: []string{debt.SharesColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(debtshare.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if duo.mutation.TagsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2M,
+			Inverse: false,
+			Table:   debt.TagsTable,
+			Columns: debt.TagsPrimaryKey,
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(tag.FieldID, field.TypeUUID),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := duo.mutation.RemovedTagsIDs(); len(nodes) > 0 && !duo.mutation.TagsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2M,
+			Inverse: false,
+			Table:   debt.TagsTable,
+			Columns: debt.TagsPrimaryKey,
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(tag.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := duo.mutation.TagsIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2M,
+			Inverse: false,
+			Table:   debt.TagsTable,
+			Columns: debt.TagsPrimaryKey,
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(tag.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if duo.mutation.AttachmentsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   debt.AttachmentsTable,
+			Columns: []string{debt.AttachmentsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(attachment.FieldID, field.TypeUUID),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := duo.mutation.RemovedAttachmentsIDs(); len(nodes) > 0 && !duo.mutation.AttachmentsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   debt.AttachmentsTable,
+			Columns: []string{debt.AttachmentsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(attachment.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := duo.mutation.AttachmentsIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   debt.AttachmentsTable,
+			Columns: []string{debt.AttachmentsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(attachment.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if duo.mutation.RefundOfCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   debt.RefundOfTable,
+			Columns: []string{debt.RefundOfColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(debt.FieldID, field.TypeUUID),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := duo.mutation.RefundOfIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   debt.RefundOfTable,
+			Columns: []string{debt.RefundOfColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(debt.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if duo.mutation.RefundsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   debt.RefundsTable,
+			Columns: []string{debt.RefundsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(debt.FieldID, field.TypeUUID),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := duo.mutation.RemovedRefundsIDs(); len(nodes) > 0 && !duo.mutation.RefundsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   debt.RefundsTable,
+			Columns: []string{debt.RefundsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(debt.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := duo.mutation.RefundsIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   debt.RefundsTable,
+			Columns: []string{debt.RefundsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(debt.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
 	_node = &Debt{config: duo.config}
 	_spec.Assign = _node.assignValues
 	_spec.ScanValues = _node.scanValues
@@ -0,0 +1,226 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"backend-go/pkg/ent/debt"
+	"backend-go/pkg/ent/debtshare"
+	"backend-go/pkg/ent/person"
+	"fmt"
+	"strings"
+	"time"
+
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/sql"
+	"github.com/google/uuid"
+)
+
+// DebtShare is the model entity for the DebtShare schema.
+type DebtShare struct {
+	config `json:"-"`
+	// ID of the ent.
+	ID uuid.UUID `json:"id,omitempty"`
+	// CreatedAt holds the value of the "created_at" field.
+	CreatedAt time.Time `json:"created_at,omitempty"`
+	// UpdatedAt holds the value of the "updated_at" field.
+	UpdatedAt time.Time `json:"updated_at,omitempty"`
+	// Amount holds the value of the "amount" field.
+	Amount float64 `json:"amount,omitempty"`
+	// Percentage holds the value of the "percentage" field.
+	Percentage *float64 `json:"percentage,omitempty"`
+	// Settled holds the value of the "settled" field.
+	Settled bool `json:"settled,omitempty"`
+	// DebtID holds the value of the "debt_id" field.
+	DebtID uuid.UUID `json:"debt_id,omitempty"`
+	// PersonID holds the value of the "person_id" field.
+	PersonID uuid.UUID `json:"person_id,omitempty"`
+	// Edges holds the relations/edges for other nodes in the graph.
+	// The values are being populated by the DebtShareQuery when eager-loading is set.
+	Edges        DebtShareEdges `json:"edges"`
+	selectValues sql.SelectValues
+}
+
+// DebtShareEdges holds the relations/edges for other nodes in the graph.
+type DebtShareEdges struct {
+	// Debt holds the value of the debt edge.
+	Debt *Debt `json:"debt,omitempty"`
+	// Person holds the value of the person edge.
+	Person *Person `json:"person,omitempty"`
+	// loadedTypes holds the information for reporting if a
+	// type was loaded (or requested) in eager-loading or not.
+	loadedTypes [2]bool
+}
+
+// DebtOrErr returns the Debt value or an error if the edge
+// was not loaded in eager-loading, or loaded but was not found.
+func (e DebtShareEdges) DebtOrErr() (*Debt, error) {
+	if e.Debt != nil {
+		return e.Debt, nil
+	} else if e.loadedTypes[0] {
+		return nil, &NotFoundError{label: debt.Label}
+	}
+	return nil, &NotLoadedError{edge: "debt"}
+}
+
+// PersonOrErr returns the Person value or an error if the edge
+// was not loaded in eager-loading, or loaded but was not found.
+func (e DebtShareEdges) PersonOrErr() (*Person, error) {
+	if e.Person != nil {
+		return e.Person, nil
+	} else if e.loadedTypes[1] {
+		return nil, &NotFoundError{label: person.Label}
+	}
+	return nil, &NotLoadedError{edge: "person"}
+}
+
+// scanValues returns the types for scanning values from sql.Rows.
+func (*DebtShare) scanValues(columns []string) ([]any, error) {
+	values := make([]any, len(columns))
+	for i := range columns {
+		switch columns[i] {
+		case debtshare.FieldSettled:
+			values[i] = new(sql.NullBool)
+		case debtshare.FieldAmount, debtshare.FieldPercentage:
+			values[i] = new(sql.NullFloat64)
+		case debtshare.FieldCreatedAt, debtshare.FieldUpdatedAt:
+			values[i] = new(sql.NullTime)
+		case debtshare.FieldID, debtshare.FieldDebtID, debtshare.FieldPersonID:
+			values[i] = new(uuid.UUID)
+		default:
+			values[i] = new(sql.UnknownType)
+		}
+	}
+	return values, nil
+}
+
+// assignValues assigns the values that were returned from sql.Rows (after scanning)
+// to the DebtShare fields.
+func (ds *DebtShare) assignValues(columns []string, values []any) error {
+	if m, n := len(values), len(columns); m < n {
+		return fmt.Errorf("mismatch number of scan values: %d != %d", m, n)
+	}
+	for i := range columns {
+		switch columns[i] {
+		case debtshare.FieldID:
+			if value, ok := values[i].(*uuid.UUID); !ok {
+				return fmt.Errorf("unexpected type %T for field id", values[i])
+			} else if value != nil {
+				ds.ID = *value
+			}
+		case debtshare.FieldCreatedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field created_at", values[i])
+			} else if value.Valid {
+				ds.CreatedAt = value.Time
+			}
+		case debtshare.FieldUpdatedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field updated_at", values[i])
+			} else if value.Valid {
+				ds.UpdatedAt = value.Time
+			}
+		case debtshare.FieldAmount:
+			if value, ok := values[i].(*sql.NullFloat64); !ok {
+				return fmt.Errorf("unexpected type %T for field amount", values[i])
+			} else if value.Valid {
+				ds.Amount = value.Float64
+			}
+		case debtshare.FieldPercentage:
+			if value, ok := values[i].(*sql.NullFloat64); !ok {
+				return fmt.Errorf("unexpected type %T for field percentage", values[i])
+			} else if value.Valid {
+				ds.Percentage = new(float64)
+				*ds.Percentage = value.Float64
+			}
+		case debtshare.FieldSettled:
+			if value, ok := values[i].(*sql.NullBool); !ok {
+				return fmt.Errorf("unexpected type %T for field settled", values[i])
+			} else if value.Valid {
+				ds.Settled = value.Bool
+			}
+		case debtshare.FieldDebtID:
+			if value, ok := values[i].(*uuid.UUID); !ok {
+				return fmt.Errorf("unexpected type %T for field debt_id", values[i])
+			} else if value != nil {
+				ds.DebtID = *value
+			}
+		case debtshare.FieldPersonID:
+			if value, ok := values[i].(*uuid.UUID); !ok {
+				return fmt.Errorf("unexpected type %T for field person_id", values[i])
+			} else if value != nil {
+				ds.PersonID = *value
+			}
+		default:
+			ds.selectValues.Set(columns[i], values[i])
+		}
+	}
+	return nil
+}
+
+// Value returns the ent.Value that was dynamically selected and assigned to the DebtShare.
+// This includes values selected through modifiers, order, etc.
+func (ds *DebtShare) Value(name string) (ent.Value, error) {
+	return ds.selectValues.Get(name)
+}
+
+// QueryDebt queries the "debt" edge of the DebtShare entity.
+func (ds *DebtShare) QueryDebt() *DebtQuery {
+	return NewDebtShareClient(ds.config).QueryDebt(ds)
+}
+
+// QueryPerson queries the "person" edge of the DebtShare entity.
+func (ds *DebtShare) QueryPerson() *PersonQuery {
+	return NewDebtShareClient(ds.config).QueryPerson(ds)
+}
+
+// Update returns a builder for updating this DebtShare.
+// Note that you need to call DebtShare.Unwrap() before calling this method if this DebtShare
+// was returned from a transaction, and the transaction was committed or rolled back.
+func (ds *DebtShare) Update() *DebtShareUpdateOne {
+	return NewDebtShareClient(ds.config).UpdateOne(ds)
+}
+
+// Unwrap unwraps the DebtShare entity that was returned from a transaction after it was closed,
+// so that all future queries will be executed through the driver which created the transaction.
+func (ds *DebtShare) Unwrap() *DebtShare {
+	_tx, ok := ds.config.driver.(*txDriver)
+	if !ok {
+		panic("ent: DebtShare is not a transactional entity")
+	}
+	ds.config.driver = _tx.drv
+	return ds
+}
+
+// String implements the fmt.Stringer.
+func (ds *DebtShare) String() string {
+	var builder strings.Builder
+	builder.WriteString("DebtShare(")
+	builder.WriteString(fmt.Sprintf("id=%v, ", ds.ID))
+	builder.WriteString("created_at=")
+	builder.WriteString(ds.CreatedAt.Format(time.ANSIC))
+	builder.WriteString(", ")
+	builder.WriteString("updated_at=")
+	builder.WriteString(ds.UpdatedAt.Format(time.ANSIC))
+	builder.WriteString(", ")
+	builder.WriteString("amount=")
+	builder.WriteString(fmt.Sprintf("%v", ds.Amount))
+	builder.WriteString(", ")
+	if v := ds.Percentage; v != nil {
+		builder.WriteString("percentage=")
+		builder.WriteString(fmt.Sprintf("%v", *v))
+	}
+	builder.WriteString(", ")
+	builder.WriteString("settled=")
+	builder.WriteString(fmt.Sprintf("%v", ds.Settled))
+	builder.WriteString(", ")
+	builder.WriteString("debt_id=")
+	builder.WriteString(fmt.Sprintf("%v", ds.DebtID))
+	builder.WriteString(", ")
+	builder.WriteString("person_id=")
+	builder.WriteString(fmt.Sprintf("%v", ds.PersonID))
+	builder.WriteByte(')')
+	return builder.String()
+}
+
+// DebtShares is a parsable slice of DebtShare.
+type DebtShares []*DebtShare
@@ -0,0 +1,373 @@
+// Code generated by ent, DO NOT EDIT.
+
+package debtshare
+
+import (
+	"backend-go/pkg/ent/predicate"
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"github.com/google/uuid"
+)
+
+// ID filters vertices based on their ID field.
+func ID(id uuid.UUID) predicate.DebtShare {
+	return predicate.DebtShare(sql.FieldEQ(FieldID, id))
+}
+
+// IDEQ applies the EQ predicate on the ID field.
+func IDEQ(id uuid.UUID) predicate.DebtShare {
+	return predicate.DebtShare(sql.FieldEQ(FieldID, id))
+}
+
+// IDNEQ applies the NEQ predicate on the ID field.
+func IDNEQ(id uuid.UUID) predicate.DebtShare {
+	return predicate.DebtShare(sql.FieldNEQ(FieldID, id))
+}
+
+// IDIn applies the In predicate on the ID field.
+func IDIn(ids ...uuid.UUID) predicate.DebtShare {
+	return predicate.DebtShare(sql.FieldIn(FieldID, ids...))
+}
+
+// IDNotIn applies the NotIn predicate on the ID field.
+func IDNotIn(ids ...uuid.UUID) predicate.DebtShare {
+	return predicate.DebtShare(sql.FieldNotIn(FieldID, ids...))
+}
+
+// IDGT applies the GT predicate on the ID field.
+func IDGT(id uuid.UUID) predicate.DebtShare {
+	return predicate.DebtShare(sql.FieldGT(FieldID, id))
+}
+
+// IDGTE applies the GTE predicate on the ID field.
+func IDGTE(id uuid.UUID) predicate.DebtShare {
+	return predicate.DebtShare(sql.FieldGTE(FieldID, id))
+}
+
+// IDLT applies the LT predicate on the ID field.
+func IDLT(id uuid.UUID) predicate.DebtShare {
+	return predicate.DebtShare(sql.FieldLT(FieldID, id))
+}
+
+// IDLTE applies the LTE predicate on the ID field.
+func IDLTE(id uuid.UUID) predicate.DebtShare {
+	return predicate.DebtShare(sql.FieldLTE(FieldID, id))
+}
+
+// CreatedAt applies equality check predicate on the "created_at" field. It's identical to CreatedAtEQ.
+func CreatedAt(v time.Time) predicate.DebtShare {
+	return predicate.DebtShare(sql.FieldEQ(FieldCreatedAt, v))
+}
+
+// UpdatedAt applies equality check predicate on the "updated_at" field. It's identical to UpdatedAtEQ.
+func UpdatedAt(v time.Time) predicate.DebtShare {
+	return predicate.DebtShare(sql.FieldEQ(FieldUpdatedAt, v))
+}
+
+// Amount applies equality check predicate on the "amount" field. It's identical to AmountEQ.
+func Amount(v float64) predicate.DebtShare {
+	return predicate.DebtShare(sql.FieldEQ(FieldAmount, v))
+}
+
+// Percentage applies equality check predicate on the "percentage" field. It's identical to PercentageEQ.
+func Percentage(v float64) predicate.DebtShare {
+	return predicate.DebtShare(sql.FieldEQ(FieldPercentage, v))
+}
+
+// Settled applies equality check predicate on the "settled" field. It's identical to SettledEQ.
+func Settled(v bool) predicate.DebtShare {
+	return predicate.DebtShare(sql.FieldEQ(FieldSettled, v))
+}
+
+// DebtID applies equality check predicate on the "debt_id" field. It's identical to DebtIDEQ.
+func DebtID(v uuid.UUID) predicate.DebtShare {
+	return predicate.DebtShare(sql.FieldEQ(FieldDebtID, v))
+}
+
+// PersonID applies equality check predicate on the "person_id" field. It's identical to PersonIDEQ.
+func PersonID(v uuid.UUID) predicate.DebtShare {
+	return predicate.DebtShare(sql.FieldEQ(FieldPersonID, v))
+}
+
+// CreatedAtEQ applies the EQ predicate on the "created_at" field.
+func CreatedAtEQ(v time.Time) predicate.DebtShare {
+	return predicate.DebtShare(sql.FieldEQ(FieldCreatedAt, v))
+}
+
+// CreatedAtNEQ applies the NEQ predicate on the "created_at" field.
+func CreatedAtNEQ(v time.Time) predicate.DebtShare {
+	return predicate.DebtShare(sql.FieldNEQ(FieldCreatedAt, v))
+}
+
+// CreatedAtIn applies the In predicate on the "created_at" field.
+func CreatedAtIn(vs ...time.Time) predicate.DebtShare {
+	return predicate.DebtShare(sql.FieldIn(FieldCreatedAt, vs...))
+}
+
+// CreatedAtNotIn applies the NotIn predicate on the "created_at" field.
+func CreatedAtNotIn(vs ...time.Time) predicate.DebtShare {
+	return predicate.DebtShare(sql.FieldNotIn(FieldCreatedAt, vs...))
+}
+
+// CreatedAtGT applies the GT predicate on the "created_at" field.
+func CreatedAtGT(v time.Time) predicate.DebtShare {
+	return predicate.DebtShare(sql.FieldGT(FieldCreatedAt, v))
+}
+
+// CreatedAtGTE applies the GTE predicate on the "created_at" field.
+func CreatedAtGTE(v time.Time) predicate.DebtShare {
+	return predicate.DebtShare(sql.FieldGTE(FieldCreatedAt, v))
+}
+
+// CreatedAtLT applies the LT predicate on the "created_at" field.
+func CreatedAtLT(v time.Time) predicate.DebtShare {
+	return predicate.DebtShare(sql.FieldLT(FieldCreatedAt, v))
+}
+
+// CreatedAtLTE applies the LTE predicate on the "created_at" field.
+func CreatedAtLTE(v time.Time) predicate.DebtShare {
+	return predicate.DebtShare(sql.FieldLTE(FieldCreatedAt, v))
+}
+
+// UpdatedAtEQ applies the EQ predicate on the "updated_at" field.
+func UpdatedAtEQ(v time.Time) predicate.DebtShare {
+	return predicate.DebtShare(sql.FieldEQ(FieldUpdatedAt, v))
+}
+
+// UpdatedAtNEQ applies the NEQ predicate on the "updated_at" field.
+func UpdatedAtNEQ(v time.Time) predicate.DebtShare {
+	return predicate.DebtShare(sql.FieldNEQ(FieldUpdatedAt, v))
+}
+
+// UpdatedAtIn applies the In predicate on the "updated_at" field.
+func UpdatedAtIn(vs ...time.Time) predicate.DebtShare {
+	return predicate.DebtShare(sql.FieldIn(FieldUpdatedAt, vs...))
+}
+
+// UpdatedAtNotIn applies the NotIn predicate on the "updated_at" field.
+func UpdatedAtNotIn(vs ...time.Time) predicate.DebtShare {
+	return predicate.DebtShare(sql.FieldNotIn(FieldUpdatedAt, vs...))
+}
+
+// UpdatedAtGT applies the GT predicate on the "updated_at" field.
+func UpdatedAtGT(v time.Time) predicate.DebtShare {
+	return predicate.DebtShare(sql.FieldGT(FieldUpdatedAt, v))
+}
+
+// UpdatedAtGTE applies the GTE predicate on the "updated_at" field.
+func UpdatedAtGTE(v time.Time) predicate.DebtShare {
+	return predicate.DebtShare(sql.FieldGTE(FieldUpdatedAt, v))
+}
+
+// UpdatedAtLT applies the LT predicate on the "updated_at" field.
+func UpdatedAtLT(v time.Time) predicate.DebtShare {
+	return predicate.DebtShare(sql.FieldLT(FieldUpdatedAt, v))
+}
+
+// UpdatedAtLTE applies the LTE predicate on the "updated_at" field.
+func UpdatedAtLTE(v time.Time) predicate.DebtShare {
+	return predicate.DebtShare(sql.FieldLTE(FieldUpdatedAt, v))
+}
+
+// AmountEQ applies the EQ predicate on the "amount" field.
+func AmountEQ(v float64) predicate.DebtShare {
+	return predicate.DebtShare(sql.FieldEQ(FieldAmount, v))
+}
+
+// AmountNEQ applies the NEQ predicate on the "amount" field.
+func AmountNEQ(v float64) predicate.DebtShare {
+	return predicate.DebtShare(sql.FieldNEQ(FieldAmount, v))
+}
+
+// AmountIn applies the In predicate on the "amount" field.
+func AmountIn(vs ...float64) predicate.DebtShare {
+	return predicate.DebtShare(sql.FieldIn(FieldAmount, vs...))
+}
+
+// AmountNotIn applies the NotIn predicate on the "amount" field.
+func AmountNotIn(vs ...float64) predicate.DebtShare {
+	return predicate.DebtShare(sql.FieldNotIn(FieldAmount, vs...))
+}
+
+// AmountGT applies the GT predicate on the "amount" field.
+func AmountGT(v float64) predicate.DebtShare {
+	return predicate.DebtShare(sql.FieldGT(FieldAmount, v))
+}
+
+// AmountGTE applies the GTE predicate on the "amount" field.
+func AmountGTE(v float64) predicate.DebtShare {
+	return predicate.DebtShare(sql.FieldGTE(FieldAmount, v))
+}
+
+// AmountLT applies the LT predicate on the "amount" field.
+func AmountLT(v float64) predicate.DebtShare {
+	return predicate.DebtShare(sql.FieldLT(FieldAmount, v))
+}
+
+// AmountLTE applies the LTE predicate on the "amount" field.
+func AmountLTE(v float64) predicate.DebtShare {
+	return predicate.DebtShare(sql.FieldLTE(FieldAmount, v))
+}
+
+// PercentageEQ applies the EQ predicate on the "percentage" field.
+func PercentageEQ(v float64) predicate.DebtShare {
+	return predicate.DebtShare(sql.FieldEQ(FieldPercentage, v))
+}
+
+// PercentageNEQ applies the NEQ predicate on the "percentage" field.
+func PercentageNEQ(v float64) predicate.DebtShare {
+	return predicate.DebtShare(sql.FieldNEQ(FieldPercentage, v))
+}
+
+// PercentageIn applies the In predicate on the "percentage" field.
+func PercentageIn(vs ...float64) predicate.DebtShare {
+	return predicate.DebtShare(sql.FieldIn(FieldPercentage, vs...))
+}
+
+// PercentageNotIn applies the NotIn predicate on the "percentage" field.
+func PercentageNotIn(vs ...float64) predicate.DebtShare {
+	return predicate.DebtShare(sql.FieldNotIn(FieldPercentage, vs...))
+}
+
+// PercentageGT applies the GT predicate on the "percentage" field.
+func PercentageGT(v float64) predicate.DebtShare {
+	return predicate.DebtShare(sql.FieldGT(FieldPercentage, v))
+}
+
+// PercentageGTE applies the GTE predicate on the "percentage" field.
+func PercentageGTE(v float64) predicate.DebtShare {
+	return predicate.DebtShare(sql.FieldGTE(FieldPercentage, v))
+}
+
+// PercentageLT applies the LT predicate on the "percentage" field.
+func PercentageLT(v float64) predicate.DebtShare {
+	return predicate.DebtShare(sql.FieldLT(FieldPercentage, v))
+}
+
+// PercentageLTE applies the LTE predicate on the "percentage" field.
+func PercentageLTE(v float64) predicate.DebtShare {
+	return predicate.DebtShare(sql.FieldLTE(FieldPercentage, v))
+}
+
+// PercentageIsNil applies the IsNil predicate on the "percentage" field.
+func PercentageIsNil() predicate.DebtShare {
+	return predicate.DebtShare(sql.FieldIsNull(FieldPercentage))
+}
+
+// PercentageNotNil applies the NotNil predicate on the "percentage" field.
+func PercentageNotNil() predicate.DebtShare {
+	return predicate.DebtShare(sql.FieldNotNull(FieldPercentage))
+}
+
+// SettledEQ applies the EQ predicate on the "settled" field.
+func SettledEQ(v bool) predicate.DebtShare {
+	return predicate.DebtShare(sql.FieldEQ(FieldSettled, v))
+}
+
+// SettledNEQ applies the NEQ predicate on the "settled" field.
+func SettledNEQ(v bool) predicate.DebtShare {
+	return predicate.DebtShare(sql.FieldNEQ(FieldSettled, v))
+}
+
+// DebtIDEQ applies the EQ predicate on the "debt_id" field.
+func DebtIDEQ(v uuid.UUID) predicate.DebtShare {
+	return predicate.DebtShare(sql.FieldEQ(FieldDebtID, v))
+}
+
+// DebtIDNEQ applies the NEQ predicate on the "debt_id" field.
+func DebtIDNEQ(v uuid.UUID) predicate.DebtShare {
+	return predicate.DebtShare(sql.FieldNEQ(FieldDebtID, v))
+}
+
+// DebtIDIn applies the In predicate on the "debt_id" field.
+func DebtIDIn(vs ...uuid.UUID) predicate.DebtShare {
+	return predicate.DebtShare(sql.FieldIn(FieldDebtID, vs...))
+}
+
+// DebtIDNotIn applies the NotIn predicate on the "debt_id" field.
+func DebtIDNotIn(vs ...uuid.UUID) predicate.DebtShare {
+	return predicate.DebtShare(sql.FieldNotIn(FieldDebtID, vs...))
+}
+
+// PersonIDEQ applies the EQ predicate on the "person_id" field.
+func PersonIDEQ(v uuid.UUID) predicate.DebtShare {
+	return predicate.DebtShare(sql.FieldEQ(FieldPersonID, v))
+}
+
+// PersonIDNEQ applies the NEQ predicate on the "person_id" field.
+func PersonIDNEQ(v uuid.UUID) predicate.DebtShare {
+	return predicate.DebtShare(sql.FieldNEQ(FieldPersonID, v))
+}
+
+// PersonIDIn applies the In predicate on the "person_id" field.
+func PersonIDIn(vs ...uuid.UUID) predicate.DebtShare {
+	return predicate.DebtShare(sql.FieldIn(FieldPersonID, vs...))
+}
+
+// PersonIDNotIn applies the NotIn predicate on the "person_id" field.
+func PersonIDNotIn(vs ...uuid.UUID) predicate.DebtShare {
+	return predicate.DebtShare(sql.FieldNotIn(FieldPersonID, vs...))
+}
+
+// HasDebt applies the HasEdge predicate on the "debt" edge.
+func HasDebt() predicate.DebtShare {
+	return predicate.DebtShare(func(s *sql.Selector) {
+		step := sqlgraph.NewStep(
+			sqlgraph.From(Table, FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, DebtTable, DebtColumn),
+		)
+		sqlgraph.HasNeighbors(s, step)
+	})
+}
+
+// HasDebtWith applies the HasEdge predicate on the "debt" edge with a given conditions (other predicates).
+func HasDebtWith(preds ...predicate.Debt) predicate.DebtShare {
+	return predicate.DebtShare(func(s *sql.Selector) {
+		step := newDebtStep()
+		sqlgraph.HasNeighborsWith(s, step, func(s *sql.Selector) {
+			for _, p := range preds {
+				p(s)
+			}
+		})
+	})
+}
+
+// HasPerson applies the HasEdge predicate on the "person" edge.
+func HasPerson() predicate.DebtShare {
+	return predicate.DebtShare(func(s *sql.Selector) {
+		step := sqlgraph.NewStep(
+			sqlgraph.From(Table, FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, false, PersonTable, PersonColumn),
+		)
+		sqlgraph.HasNeighbors(s, step)
+	})
+}
+
+// HasPersonWith applies the HasEdge predicate on the "person" edge with a given conditions (other predicates).
+func HasPersonWith(preds ...predicate.Person) predicate.DebtShare {
+	return predicate.DebtShare(func(s *sql.Selector) {
+		step := newPersonStep()
+		sqlgraph.HasNeighborsWith(s, step, func(s *sql.Selector) {
+			for _, p := range preds {
+				p(s)
+			}
+		})
+	})
+}
+
+// And groups predicates with the AND operator between them.
+func And(predicates ...predicate.DebtShare) predicate.DebtShare {
+	return predicate.DebtShare(sql.AndPredicates(predicates...))
+}
+
+// Or groups predicates with the OR operator between them.
+func Or(predicates ...predicate.DebtShare) predicate.DebtShare {
+	return predicate.DebtShare(sql.OrPredicates(predicates...))
+}
+
+// Not applies the not operator on the given predicate.
+func Not(p predicate.DebtShare) predicate.DebtShare {
+	return predicate.DebtShare(sql.NotPredicates(p))
+}
@@ -0,0 +1,377 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"backend-go/pkg/ent/debt"
+	"backend-go/pkg/ent/debtshare"
+	"backend-go/pkg/ent/person"
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/google/uuid"
+)
+
+// DebtShareCreate is the builder for creating a DebtShare entity.
+type DebtShareCreate struct {
+	config
+	mutation *DebtShareMutation
+	hooks    []Hook
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (dsc *DebtShareCreate) SetCreatedAt(t time.Time) *DebtShareCreate {
+	dsc.mutation.SetCreatedAt(t)
+	return dsc
+}
+
+// SetNillableCreatedAt sets the "created_at" field if the given value is not nil.
+func (dsc *DebtShareCreate) SetNillableCreatedAt(t *time.Time) *DebtShareCreate {
+	if t != nil {
+		dsc.SetCreatedAt(*t)
+	}
+	return dsc
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (dsc *DebtShareCreate) SetUpdatedAt(t time.Time) *DebtShareCreate {
+	dsc.mutation.SetUpdatedAt(t)
+	return dsc
+}
+
+// SetNillableUpdatedAt sets the "updated_at" field if the given value is not nil.
+func (dsc *DebtShareCreate) SetNillableUpdatedAt(t *time.Time) *DebtShareCreate {
+	if t != nil {
+		dsc.SetUpdatedAt(*t)
+	}
+	return dsc
+}
+
+// SetAmount sets the "amount" field.
+func (dsc *DebtShareCreate) SetAmount(f float64) *DebtShareCreate {
+	dsc.mutation.SetAmount(f)
+	return dsc
+}
+
+// SetPercentage sets the "percentage" field.
+func (dsc *DebtShareCreate) SetPercentage(f float64) *DebtShareCreate {
+	dsc.mutation.SetPercentage(f)
+	return dsc
+}
+
+// SetNillablePercentage sets the "percentage" field if the given value is not nil.
+func (dsc *DebtShareCreate) SetNillablePercentage(f *float64) *DebtShareCreate {
+	if f != nil {
+		dsc.SetPercentage(*f)
+	}
+	return dsc
+}
+
+// SetSettled sets the "settled" field.
+func (dsc *DebtShareCreate) SetSettled(b bool) *DebtShareCreate {
+	dsc.mutation.SetSettled(b)
+	return dsc
+}
+
+// SetNillableSettled sets the "settled" field if the given value is not nil.
+func (dsc *DebtShareCreate) SetNillableSettled(b *bool) *DebtShareCreate {
+	if b != nil {
+		dsc.SetSettled(*b)
+	}
+	return dsc
+}
+
+// SetDebtID sets the "debt_id" field.
+func (dsc *DebtShareCreate) SetDebtID(u uuid.UUID) *DebtShareCreate {
+	dsc.mutation.SetDebtID(u)
+	return dsc
+}
+
+// SetPersonID sets the "person_id" field.
+func (dsc *DebtShareCreate) SetPersonID(u uuid.UUID) *DebtShareCreate {
+	dsc.mutation.SetPersonID(u)
+	return dsc
+}
+
+// SetID sets the "id" field.
+func (dsc *DebtShareCreate) SetID(u uuid.UUID) *DebtShareCreate {
+	dsc.mutation.SetID(u)
+	return dsc
+}
+
+// SetNillableID sets the "id" field if the given value is not nil.
+func (dsc *DebtShareCreate) SetNillableID(u *uuid.UUID) *DebtShareCreate {
+	if u != nil {
+		dsc.SetID(*u)
+	}
+	return dsc
+}
+
+// SetDebt sets the "debt" edge to the Debt entity.
+func (dsc *DebtShareCreate) SetDebt(d *Debt) *DebtShareCreate {
+	return dsc.SetDebtID(d.ID)
+}
+
+// SetPerson sets the "person" edge to the Person entity.
+func (dsc *DebtShareCreate) SetPerson(p *Person) *DebtShareCreate {
+	return dsc.SetPersonID(p.ID)
+}
+
+// Mutation returns the DebtShareMutation object of the builder.
+func (dsc *DebtShareCreate) Mutation() *DebtShareMutation {
+	return dsc.mutation
+}
+
+// Save creates the DebtShare in the database.
+func (dsc *DebtShareCreate) Save(ctx context.Context) (*DebtShare, error) {
+	dsc.defaults()
+	return withHooks(ctx, dsc.sqlSave, dsc.mutation, dsc.hooks)
+}
+
+// SaveX calls Save and panics if Save returns an error.
+func (dsc *DebtShareCreate) SaveX(ctx context.Context) *DebtShare {
+	v, err := dsc.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (dsc *DebtShareCreate) Exec(ctx context.Context) error {
+	_, err := dsc.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (dsc *DebtShareCreate) ExecX(ctx context.Context) {
+	if err := dsc.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (dsc *DebtShareCreate) defaults() {
+	if _, ok := dsc.mutation.CreatedAt(); !ok {
+		v := debtshare.DefaultCreatedAt()
+		dsc.mutation.SetCreatedAt(v)
+	}
+	if _, ok := dsc.mutation.UpdatedAt(); !ok {
+		v := debtshare.DefaultUpdatedAt()
+		dsc.mutation.SetUpdatedAt(v)
+	}
+	if _, ok := dsc.mutation.Settled(); !ok {
+		v := debtshare.DefaultSettled
+		dsc.mutation.SetSettled(v)
+	}
+	if _, ok := dsc.mutation.ID(); !ok {
+		v := debtshare.DefaultID()
+		dsc.mutation.SetID(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (dsc *DebtShareCreate) check() error {
+	if _, ok := dsc.mutation.CreatedAt(); !ok {
+		return &ValidationError{Name: "created_at", err: errors.New(`ent: missing required field "DebtShare.created_at"`)}
+	}
+	if _, ok := dsc.mutation.UpdatedAt(); !ok {
+		return &ValidationError{Name: "updated_at", err: errors.New(`ent: missing required field "DebtShare.updated_at"`)}
+	}
+	if _, ok := dsc.mutation.Amount(); !ok {
+		return &ValidationError{Name: "amount", err: errors.New(`ent: missing required field "DebtShare.amount"`)}
+	}
+	if _, ok := dsc.mutation.Settled(); !ok {
+		return &ValidationError{Name: "settled", err: errors.New(`ent: missing required field "DebtShare.settled"`)}
+	}
+	if _, ok := dsc.mutation.DebtID(); !ok {
+		return &ValidationError{Name: "debt_id", err: errors.New(`ent: missing required field "DebtShare.debt_id"`)}
+	}
+	if _, ok := dsc.mutation.PersonID(); !ok {
+		return &ValidationError{Name: "person_id", err: errors.New(`ent: missing required field "DebtShare.person_id"`)}
+	}
+	if len(dsc.mutation.DebtIDs()) == 0 {
+		return &ValidationError{Name: "debt", err: errors.New(`ent: missing required edge "DebtShare.debt"`)}
+	}
+	if len(dsc.mutation.PersonIDs()) == 0 {
+		return &ValidationError{Name: "person", err: errors.New(`ent: missing required edge "DebtShare.person"`)}
+	}
+	return nil
+}
+
+func (dsc *DebtShareCreate) sqlSave(ctx context.Context) (*DebtShare, error) {
+	if err := dsc.check(); err != nil {
+		return nil, err
+	}
+	_node, _spec := dsc.createSpec()
+	if err := sqlgraph.CreateNode(ctx, dsc.driver, _spec); err != nil {
+		if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	if _spec.ID.Value != nil {
+		if id, ok := _spec.ID.Value.(*uuid.UUID); ok {
+			_node.ID = *id
+		} else if err := _node.ID.Scan(_spec.ID.Value); err != nil {
+			return nil, err
+		}
+	}
+	dsc.mutation.id = &_node.ID
+	dsc.mutation.done = true
+	return _node, nil
+}
+
+func (dsc *DebtShareCreate) createSpec() (*DebtShare, *sqlgraph.CreateSpec) {
+	var (
+		_node = &DebtShare{config: dsc.config}
+		_spec = sqlgraph.NewCreateSpec(debtshare.Table, sqlgraph.NewFieldSpec(debtshare.FieldID, field.TypeUUID))
+	)
+	if id, ok := dsc.mutation.ID(); ok {
+		_node.ID = id
+		_spec.ID.Value = &id
+	}
+	if value, ok := dsc.mutation.CreatedAt(); ok {
+		_spec.SetField(debtshare.FieldCreatedAt, field.TypeTime, value)
+		_node.CreatedAt = value
+	}
+	if value, ok := dsc.mutation.UpdatedAt(); ok {
+		_spec.SetField(debtshare.FieldUpdatedAt, field.TypeTime, value)
+		_node.UpdatedAt = value
+	}
+	if value, ok := dsc.mutation.Amount(); ok {
+		_spec.SetField(debtshare.FieldAmount, field.TypeFloat64, value)
+		_node.Amount = value
+	}
+	if value, ok := dsc.mutation.Percentage(); ok {
+		_spec.SetField(debtshare.FieldPercentage, field.TypeFloat64, value)
+		_node.Percentage = &value
+	}
+	if value, ok := dsc.mutation.Settled(); ok {
+		_spec.SetField(debtshare.FieldSettled, field.TypeBool, value)
+		_node.Settled = value
+	}
+	if nodes := dsc.mutation.DebtIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   debtshare.DebtTable,
+			Columns: []string{debtshare.DebtColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(debt.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_node.DebtID = nodes[0]
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	if nodes := dsc.mutation.PersonIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: false,
+			Table:   debtshare.PersonTable,
+			Columns: []string{debtshare.PersonColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(person.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_node.PersonID = nodes[0]
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	return _node, _spec
+}
+
+// DebtShareCreateBulk is the builder for creating many DebtShare entities in bulk.
+type DebtShareCreateBulk struct {
+	config
+	err      error
+	builders []*DebtShareCreate
+}
+
+// Save creates the DebtShare entities in the database.
+func (dscb *DebtShareCreateBulk) Save(ctx context.Context) ([]*DebtShare, error) {
+	if dscb.err != nil {
+		return nil, dscb.err
+	}
+	specs := make([]*sqlgraph.CreateSpec, len(dscb.builders))
+	nodes := make([]*DebtShare, len(dscb.builders))
+	mutators := make([]Mutator, len(dscb.builders))
+	for i := range dscb.builders {
+		func(i int, root context.Context) {
+			builder := dscb.builders[i]
+			builder.defaults()
+			var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+				mutation, ok := m.(*DebtShareMutation)
+				if !ok {
+					return nil, fmt.Errorf("unexpected mutation type %T", m)
+				}
+				if err := builder.check(); err != nil {
+					return nil, err
+				}
+				builder.mutation = mutation
+				var err error
+				nodes[i], specs[i] = builder.createSpec()
+				if i < len(mutators)-1 {
+					_, err = mutators[i+1].Mutate(root, dscb.builders[i+1].mutation)
+				} else {
+					spec := &sqlgraph.BatchCreateSpec{Nodes: specs}
+					// Invoke the actual operation on the latest mutation in the chain.
+					if err = sqlgraph.BatchCreate(ctx, dscb.driver, spec); err != nil {
+						if sqlgraph.IsConstraintError(err) {
+							err = &ConstraintError{msg: err.Error(), wrap: err}
+						}
+					}
+				}
+				if err != nil {
+					return nil, err
+				}
+				mutation.id = &nodes[i].ID
+				mutation.done = true
+				return nodes[i], nil
+			})
+			for i := len(builder.hooks) - 1; i >= 0; i-- {
+				mut = builder.hooks[i](mut)
+			}
+			mutators[i] = mut
+		}(i, ctx)
+	}
+	if len(mutators) > 0 {
+		if _, err := mutators[0].Mutate(ctx, dscb.builders[0].mutation); err != nil {
+			return nil, err
+		}
+	}
+	return nodes, nil
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (dscb *DebtShareCreateBulk) SaveX(ctx context.Context) []*DebtShare {
+	v, err := dscb.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (dscb *DebtShareCreateBulk) Exec(ctx context.Context) error {
+	_, err := dscb.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (dscb *DebtShareCreateBulk) ExecX(ctx context.Context) {
+	if err := dscb.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
@@ -0,0 +1,619 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"backend-go/pkg/ent/debt"
+	"backend-go/pkg/ent/debtshare"
+	"backend-go/pkg/ent/person"
+	"backend-go/pkg/ent/predicate"
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/google/uuid"
+)
+
+// DebtShareUpdate is the builder for updating DebtShare entities.
+type DebtShareUpdate struct {
+	config
+	hooks    []Hook
+	mutation *DebtShareMutation
+}
+
+// Where appends a list predicates to the DebtShareUpdate builder.
+func (dsu *DebtShareUpdate) Where(ps ...predicate.DebtShare) *DebtShareUpdate {
+	dsu.mutation.Where(ps...)
+	return dsu
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (dsu *DebtShareUpdate) SetUpdatedAt(t time.Time) *DebtShareUpdate {
+	dsu.mutation.SetUpdatedAt(t)
+	return dsu
+}
+
+// SetAmount sets the "amount" field.
+func (dsu *DebtShareUpdate) SetAmount(f float64) *DebtShareUpdate {
+	dsu.mutation.ResetAmount()
+	dsu.mutation.SetAmount(f)
+	return dsu
+}
+
+// SetNillableAmount sets the "amount" field if the given value is not nil.
+func (dsu *DebtShareUpdate) SetNillableAmount(f *float64) *DebtShareUpdate {
+	if f != nil {
+		dsu.SetAmount(*f)
+	}
+	return dsu
+}
+
+// AddAmount adds f to the "amount" field.
+func (dsu *DebtShareUpdate) AddAmount(f float64) *DebtShareUpdate {
+	dsu.mutation.AddAmount(f)
+	return dsu
+}
+
+// SetPercentage sets the "percentage" field.
+func (dsu *DebtShareUpdate) SetPercentage(f float64) *DebtShareUpdate {
+	dsu.mutation.ResetPercentage()
+	dsu.mutation.SetPercentage(f)
+	return dsu
+}
+
+// SetNillablePercentage sets the "percentage" field if the given value is not nil.
+func (dsu *DebtShareUpdate) SetNillablePercentage(f *float64) *DebtShareUpdate {
+	if f != nil {
+		dsu.SetPercentage(*f)
+	}
+	return dsu
+}
+
+// AddPercentage adds f to the "percentage" field.
+func (dsu *DebtShareUpdate) AddPercentage(f float64) *DebtShareUpdate {
+	dsu.mutation.AddPercentage(f)
+	return dsu
+}
+
+// ClearPercentage clears the value of the "percentage" field.
+func (dsu *DebtShareUpdate) ClearPercentage() *DebtShareUpdate {
+	dsu.mutation.ClearPercentage()
+	return dsu
+}
+
+// SetSettled sets the "settled" field.
+func (dsu *DebtShareUpdate) SetSettled(b bool) *DebtShareUpdate {
+	dsu.mutation.SetSettled(b)
+	return dsu
+}
+
+// SetNillableSettled sets the "settled" field if the given value is not nil.
+func (dsu *DebtShareUpdate) SetNillableSettled(b *bool) *DebtShareUpdate {
+	if b != nil {
+		dsu.SetSettled(*b)
+	}
+	return dsu
+}
+
+// SetDebtID sets the "debt_id" field.
+func (dsu *DebtShareUpdate) SetDebtID(u uuid.UUID) *DebtShareUpdate {
+	dsu.mutation.SetDebtID(u)
+	return dsu
+}
+
+// SetNillableDebtID sets the "debt_id" field if the given value is not nil.
+func (dsu *DebtShareUpdate) SetNillableDebtID(u *uuid.UUID) *DebtShareUpdate {
+	if u != nil {
+		dsu.SetDebtID(*u)
+	}
+	return dsu
+}
+
+// SetPersonID sets the "person_id" field.
+func (dsu *DebtShareUpdate) SetPersonID(u uuid.UUID) *DebtShareUpdate {
+	dsu.mutation.SetPersonID(u)
+	return dsu
+}
+
+// SetNillablePersonID sets the "person_id" field if the given value is not nil.
+func (dsu *DebtShareUpdate) SetNillablePersonID(u *uuid.UUID) *DebtShareUpdate {
+	if u != nil {
+		dsu.SetPersonID(*u)
+	}
+	return dsu
+}
+
+// SetDebt sets the "debt" edge to the Debt entity.
+func (dsu *DebtShareUpdate) SetDebt(d *Debt) *DebtShareUpdate {
+	return dsu.SetDebtID(d.ID)
+}
+
+// SetPerson sets the "person" edge to the Person entity.
+func (dsu *DebtShareUpdate) SetPerson(p *Person) *DebtShareUpdate {
+	return dsu.SetPersonID(p.ID)
+}
+
+// Mutation returns the DebtShareMutation object of the builder.
+func (dsu *DebtShareUpdate) Mutation() *DebtShareMutation {
+	return dsu.mutation
+}
+
+// ClearDebt clears the "debt" edge to the Debt entity.
+func (dsu *DebtShareUpdate) ClearDebt() *DebtShareUpdate {
+	dsu.mutation.ClearDebt()
+	return dsu
+}
+
+// ClearPerson clears the "person" edge to the Person entity.
+func (dsu *DebtShareUpdate) ClearPerson() *DebtShareUpdate {
+	dsu.mutation.ClearPerson()
+	return dsu
+}
+
+// Save executes the query and returns the number of nodes affected by the update operation.
+func (dsu *DebtShareUpdate) Save(ctx context.Context) (int, error) {
+	dsu.defaults()
+	return withHooks(ctx, dsu.sqlSave, dsu.mutation, dsu.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (dsu *DebtShareUpdate) SaveX(ctx context.Context) int {
+	affected, err := dsu.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return affected
+}
+
+// Exec executes the query.
+func (dsu *DebtShareUpdate) Exec(ctx context.Context) error {
+	_, err := dsu.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (dsu *DebtShareUpdate) ExecX(ctx context.Context) {
+	if err := dsu.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (dsu *DebtShareUpdate) defaults() {
+	if _, ok := dsu.mutation.UpdatedAt(); !ok {
+		v := debtshare.UpdateDefaultUpdatedAt()
+		dsu.mutation.SetUpdatedAt(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (dsu *DebtShareUpdate) check() error {
+	if dsu.mutation.DebtCleared() && len(dsu.mutation.DebtIDs()) > 0 {
+		return errors.New(`ent: clearing a required unique edge "DebtShare.debt"`)
+	}
+	if dsu.mutation.PersonCleared() && len(dsu.mutation.PersonIDs()) > 0 {
+		return errors.New(`ent: clearing a required unique edge "DebtShare.person"`)
+	}
+	return nil
+}
+
+func (dsu *DebtShareUpdate) sqlSave(ctx context.Context) (n int, err error) {
+	if err := dsu.check(); err != nil {
+		return n, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(debtshare.Table, debtshare.Columns, sqlgraph.NewFieldSpec(debtshare.FieldID, field.TypeUUID))
+	if ps := dsu.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := dsu.mutation.UpdatedAt(); ok {
+		_spec.SetField(debtshare.FieldUpdatedAt, field.TypeTime, value)
+	}
+	if value, ok := dsu.mutation.Amount(); ok {
+		_spec.SetField(debtshare.FieldAmount, field.TypeFloat64, value)
+	}
+	if value, ok := dsu.mutation.AddedAmount(); ok {
+		_spec.AddField(debtshare.FieldAmount, field.TypeFloat64, value)
+	}
+	if value, ok := dsu.mutation.Percentage(); ok {
+		_spec.SetField(debtshare.FieldPercentage, field.TypeFloat64, value)
+	}
+	if value, ok := dsu.mutation.AddedPercentage(); ok {
+		_spec.AddField(debtshare.FieldPercentage, field.TypeFloat64, value)
+	}
+	if dsu.mutation.PercentageCleared() {
+		_spec.ClearField(debtshare.FieldPercentage, field.TypeFloat64)
+	}
+	if value, ok := dsu.mutation.Settled(); ok {
+		_spec.SetField(debtshare.FieldSettled, field.TypeBool, value)
+	}
+	if dsu.mutation.DebtCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   debtshare.DebtTable,
+			Columns: []string{debtshare.DebtColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(debt.FieldID, field.TypeUUID),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := dsu.mutation.DebtIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   debtshare.DebtTable,
+			Columns: []string{debtshare.DebtColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(debt.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if dsu.mutation.PersonCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: false,
+			Table:   debtshare.PersonTable,
+			Columns: []string{debtshare.PersonColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(person.FieldID, field.TypeUUID),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := dsu.mutation.PersonIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: false,
+			Table:   debtshare.PersonTable,
+			Columns: []string{debtshare.PersonColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(person.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if n, err = sqlgraph.UpdateNodes(ctx, dsu.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{debtshare.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return 0, err
+	}
+	dsu.mutation.done = true
+	return n, nil
+}
+
+// DebtShareUpdateOne is the builder for updating a single DebtShare entity.
+type DebtShareUpdateOne struct {
+	config
+	fields   []string
+	hooks    []Hook
+	mutation *DebtShareMutation
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (dsuo *DebtShareUpdateOne) SetUpdatedAt(t time.Time) *DebtShareUpdateOne {
+	dsuo.mutation.SetUpdatedAt(t)
+	return dsuo
+}
+
+// SetAmount sets the "amount" field.
+func (dsuo *DebtShareUpdateOne) SetAmount(f float64) *DebtShareUpdateOne {
+	dsuo.mutation.ResetAmount()
+	dsuo.mutation.SetAmount(f)
+	return dsuo
+}
+
+// SetNillableAmount sets the "amount" field if the given value is not nil.
+func (dsuo *DebtShareUpdateOne) SetNillableAmount(f *float64) *DebtShareUpdateOne {
+	if f != nil {
+		dsuo.SetAmount(*f)
+	}
+	return dsuo
+}
+
+// AddAmount adds f to the "amount" field.
+func (dsuo *DebtShareUpdateOne) AddAmount(f float64) *DebtShareUpdateOne {
+	dsuo.mutation.AddAmount(f)
+	return dsuo
+}
+
+// SetPercentage sets the "percentage" field.
+func (dsuo *DebtShareUpdateOne) SetPercentage(f float64) *DebtShareUpdateOne {
+	dsuo.mutation.ResetPercentage()
+	dsuo.mutation.SetPercentage(f)
+	return dsuo
+}
+
+// SetNillablePercentage sets the "percentage" field if the given value is not nil.
+func (dsuo *DebtShareUpdateOne) SetNillablePercentage(f *float64) *DebtShareUpdateOne {
+	if f != nil {
+		dsuo.SetPercentage(*f)
+	}
+	return dsuo
+}
+
+// AddPercentage adds f to the "percentage" field.
+func (dsuo *DebtShareUpdateOne) AddPercentage(f float64) *DebtShareUpdateOne {
+	dsuo.mutation.AddPercentage(f)
+	return dsuo
+}
+
+// ClearPercentage clears the value of the "percentage" field.
+func (dsuo *DebtShareUpdateOne) ClearPercentage() *DebtShareUpdateOne {
+	dsuo.mutation.ClearPercentage()
+	return dsuo
+}
+
+// SetSettled sets the "settled" field.
+func (dsuo *DebtShareUpdateOne) SetSettled(b bool) *DebtShareUpdateOne {
+	dsuo.mutation.SetSettled(b)
+	return dsuo
+}
+
+// SetNillableSettled sets the "settled" field if the given value is not nil.
+func (dsuo *DebtShareUpdateOne) SetNillableSettled(b *bool) *DebtShareUpdateOne {
+	if b != nil {
+		dsuo.SetSettled(*b)
+	}
+	return dsuo
+}
+
+// SetDebtID sets the "debt_id" field.
+func (dsuo *DebtShareUpdateOne) SetDebtID(u uuid.UUID) *DebtShareUpdateOne {
+	dsuo.mutation.SetDebtID(u)
+	return dsuo
+}
+
+// SetNillableDebtID sets the "debt_id" field if the given value is not nil.
+func (dsuo *DebtShareUpdateOne) SetNillableDebtID(u *uuid.UUID) *DebtShareUpdateOne {
+	if u != nil {
+		dsuo.SetDebtID(*u)
+	}
+	return dsuo
+}
+
+// SetPersonID sets the "person_id" field.
+func (dsuo *DebtShareUpdateOne) SetPersonID(u uuid.UUID) *DebtShareUpdateOne {
+	dsuo.mutation.SetPersonID(u)
+	return dsuo
+}
+
+// SetNillablePersonID sets the "person_id" field if the given value is not nil.
+func (dsuo *DebtShareUpdateOne) SetNillablePersonID(u *uuid.UUID) *DebtShareUpdateOne {
+	if u != nil {
+		dsuo.SetPersonID(*u)
+	}
+	return dsuo
+}
+
+// SetDebt sets the "debt" edge to the Debt entity.
+func (dsuo *DebtShareUpdateOne) SetDebt(d *Debt) *DebtShareUpdateOne {
+	return dsuo.SetDebtID(d.ID)
+}
+
+// SetPerson sets the "person" edge to the Person entity.
+func (dsuo *DebtShareUpdateOne) SetPerson(p *Person) *DebtShareUpdateOne {
+	return dsuo.SetPersonID(p.ID)
+}
+
+// Mutation returns the DebtShareMutation object of the builder.
+func (dsuo *DebtShareUpdateOne) Mutation() *DebtShareMutation {
+	return dsuo.mutation
+}
+
+// ClearDebt clears the "debt" edge to the Debt entity.
+func (dsuo *DebtShareUpdateOne) ClearDebt() *DebtShareUpdateOne {
+	dsuo.mutation.ClearDebt()
+	return dsuo
+}
+
+// ClearPerson clears the "person" edge to the Person entity.
+func (dsuo *DebtShareUpdateOne) ClearPerson() *DebtShareUpdateOne {
+	dsuo.mutation.ClearPerson()
+	return dsuo
+}
+
+// Where appends a list predicates to the DebtShareUpdate builder.
+func (dsuo *DebtShareUpdateOne) Where(ps ...predicate.DebtShare) *DebtShareUpdateOne {
+	dsuo.mutation.Where(ps...)
+	return dsuo
+}
+
+// Select allows selecting one or more fields (columns) of the returned entity.
+// The default is selecting all fields defined in the entity schema.
+func (dsuo *DebtShareUpdateOne) Select(field string, fields ...string) *DebtShareUpdateOne {
+	dsuo.fields = append([]string{field}, fields...)
+	return dsuo
+}
+
+// Save executes the query and returns the updated DebtShare entity.
+func (dsuo *DebtShareUpdateOne) Save(ctx context.Context) (*DebtShare, error) {
+	dsuo.defaults()
+	return withHooks(ctx, dsuo.sqlSave, dsuo.mutation, dsuo.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (dsuo *DebtShareUpdateOne) SaveX(ctx context.Context) *DebtShare {
+	node, err := dsuo.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// Exec executes the query on the entity.
+func (dsuo *DebtShareUpdateOne) Exec(ctx context.Context) error {
+	_, err := dsuo.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (dsuo *DebtShareUpdateOne) ExecX(ctx context.Context) {
+	if err := dsuo.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (dsuo *DebtShareUpdateOne) defaults() {
+	if _, ok := dsuo.mutation.UpdatedAt(); !ok {
+		v := debtshare.UpdateDefaultUpdatedAt()
+		dsuo.mutation.SetUpdatedAt(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (dsuo *DebtShareUpdateOne) check() error {
+	if dsuo.mutation.DebtCleared() && len(dsuo.mutation.DebtIDs()) > 0 {
+		return errors.New(`ent: clearing a required unique edge "DebtShare.debt"`)
+	}
+	if dsuo.mutation.PersonCleared() && len(dsuo.mutation.PersonIDs()) > 0 {
+		return errors.New(`ent: clearing a required unique edge "DebtShare.person"`)
+	}
+	return nil
+}
+
+func (dsuo *DebtShareUpdateOne) sqlSave(ctx context.Context) (_node *DebtShare, err error) {
+	if err := dsuo.check(); err != nil {
+		return _node, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(debtshare.Table, debtshare.Columns, sqlgraph.NewFieldSpec(debtshare.FieldID, field.TypeUUID))
+	id, ok := dsuo.mutation.ID()
+	if !ok {
+		return nil, &ValidationError{Name: "id", err: errors.New(`ent: missing "DebtShare.id" for update`)}
+	}
+	_spec.Node.ID.Value = id
+	if fields := dsuo.fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields))
+		_spec.Node.Columns = append(_spec.Node.Columns, debtshare.FieldID)
+		for _, f := range fields {
+			if !debtshare.ValidColumn(f) {
+				return nil, &ValidationError{Name: f, err: fmt.Errorf("ent: invalid field %q for query", f)}
+			}
+			if f != debtshare.FieldID {
+				_spec.Node.Columns = append(_spec.Node.Columns, f)
+			}
+		}
+	}
+	if ps := dsuo.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := dsuo.mutation.UpdatedAt(); ok {
+		_spec.SetField(debtshare.FieldUpdatedAt, field.TypeTime, value)
+	}
+	if value, ok := dsuo.mutation.Amount(); ok {
+		_spec.SetField(debtshare.FieldAmount, field.TypeFloat64, value)
+	}
+	if value, ok := dsuo.mutation.AddedAmount(); ok {
+		_spec.AddField(debtshare.FieldAmount, field.TypeFloat64, value)
+	}
+	if value, ok := dsuo.mutation.Percentage(); ok {
+		_spec.SetField(debtshare.FieldPercentage, field.TypeFloat64, value)
+	}
+	if value, ok := dsuo.mutation.AddedPercentage(); ok {
+		_spec.AddField(debtshare.FieldPercentage, field.TypeFloat64, value)
+	}
+	if dsuo.mutation.PercentageCleared() {
+		_spec.ClearField(debtshare.FieldPercentage, field.TypeFloat64)
+	}
+	if value, ok := dsuo.mutation.Settled(); ok {
+		_spec.SetField(debtshare.FieldSettled, field.TypeBool, value)
+	}
+	if dsuo.mutation.DebtCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   debtshare.DebtTable,
+			Columns: []string{debtshare.DebtColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(debt.FieldID, field.TypeUUID),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := dsuo.mutation.DebtIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   debtshare.DebtTable,
+			Columns: []string{debtshare.DebtColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(debt.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if dsuo.mutation.PersonCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: false,
+			Table:   debtshare.PersonTable,
+			Columns: []string{debtshare.PersonColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(person.FieldID, field.TypeUUID),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := dsuo.mutation.PersonIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: false,
+			Table:   debtshare.PersonTable,
+			Columns: []string{debtshare.PersonColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(person.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	_node = &DebtShare{config: dsuo.config}
+	_spec.Assign = _node.assignValues
+	_spec.ScanValues = _node.scanValues
+	if err = sqlgraph.UpdateNode(ctx, dsuo.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{debtshare.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	dsuo.mutation.done = true
+	return _node, nil
+}
@@ -3,10 +3,19 @@
 package ent
 
 import (
+	"backend-go/pkg/ent/apikey"
+	"backend-go/pkg/ent/attachment"
+	"backend-go/pkg/ent/auditentry"
 	"backend-go/pkg/ent/category"
 	"backend-go/pkg/ent/debt"
+	"backend-go/pkg/ent/debtshare"
 	"backend-go/pkg/ent/invoice"
 	"backend-go/pkg/ent/paymentstatus"
+	"backend-go/pkg/ent/person"
+	"backend-go/pkg/ent/tag"
+	"backend-go/pkg/ent/user"
+	"backend-go/pkg/ent/workspace"
+	"backend-go/pkg/ent/workspacemember"
 	"context"
 	"errors"
 	"fmt"
@@ -76,10 +85,19 @@ var (
 func checkColumn(table, column string) error {
 	initCheck.Do(func() {
 		columnCheck = sql.NewColumnCheck(map[string]func(string) bool{
-			category.Table:      category.ValidColumn,
-			debt.Table:          debt.ValidColumn,
-			invoice.Table:       invoice.ValidColumn,
-			paymentstatus.Table: paymentstatus.ValidColumn,
+			apikey.Table:          apikey.ValidColumn,
+			attachment.Table:      attachment.ValidColumn,
+			auditentry.Table:      auditentry.ValidColumn,
+			category.Table:        category.ValidColumn,
+			debt.Table:            debt.ValidColumn,
+			debtshare.Table:       debtshare.ValidColumn,
+			invoice.Table:         invoice.ValidColumn,
+			paymentstatus.Table:   paymentstatus.ValidColumn,
+			person.Table:          person.ValidColumn,
+			tag.Table:             tag.ValidColumn,
+			user.Table:            user.ValidColumn,
+			workspace.Table:       workspace.ValidColumn,
+			workspacemember.Table: workspacemember.ValidColumn,
 		})
 	})
 	return columnCheck(table, column)
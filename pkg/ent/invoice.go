@@ -5,6 +5,7 @@ package ent
 import (
 	"backend-go/pkg/ent/invoice"
 	"backend-go/pkg/ent/paymentstatus"
+	"backend-go/pkg/ent/workspace"
 	"fmt"
 	"strings"
 	"time"
@@ -23,6 +24,10 @@ type Invoice struct {
 	CreatedAt time.Time `json:"created_at,omitempty"`
 	// UpdatedAt holds the value of the "updated_at" field.
 	UpdatedAt time.Time `json:"updated_at,omitempty"`
+	// Version holds the value of the "version" field.
+	Version int `json:"version,omitempty"`
+	// DeletedAt holds the value of the "deleted_at" field.
+	DeletedAt *time.Time `json:"deleted_at,omitempty"`
 	// Amount holds the value of the "amount" field.
 	Amount float64 `json:"amount,omitempty"`
 	// Title holds the value of the "title" field.
@@ -31,6 +36,8 @@ type Invoice struct {
 	IssueDate time.Time `json:"issue_date,omitempty"`
 	// DueDate holds the value of the "due_date" field.
 	DueDate time.Time `json:"due_date,omitempty"`
+	// WorkspaceID holds the value of the "workspace_id" field.
+	WorkspaceID uuid.UUID `json:"workspace_id,omitempty"`
 	// Edges holds the relations/edges for other nodes in the graph.
 	// The values are being populated by the InvoiceQuery when eager-loading is set.
 	Edges        InvoiceEdges `json:"edges"`
@@ -42,9 +49,15 @@ type Invoice struct {
 type InvoiceEdges struct {
 	// Status holds the value of the status edge.
 	Status *PaymentStatus `json:"status,omitempty"`
+	// Workspace holds the value of the workspace edge.
+	Workspace *Workspace `json:"workspace,omitempty"`
+	// Attachments holds the value of the attachments edge.
+	Attachments []*Attachment `json:"attachments,omitempty"`
+	// Debts holds the value of the debts edge.
+	Debts []*Debt `json:"debts,omitempty"`
 	// loadedTypes holds the information for reporting if a
 	// type was loaded (or requested) in eager-loading or not.
-	loadedTypes [1]bool
+	loadedTypes [4]bool
 }
 
 // StatusOrErr returns the Status value or an error if the edge
@@ -58,6 +71,35 @@ func (e InvoiceEdges) StatusOrErr() (*PaymentStatus, error) {
 	return nil, &NotLoadedError{edge: "status"}
 }
 
+// WorkspaceOrErr returns the Workspace value or an error if the edge
+// was not loaded in eager-loading, or loaded but was not found.
+func (e InvoiceEdges) WorkspaceOrErr() (*Workspace, error) {
+	if e.Workspace != nil {
+		return e.Workspace, nil
+	} else if e.loadedTypes[1] {
+		return nil, &NotFoundError{label: workspace.Label}
+	}
+	return nil, &NotLoadedError{edge: "workspace"}
+}
+
+// AttachmentsOrErr returns the Attachments value or an error if the edge
+// was not loaded in eager-loading.
+func (e InvoiceEdges) AttachmentsOrErr() ([]*Attachment, error) {
+	if e.loadedTypes[2] {
+		return e.Attachments, nil
+	}
+	return nil, &NotLoadedError{edge: "attachments"}
+}
+
+// DebtsOrErr returns the Debts value or an error if the edge
+// was not loaded in eager-loading.
+func (e InvoiceEdges) DebtsOrErr() ([]*Debt, error) {
+	if e.loadedTypes[3] {
+		return e.Debts, nil
+	}
+	return nil, &NotLoadedError{edge: "debts"}
+}
+
 // scanValues returns the types for scanning values from sql.Rows.
 func (*Invoice) scanValues(columns []string) ([]any, error) {
 	values := make([]any, len(columns))
@@ -65,11 +107,13 @@ func (*Invoice) scanValues(columns []string) ([]any, error) {
 		switch columns[i] {
 		case invoice.FieldAmount:
 			values[i] = new(sql.NullFloat64)
+		case invoice.FieldVersion:
+			values[i] = new(sql.NullInt64)
 		case invoice.FieldTitle:
 			values[i] = new(sql.NullString)
-		case invoice.FieldCreatedAt, invoice.FieldUpdatedAt, invoice.FieldIssueDate, invoice.FieldDueDate:
+		case invoice.FieldCreatedAt, invoice.FieldUpdatedAt, invoice.FieldDeletedAt, invoice.FieldIssueDate, invoice.FieldDueDate:
 			values[i] = new(sql.NullTime)
-		case invoice.FieldID:
+		case invoice.FieldID, invoice.FieldWorkspaceID:
 			values[i] = new(uuid.UUID)
 		case invoice.ForeignKeys[0]: // status_id
 			values[i] = &sql.NullScanner{S: new(uuid.UUID)}
@@ -106,6 +150,19 @@ func (i *Invoice) assignValues(columns []string, values []any) error {
 			} else if value.Valid {
 				i.UpdatedAt = value.Time
 			}
+		case invoice.FieldVersion:
+			if value, ok := values[j].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field version", values[j])
+			} else if value.Valid {
+				i.Version = int(value.Int64)
+			}
+		case invoice.FieldDeletedAt:
+			if value, ok := values[j].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field deleted_at", values[j])
+			} else if value.Valid {
+				i.DeletedAt = new(time.Time)
+				*i.DeletedAt = value.Time
+			}
 		case invoice.FieldAmount:
 			if value, ok := values[j].(*sql.NullFloat64); !ok {
 				return fmt.Errorf("unexpected type %T for field amount", values[j])
@@ -130,6 +187,12 @@ func (i *Invoice) assignValues(columns []string, values []any) error {
 			} else if value.Valid {
 				i.DueDate = value.Time
 			}
+		case invoice.FieldWorkspaceID:
+			if value, ok := values[j].(*uuid.UUID); !ok {
+				return fmt.Errorf("unexpected type %T for field workspace_id", values[j])
+			} else if value != nil {
+				i.WorkspaceID = *value
+			}
 		case invoice.ForeignKeys[0]:
 			if value, ok := values[j].(*sql.NullScanner); !ok {
 				return fmt.Errorf("unexpected type %T for field status_id", values[j])
@@ -155,6 +218,21 @@ func (i *Invoice) QueryStatus() *PaymentStatusQuery {
 	return NewInvoiceClient(i.config).QueryStatus(i)
 }
 
+// QueryWorkspace queries the "workspace" edge of the Invoice entity.
+func (i *Invoice) QueryWorkspace() *WorkspaceQuery {
+	return NewInvoiceClient(i.config).QueryWorkspace(i)
+}
+
+// QueryAttachments queries the "attachments" edge of the Invoice entity.
+func (i *Invoice) QueryAttachments() *AttachmentQuery {
+	return NewInvoiceClient(i.config).QueryAttachments(i)
+}
+
+// QueryDebts queries the "debts" edge of the Invoice entity.
+func (i *Invoice) QueryDebts() *DebtQuery {
+	return NewInvoiceClient(i.config).QueryDebts(i)
+}
+
 // Update returns a builder for updating this Invoice.
 // Note that you need to call Invoice.Unwrap() before calling this method if this Invoice
 // was returned from a transaction, and the transaction was committed or rolled back.
@@ -184,6 +262,14 @@ func (i *Invoice) String() string {
 	builder.WriteString("updated_at=")
 	builder.WriteString(i.UpdatedAt.Format(time.ANSIC))
 	builder.WriteString(", ")
+	builder.WriteString("version=")
+	builder.WriteString(fmt.Sprintf("%v", i.Version))
+	builder.WriteString(", ")
+	if v := i.DeletedAt; v != nil {
+		builder.WriteString("deleted_at=")
+		builder.WriteString(v.Format(time.ANSIC))
+	}
+	builder.WriteString(", ")
 	builder.WriteString("amount=")
 	builder.WriteString(fmt.Sprintf("%v", i.Amount))
 	builder.WriteString(", ")
@@ -195,6 +281,9 @@ func (i *Invoice) String() string {
 	builder.WriteString(", ")
 	builder.WriteString("due_date=")
 	builder.WriteString(i.DueDate.Format(time.ANSIC))
+	builder.WriteString(", ")
+	builder.WriteString("workspace_id=")
+	builder.WriteString(fmt.Sprintf("%v", i.WorkspaceID))
 	builder.WriteByte(')')
 	return builder.String()
 }
@@ -3,8 +3,11 @@
 package ent
 
 import (
+	"backend-go/pkg/ent/attachment"
+	"backend-go/pkg/ent/debt"
 	"backend-go/pkg/ent/invoice"
 	"backend-go/pkg/ent/paymentstatus"
+	"backend-go/pkg/ent/workspace"
 	"context"
 	"errors"
 	"fmt"
@@ -50,6 +53,34 @@ func (ic *InvoiceCreate) SetNillableUpdatedAt(t *time.Time) *InvoiceCreate {
 	return ic
 }
 
+// SetVersion sets the "version" field.
+func (ic *InvoiceCreate) SetVersion(i int) *InvoiceCreate {
+	ic.mutation.SetVersion(i)
+	return ic
+}
+
+// SetNillableVersion sets the "version" field if the given value is not nil.
+func (ic *InvoiceCreate) SetNillableVersion(i *int) *InvoiceCreate {
+	if i != nil {
+		ic.SetVersion(*i)
+	}
+	return ic
+}
+
+// SetDeletedAt sets the "deleted_at" field.
+func (ic *InvoiceCreate) SetDeletedAt(t time.Time) *InvoiceCreate {
+	ic.mutation.SetDeletedAt(t)
+	return ic
+}
+
+// SetNillableDeletedAt sets the "deleted_at" field if the given value is not nil.
+func (ic *InvoiceCreate) SetNillableDeletedAt(t *time.Time) *InvoiceCreate {
+	if t != nil {
+		ic.SetDeletedAt(*t)
+	}
+	return ic
+}
+
 // SetAmount sets the "amount" field.
 func (ic *InvoiceCreate) SetAmount(f float64) *InvoiceCreate {
 	ic.mutation.SetAmount(f)
@@ -74,6 +105,12 @@ func (ic *InvoiceCreate) SetDueDate(t time.Time) *InvoiceCreate {
 	return ic
 }
 
+// SetWorkspaceID sets the "workspace_id" field.
+func (ic *InvoiceCreate) SetWorkspaceID(u uuid.UUID) *InvoiceCreate {
+	ic.mutation.SetWorkspaceID(u)
+	return ic
+}
+
 // SetID sets the "id" field.
 func (ic *InvoiceCreate) SetID(u uuid.UUID) *InvoiceCreate {
 	ic.mutation.SetID(u)
@@ -107,6 +144,41 @@ func (ic *InvoiceCreate) SetStatus(p *PaymentStatus) *InvoiceCreate {
 	return ic.SetStatusID(p.ID)
 }
 
+// SetWorkspace sets the "workspace" edge to the Workspace entity.
+func (ic *InvoiceCreate) SetWorkspace(w *Workspace) *InvoiceCreate {
+	return ic.SetWorkspaceID(w.ID)
+}
+
+// AddAttachmentIDs adds the "attachments" edge to the Attachment entity by IDs.
+func (ic *InvoiceCreate) AddAttachmentIDs(ids ...uuid.UUID) *InvoiceCreate {
+	ic.mutation.AddAttachmentIDs(ids...)
+	return ic
+}
+
+// AddAttachments adds the "attachments" edges to the Attachment entity.
+func (ic *InvoiceCreate) AddAttachments(a ...*Attachment) *InvoiceCreate {
+	ids := make([]uuid.UUID, len(a))
+	for i := range a {
+		ids[i] = a[i].ID
+	}
+	return ic.AddAttachmentIDs(ids...)
+}
+
+// AddDebtIDs adds the "debts" edge to the Debt entity by IDs.
+func (ic *InvoiceCreate) AddDebtIDs(ids ...uuid.UUID) *InvoiceCreate {
+	ic.mutation.AddDebtIDs(ids...)
+	return ic
+}
+
+// AddDebts adds the "debts" edges to the Debt entity.
+func (ic *InvoiceCreate) AddDebts(d ...*Debt) *InvoiceCreate {
+	ids := make([]uuid.UUID, len(d))
+	for i := range d {
+		ids[i] = d[i].ID
+	}
+	return ic.AddDebtIDs(ids...)
+}
+
 // Mutation returns the InvoiceMutation object of the builder.
 func (ic *InvoiceCreate) Mutation() *InvoiceMutation {
 	return ic.mutation
@@ -150,6 +222,10 @@ func (ic *InvoiceCreate) defaults() {
 		v := invoice.DefaultUpdatedAt()
 		ic.mutation.SetUpdatedAt(v)
 	}
+	if _, ok := ic.mutation.Version(); !ok {
+		v := invoice.DefaultVersion
+		ic.mutation.SetVersion(v)
+	}
 	if _, ok := ic.mutation.ID(); !ok {
 		v := invoice.DefaultID()
 		ic.mutation.SetID(v)
@@ -164,6 +240,9 @@ func (ic *InvoiceCreate) check() error {
 	if _, ok := ic.mutation.UpdatedAt(); !ok {
 		return &ValidationError{Name: "updated_at", err: errors.New(`ent: missing required field "Invoice.updated_at"`)}
 	}
+	if _, ok := ic.mutation.Version(); !ok {
+		return &ValidationError{Name: "version", err: errors.New(`ent: missing required field "Invoice.version"`)}
+	}
 	if _, ok := ic.mutation.Amount(); !ok {
 		return &ValidationError{Name: "amount", err: errors.New(`ent: missing required field "Invoice.amount"`)}
 	}
@@ -181,6 +260,12 @@ func (ic *InvoiceCreate) check() error {
 	if _, ok := ic.mutation.DueDate(); !ok {
 		return &ValidationError{Name: "due_date", err: errors.New(`ent: missing required field "Invoice.due_date"`)}
 	}
+	if _, ok := ic.mutation.WorkspaceID(); !ok {
+		return &ValidationError{Name: "workspace_id", err: errors.New(`ent: missing required field "Invoice.workspace_id"`)}
+	}
+	if len(ic.mutation.WorkspaceIDs()) == 0 {
+		return &ValidationError{Name: "workspace", err: errors.New(`ent: missing required edge "Invoice.workspace"`)}
+	}
 	return nil
 }
 
@@ -224,6 +309,14 @@ func (ic *InvoiceCreate) createSpec() (*Invoice, *sqlgraph.CreateSpec) {
 		_spec.SetField(invoice.FieldUpdatedAt, field.TypeTime, value)
 		_node.UpdatedAt = value
 	}
+	if value, ok := ic.mutation.Version(); ok {
+		_spec.SetField(invoice.FieldVersion, field.TypeInt, value)
+		_node.Version = value
+	}
+	if value, ok := ic.mutation.DeletedAt(); ok {
+		_spec.SetField(invoice.FieldDeletedAt, field.TypeTime, value)
+		_node.DeletedAt = &value
+	}
 	if value, ok := ic.mutation.Amount(); ok {
 		_spec.SetField(invoice.FieldAmount, field.TypeFloat64, value)
 		_node.Amount = value
@@ -257,6 +350,55 @@ func (ic *InvoiceCreate) createSpec() (*Invoice, *sqlgraph.CreateSpec) {
 		_node.status_id = &nodes[0]
 		_spec.Edges = append(_spec.Edges, edge)
 	}
+	if nodes := ic.mutation.WorkspaceIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: false,
+			Table:   invoice.WorkspaceTable,
+			Columns: []string{invoice.WorkspaceColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(workspace.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_node.WorkspaceID = nodes[0]
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	if nodes := ic.mutation.AttachmentsIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   invoice.AttachmentsTable,
+			Columns: []string{invoice.AttachmentsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(attachment.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	if nodes := ic.mutation.DebtsIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: true,
+			Table:   invoice.DebtsTable,
+			Columns: []string{invoice.DebtsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(debt.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges = append(_spec.Edges, edge)
+	}
 	return _node, _spec
 }
 
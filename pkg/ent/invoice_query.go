@@ -3,10 +3,14 @@
 package ent
 
 import (
+	"backend-go/pkg/ent/attachment"
+	"backend-go/pkg/ent/debt"
 	"backend-go/pkg/ent/invoice"
 	"backend-go/pkg/ent/paymentstatus"
 	"backend-go/pkg/ent/predicate"
+	"backend-go/pkg/ent/workspace"
 	"context"
+	"database/sql/driver"
 	"fmt"
 	"math"
 
@@ -20,12 +24,15 @@ import (
 // InvoiceQuery is the builder for querying Invoice entities.
 type InvoiceQuery struct {
 	config
-	ctx        *QueryContext
-	order      []invoice.OrderOption
-	inters     []Interceptor
-	predicates []predicate.Invoice
-	withStatus *PaymentStatusQuery
-	withFKs    bool
+	ctx             *QueryContext
+	order           []invoice.OrderOption
+	inters          []Interceptor
+	predicates      []predicate.Invoice
+	withStatus      *PaymentStatusQuery
+	withWorkspace   *WorkspaceQuery
+	withAttachments *AttachmentQuery
+	withDebts       *DebtQuery
+	withFKs         bool
 	// intermediate query (i.e. traversal path).
 	sql  *sql.Selector
 	path func(context.Context) (*sql.Selector, error)
@@ -84,6 +91,72 @@ func (iq *InvoiceQuery) QueryStatus() *PaymentStatusQuery {
 	return query
 }
 
+// QueryWorkspace chains the current query on the "workspace" edge.
+func (iq *InvoiceQuery) QueryWorkspace() *WorkspaceQuery {
+	query := (&WorkspaceClient{config: iq.config}).Query()
+	query.path = func(ctx context.Context) (fromU *sql.Selector, err error) {
+		if err := iq.prepareQuery(ctx); err != nil {
+			return nil, err
+		}
+		selector := iq.sqlQuery(ctx)
+		if err := selector.Err(); err != nil {
+			return nil, err
+		}
+		step := sqlgraph.NewStep(
+			sqlgraph.From(invoice.Table, invoice.FieldID, selector),
+			sqlgraph.To(workspace.Table, workspace.FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, false, invoice.WorkspaceTable, invoice.WorkspaceColumn),
+		)
+		fromU = sqlgraph.SetNeighbors(iq.driver.Dialect(), step)
+		return fromU, nil
+	}
+	return query
+}
+
+// QueryAttachments chains the current query on the "attachments" edge.
+func (iq *InvoiceQuery) QueryAttachments() *AttachmentQuery {
+	query := (&AttachmentClient{config: iq.config}).Query()
+	query.path = func(ctx context.Context) (fromU *sql.Selector, err error) {
+		if err := iq.prepareQuery(ctx); err != nil {
+			return nil, err
+		}
+		selector := iq.sqlQuery(ctx)
+		if err := selector.Err(); err != nil {
+			return nil, err
+		}
+		step := sqlgraph.NewStep(
+			sqlgraph.From(invoice.Table, invoice.FieldID, selector),
+			sqlgraph.To(attachment.Table, attachment.FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, invoice.AttachmentsTable, invoice.AttachmentsColumn),
+		)
+		fromU = sqlgraph.SetNeighbors(iq.driver.Dialect(), step)
+		return fromU, nil
+	}
+	return query
+}
+
+// QueryDebts chains the current query on the "debts" edge.
+func (iq *InvoiceQuery) QueryDebts() *DebtQuery {
+	query := (&DebtClient{config: iq.config}).Query()
+	query.path = func(ctx context.Context) (fromU *sql.Selector, err error) {
+		if err := iq.prepareQuery(ctx); err != nil {
+			return nil, err
+		}
+		selector := iq.sqlQuery(ctx)
+		if err := selector.Err(); err != nil {
+			return nil, err
+		}
+		step := sqlgraph.NewStep(
+			sqlgraph.From(invoice.Table, invoice.FieldID, selector),
+			sqlgraph.To(debt.Table, debt.FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, true, invoice.DebtsTable, invoice.DebtsColumn),
+		)
+		fromU = sqlgraph.SetNeighbors(iq.driver.Dialect(), step)
+		return fromU, nil
+	}
+	return query
+}
+
 // First returns the first Invoice entity from the query.
 // Returns a *NotFoundError when no Invoice was found.
 func (iq *InvoiceQuery) First(ctx context.Context) (*Invoice, error) {
@@ -271,12 +344,15 @@ func (iq *InvoiceQuery) Clone() *InvoiceQuery {
 		return nil
 	}
 	return &InvoiceQuery{
-		config:     iq.config,
-		ctx:        iq.ctx.Clone(),
-		order:      append([]invoice.OrderOption{}, iq.order...),
-		inters:     append([]Interceptor{}, iq.inters...),
-		predicates: append([]predicate.Invoice{}, iq.predicates...),
-		withStatus: iq.withStatus.Clone(),
+		config:          iq.config,
+		ctx:             iq.ctx.Clone(),
+		order:           append([]invoice.OrderOption{}, iq.order...),
+		inters:          append([]Interceptor{}, iq.inters...),
+		predicates:      append([]predicate.Invoice{}, iq.predicates...),
+		withStatus:      iq.withStatus.Clone(),
+		withWorkspace:   iq.withWorkspace.Clone(),
+		withAttachments: iq.withAttachments.Clone(),
+		withDebts:       iq.withDebts.Clone(),
 		// clone intermediate query.
 		sql:  iq.sql.Clone(),
 		path: iq.path,
@@ -294,6 +370,39 @@ func (iq *InvoiceQuery) WithStatus(opts ...func(*PaymentStatusQuery)) *InvoiceQu
 	return iq
 }
 
+// WithWorkspace tells the query-builder to eager-load the nodes that are connected to
+// the "workspace" edge. The optional arguments are used to configure the query builder of the edge.
+func (iq *InvoiceQuery) WithWorkspace(opts ...func(*WorkspaceQuery)) *InvoiceQuery {
+	query := (&WorkspaceClient{config: iq.config}).Query()
+	for _, opt := range opts {
+		opt(query)
+	}
+	iq.withWorkspace = query
+	return iq
+}
+
+// WithAttachments tells the query-builder to eager-load the nodes that are connected to
+// the "attachments" edge. The optional arguments are used to configure the query builder of the edge.
+func (iq *InvoiceQuery) WithAttachments(opts ...func(*AttachmentQuery)) *InvoiceQuery {
+	query := (&AttachmentClient{config: iq.config}).Query()
+	for _, opt := range opts {
+		opt(query)
+	}
+	iq.withAttachments = query
+	return iq
+}
+
+// WithDebts tells the query-builder to eager-load the nodes that are connected to
+// the "debts" edge. The optional arguments are used to configure the query builder of the edge.
+func (iq *InvoiceQuery) WithDebts(opts ...func(*DebtQuery)) *InvoiceQuery {
+	query := (&DebtClient{config: iq.config}).Query()
+	for _, opt := range opts {
+		opt(query)
+	}
+	iq.withDebts = query
+	return iq
+}
+
 // GroupBy is used to group vertices by one or more fields/columns.
 // It is often used with aggregate functions, like: count, max, mean, min, sum.
 //
@@ -373,8 +482,11 @@ func (iq *InvoiceQuery) sqlAll(ctx context.Context, hooks ...queryHook) ([]*Invo
 		nodes       = []*Invoice{}
 		withFKs     = iq.withFKs
 		_spec       = iq.querySpec()
-		loadedTypes = [1]bool{
+		loadedTypes = [4]bool{
 			iq.withStatus != nil,
+			iq.withWorkspace != nil,
+			iq.withAttachments != nil,
+			iq.withDebts != nil,
 		}
 	)
 	if iq.withStatus != nil {
@@ -407,6 +519,26 @@ func (iq *InvoiceQuery) sqlAll(ctx context.Context, hooks ...queryHook) ([]*Invo
 			return nil, err
 		}
 	}
+	if query := iq.withWorkspace; query != nil {
+		if err := iq.loadWorkspace(ctx, query, nodes, nil,
+			func(n *Invoice, e *Workspace) { n.Edges.Workspace = e }); err != nil {
+			return nil, err
+		}
+	}
+	if query := iq.withAttachments; query != nil {
+		if err := iq.loadAttachments(ctx, query, nodes,
+			func(n *Invoice) { n.Edges.Attachments = []*Attachment{} },
+			func(n *Invoice, e *Attachment) { n.Edges.Attachments = append(n.Edges.Attachments, e) }); err != nil {
+			return nil, err
+		}
+	}
+	if query := iq.withDebts; query != nil {
+		if err := iq.loadDebts(ctx, query, nodes,
+			func(n *Invoice) { n.Edges.Debts = []*Debt{} },
+			func(n *Invoice, e *Debt) { n.Edges.Debts = append(n.Edges.Debts, e) }); err != nil {
+			return nil, err
+		}
+	}
 	return nodes, nil
 }
 
@@ -442,6 +574,99 @@ func (iq *InvoiceQuery) loadStatus(ctx context.Context, query *PaymentStatusQuer
 	}
 	return nil
 }
+func (iq *InvoiceQuery) loadWorkspace(ctx context.Context, query *WorkspaceQuery, nodes []*Invoice, init func(*Invoice), assign func(*Invoice, *Workspace)) error {
+	ids := make([]uuid.UUID, 0, len(nodes))
+	nodeids := make(map[uuid.UUID][]*Invoice)
+	for i := range nodes {
+		fk := nodes[i].WorkspaceID
+		if _, ok := nodeids[fk]; !ok {
+			ids = append(ids, fk)
+		}
+		nodeids[fk] = append(nodeids[fk], nodes[i])
+	}
+	if len(ids) == 0 {
+		return nil
+	}
+	query.Where(workspace.IDIn(ids...))
+	neighbors, err := query.All(ctx)
+	if err != nil {
+		return err
+	}
+	for _, n := range neighbors {
+		nodes, ok := nodeids[n.ID]
+		if !ok {
+			return fmt.Errorf(`unexpected foreign-key "workspace_id" returned %v`, n.ID)
+		}
+		for i := range nodes {
+			assign(nodes[i], n)
+		}
+	}
+	return nil
+}
+func (iq *InvoiceQuery) loadAttachments(ctx context.Context, query *AttachmentQuery, nodes []*Invoice, init func(*Invoice), assign func(*Invoice, *Attachment)) error {
+	fks := make([]driver.Value, 0, len(nodes))
+	nodeids := make(map[uuid.UUID]*Invoice)
+	for i := range nodes {
+		fks = append(fks, nodes[i].ID)
+		nodeids[nodes[i].ID] = nodes[i]
+		if init != nil {
+			init(nodes[i])
+		}
+	}
+	if len(query.ctx.Fields) > 0 {
+		query.ctx.AppendFieldOnce(attachment.FieldInvoiceID)
+	}
+	query.Where(predicate.Attachment(func(s *sql.Selector) {
+		s.Where(sql.InValues(s.C(invoice.AttachmentsColumn), fks...))
+	}))
+	neighbors, err := query.All(ctx)
+	if err != nil {
+		return err
+	}
+	for _, n := range neighbors {
+		fk := n.InvoiceID
+		if fk == nil {
+			return fmt.Errorf(`foreign-key "invoice_id" is nil for node %v`, n.ID)
+		}
+		node, ok := nodeids[*fk]
+		if !ok {
+			return fmt.Errorf(`unexpected referenced foreign-key "invoice_id" returned %v for node %v`, *fk, n.ID)
+		}
+		assign(node, n)
+	}
+	return nil
+}
+func (iq *InvoiceQuery) loadDebts(ctx context.Context, query *DebtQuery, nodes []*Invoice, init func(*Invoice), assign func(*Invoice, *Debt)) error {
+	fks := make([]driver.Value, 0, len(nodes))
+	nodeids := make(map[uuid.UUID]*Invoice)
+	for i := range nodes {
+		fks = append(fks, nodes[i].ID)
+		nodeids[nodes[i].ID] = nodes[i]
+		if init != nil {
+			init(nodes[i])
+		}
+	}
+	query.withFKs = true
+	query.Where(predicate.Debt(func(s *sql.Selector) {
+		s.Where(sql.InValues(s.C(invoice.DebtsColumn), fks...))
+	}))
+	neighbors, err := query.All(ctx)
+	if err != nil {
+		return err
+	}
+	for _, n := range neighbors {
+		fk := n.invoice_id
+		if fk == nil {
+			return fmt.Errorf(`foreign-key "invoice_id" is nil for node %v`, n.ID)
+		}
+		node, ok := nodeids[*fk]
+		if !ok {
+			return fmt.Errorf(`unexpected referenced foreign-key "invoice_id" returned %v for node %v`, *fk, n.ID)
+		}
+		assign(node, n)
+	}
+	return nil
+}
 
 func (iq *InvoiceQuery) sqlCount(ctx context.Context) (int, error) {
 	_spec := iq.querySpec()
@@ -468,6 +693,9 @@ func (iq *InvoiceQuery) querySpec() *sqlgraph.QuerySpec {
 				_spec.Node.Columns = append(_spec.Node.Columns, fields[i])
 			}
 		}
+		if iq.withWorkspace != nil {
+			_spec.Node.AddColumnOnce(invoice.FieldWorkspaceID)
+		}
 	}
 	if ps := iq.predicates; len(ps) > 0 {
 		_spec.Predicate = func(selector *sql.Selector) {
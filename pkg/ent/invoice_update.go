@@ -3,9 +3,12 @@
 package ent
 
 import (
+	"backend-go/pkg/ent/attachment"
+	"backend-go/pkg/ent/debt"
 	"backend-go/pkg/ent/invoice"
 	"backend-go/pkg/ent/paymentstatus"
 	"backend-go/pkg/ent/predicate"
+	"backend-go/pkg/ent/workspace"
 	"context"
 	"errors"
 	"fmt"
@@ -36,6 +39,47 @@ func (iu *InvoiceUpdate) SetUpdatedAt(t time.Time) *InvoiceUpdate {
 	return iu
 }
 
+// SetVersion sets the "version" field.
+func (iu *InvoiceUpdate) SetVersion(i int) *InvoiceUpdate {
+	iu.mutation.ResetVersion()
+	iu.mutation.SetVersion(i)
+	return iu
+}
+
+// SetNillableVersion sets the "version" field if the given value is not nil.
+func (iu *InvoiceUpdate) SetNillableVersion(i *int) *InvoiceUpdate {
+	if i != nil {
+		iu.SetVersion(*i)
+	}
+	return iu
+}
+
+// AddVersion adds i to the "version" field.
+func (iu *InvoiceUpdate) AddVersion(i int) *InvoiceUpdate {
+	iu.mutation.AddVersion(i)
+	return iu
+}
+
+// SetDeletedAt sets the "deleted_at" field.
+func (iu *InvoiceUpdate) SetDeletedAt(t time.Time) *InvoiceUpdate {
+	iu.mutation.SetDeletedAt(t)
+	return iu
+}
+
+// SetNillableDeletedAt sets the "deleted_at" field if the given value is not nil.
+func (iu *InvoiceUpdate) SetNillableDeletedAt(t *time.Time) *InvoiceUpdate {
+	if t != nil {
+		iu.SetDeletedAt(*t)
+	}
+	return iu
+}
+
+// ClearDeletedAt clears the value of the "deleted_at" field.
+func (iu *InvoiceUpdate) ClearDeletedAt() *InvoiceUpdate {
+	iu.mutation.ClearDeletedAt()
+	return iu
+}
+
 // SetAmount sets the "amount" field.
 func (iu *InvoiceUpdate) SetAmount(f float64) *InvoiceUpdate {
 	iu.mutation.ResetAmount()
@@ -99,6 +143,20 @@ func (iu *InvoiceUpdate) SetNillableDueDate(t *time.Time) *InvoiceUpdate {
 	return iu
 }
 
+// SetWorkspaceID sets the "workspace_id" field.
+func (iu *InvoiceUpdate) SetWorkspaceID(u uuid.UUID) *InvoiceUpdate {
+	iu.mutation.SetWorkspaceID(u)
+	return iu
+}
+
+// SetNillableWorkspaceID sets the "workspace_id" field if the given value is not nil.
+func (iu *InvoiceUpdate) SetNillableWorkspaceID(u *uuid.UUID) *InvoiceUpdate {
+	if u != nil {
+		iu.SetWorkspaceID(*u)
+	}
+	return iu
+}
+
 // SetStatusID sets the "status" edge to the PaymentStatus entity by ID.
 func (iu *InvoiceUpdate) SetStatusID(id uuid.UUID) *InvoiceUpdate {
 	iu.mutation.SetStatusID(id)
@@ -118,6 +176,41 @@ func (iu *InvoiceUpdate) SetStatus(p *PaymentStatus) *InvoiceUpdate {
 	return iu.SetStatusID(p.ID)
 }
 
+// SetWorkspace sets the "workspace" edge to the Workspace entity.
+func (iu *InvoiceUpdate) SetWorkspace(w *Workspace) *InvoiceUpdate {
+	return iu.SetWorkspaceID(w.ID)
+}
+
+// AddAttachmentIDs adds the "attachments" edge to the Attachment entity by IDs.
+func (iu *InvoiceUpdate) AddAttachmentIDs(ids ...uuid.UUID) *InvoiceUpdate {
+	iu.mutation.AddAttachmentIDs(ids...)
+	return iu
+}
+
+// AddAttachments adds the "attachments" edges to the Attachment entity.
+func (iu *InvoiceUpdate) AddAttachments(a ...*Attachment) *InvoiceUpdate {
+	ids := make([]uuid.UUID, len(a))
+	for i := range a {
+		ids[i] = a[i].ID
+	}
+	return iu.AddAttachmentIDs(ids...)
+}
+
+// AddDebtIDs adds the "debts" edge to the Debt entity by IDs.
+func (iu *InvoiceUpdate) AddDebtIDs(ids ...uuid.UUID) *InvoiceUpdate {
+	iu.mutation.AddDebtIDs(ids...)
+	return iu
+}
+
+// AddDebts adds the "debts" edges to the Debt entity.
+func (iu *InvoiceUpdate) AddDebts(d ...*Debt) *InvoiceUpdate {
+	ids := make([]uuid.UUID, len(d))
+	for i := range d {
+		ids[i] = d[i].ID
+	}
+	return iu.AddDebtIDs(ids...)
+}
+
 // Mutation returns the InvoiceMutation object of the builder.
 func (iu *InvoiceUpdate) Mutation() *InvoiceMutation {
 	return iu.mutation
@@ -129,6 +222,54 @@ func (iu *InvoiceUpdate) ClearStatus() *InvoiceUpdate {
 	return iu
 }
 
+// ClearWorkspace clears the "workspace" edge to the Workspace entity.
+func (iu *InvoiceUpdate) ClearWorkspace() *InvoiceUpdate {
+	iu.mutation.ClearWorkspace()
+	return iu
+}
+
+// ClearAttachments clears all "attachments" edges to the Attachment entity.
+func (iu *InvoiceUpdate) ClearAttachments() *InvoiceUpdate {
+	iu.mutation.ClearAttachments()
+	return iu
+}
+
+// RemoveAttachmentIDs removes the "attachments" edge to Attachment entities by IDs.
+func (iu *InvoiceUpdate) RemoveAttachmentIDs(ids ...uuid.UUID) *InvoiceUpdate {
+	iu.mutation.RemoveAttachmentIDs(ids...)
+	return iu
+}
+
+// RemoveAttachments removes "attachments" edges to Attachment entities.
+func (iu *InvoiceUpdate) RemoveAttachments(a ...*Attachment) *InvoiceUpdate {
+	ids := make([]uuid.UUID, len(a))
+	for i := range a {
+		ids[i] = a[i].ID
+	}
+	return iu.RemoveAttachmentIDs(ids...)
+}
+
+// ClearDebts clears all "debts" edges to the Debt entity.
+func (iu *InvoiceUpdate) ClearDebts() *InvoiceUpdate {
+	iu.mutation.ClearDebts()
+	return iu
+}
+
+// RemoveDebtIDs removes the "debts" edge to Debt entities by IDs.
+func (iu *InvoiceUpdate) RemoveDebtIDs(ids ...uuid.UUID) *InvoiceUpdate {
+	iu.mutation.RemoveDebtIDs(ids...)
+	return iu
+}
+
+// RemoveDebts removes "debts" edges to Debt entities.
+func (iu *InvoiceUpdate) RemoveDebts(d ...*Debt) *InvoiceUpdate {
+	ids := make([]uuid.UUID, len(d))
+	for i := range d {
+		ids[i] = d[i].ID
+	}
+	return iu.RemoveDebtIDs(ids...)
+}
+
 // Save executes the query and returns the number of nodes affected by the update operation.
 func (iu *InvoiceUpdate) Save(ctx context.Context) (int, error) {
 	iu.defaults()
@@ -172,6 +313,9 @@ func (iu *InvoiceUpdate) check() error {
 			return &ValidationError{Name: "title", err: fmt.Errorf(`ent: validator failed for field "Invoice.title": %w`, err)}
 		}
 	}
+	if iu.mutation.WorkspaceCleared() && len(iu.mutation.WorkspaceIDs()) > 0 {
+		return errors.New(`ent: clearing a required unique edge "Invoice.workspace"`)
+	}
 	return nil
 }
 
@@ -190,6 +334,18 @@ func (iu *InvoiceUpdate) sqlSave(ctx context.Context) (n int, err error) {
 	if value, ok := iu.mutation.UpdatedAt(); ok {
 		_spec.SetField(invoice.FieldUpdatedAt, field.TypeTime, value)
 	}
+	if value, ok := iu.mutation.Version(); ok {
+		_spec.SetField(invoice.FieldVersion, field.TypeInt, value)
+	}
+	if value, ok := iu.mutation.AddedVersion(); ok {
+		_spec.AddField(invoice.FieldVersion, field.TypeInt, value)
+	}
+	if value, ok := iu.mutation.DeletedAt(); ok {
+		_spec.SetField(invoice.FieldDeletedAt, field.TypeTime, value)
+	}
+	if iu.mutation.DeletedAtCleared() {
+		_spec.ClearField(invoice.FieldDeletedAt, field.TypeTime)
+	}
 	if value, ok := iu.mutation.Amount(); ok {
 		_spec.SetField(invoice.FieldAmount, field.TypeFloat64, value)
 	}
@@ -234,6 +390,125 @@ func (iu *InvoiceUpdate) sqlSave(ctx context.Context) (n int, err error) {
 		}
 		_spec.Edges.Add = append(_spec.Edges.Add, edge)
 	}
+	if iu.mutation.WorkspaceCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: false,
+			Table:   invoice.WorkspaceTable,
+			Columns: []string{invoice.WorkspaceColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(workspace.FieldID, field.TypeUUID),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := iu.mutation.WorkspaceIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: false,
+			Table:   invoice.WorkspaceTable,
+			Columns: []string{invoice.WorkspaceColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(workspace.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if iu.mutation.AttachmentsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   invoice.AttachmentsTable,
+			Columns: []string{invoice.AttachmentsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(attachment.FieldID, field.TypeUUID),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := iu.mutation.RemovedAttachmentsIDs(); len(nodes) > 0 && !iu.mutation.AttachmentsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   invoice.AttachmentsTable,
+			Columns: []string{invoice.AttachmentsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(attachment.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := iu.mutation.AttachmentsIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   invoice.AttachmentsTable,
+			Columns: []string{invoice.AttachmentsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(attachment.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if iu.mutation.DebtsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: true,
+			Table:   invoice.DebtsTable,
+			Columns: []string{invoice.DebtsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(debt.FieldID, field.TypeUUID),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := iu.mutation.RemovedDebtsIDs(); len(nodes) > 0 && !iu.mutation.DebtsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: true,
+			Table:   invoice.DebtsTable,
+			Columns: []string{invoice.DebtsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(debt.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := iu.mutation.DebtsIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: true,
+			Table:   invoice.DebtsTable,
+			Columns: []string{invoice.DebtsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(debt.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
 	if n, err = sqlgraph.UpdateNodes(ctx, iu.driver, _spec); err != nil {
 		if _, ok := err.(*sqlgraph.NotFoundError); ok {
 			err = &NotFoundError{invoice.Label}
@@ -260,6 +535,47 @@ func (iuo *InvoiceUpdateOne) SetUpdatedAt(t time.Time) *InvoiceUpdateOne {
 	return iuo
 }
 
+// SetVersion sets the "version" field.
+func (iuo *InvoiceUpdateOne) SetVersion(i int) *InvoiceUpdateOne {
+	iuo.mutation.ResetVersion()
+	iuo.mutation.SetVersion(i)
+	return iuo
+}
+
+// SetNillableVersion sets the "version" field if the given value is not nil.
+func (iuo *InvoiceUpdateOne) SetNillableVersion(i *int) *InvoiceUpdateOne {
+	if i != nil {
+		iuo.SetVersion(*i)
+	}
+	return iuo
+}
+
+// AddVersion adds i to the "version" field.
+func (iuo *InvoiceUpdateOne) AddVersion(i int) *InvoiceUpdateOne {
+	iuo.mutation.AddVersion(i)
+	return iuo
+}
+
+// SetDeletedAt sets the "deleted_at" field.
+func (iuo *InvoiceUpdateOne) SetDeletedAt(t time.Time) *InvoiceUpdateOne {
+	iuo.mutation.SetDeletedAt(t)
+	return iuo
+}
+
+// SetNillableDeletedAt sets the "deleted_at" field if the given value is not nil.
+func (iuo *InvoiceUpdateOne) SetNillableDeletedAt(t *time.Time) *InvoiceUpdateOne {
+	if t != nil {
+		iuo.SetDeletedAt(*t)
+	}
+	return iuo
+}
+
+// ClearDeletedAt clears the value of the "deleted_at" field.
+func (iuo *InvoiceUpdateOne) ClearDeletedAt() *InvoiceUpdateOne {
+	iuo.mutation.ClearDeletedAt()
+	return iuo
+}
+
 // SetAmount sets the "amount" field.
 func (iuo *InvoiceUpdateOne) SetAmount(f float64) *InvoiceUpdateOne {
 	iuo.mutation.ResetAmount()
@@ -323,6 +639,20 @@ func (iuo *InvoiceUpdateOne) SetNillableDueDate(t *time.Time) *InvoiceUpdateOne
 	return iuo
 }
 
+// SetWorkspaceID sets the "workspace_id" field.
+func (iuo *InvoiceUpdateOne) SetWorkspaceID(u uuid.UUID) *InvoiceUpdateOne {
+	iuo.mutation.SetWorkspaceID(u)
+	return iuo
+}
+
+// SetNillableWorkspaceID sets the "workspace_id" field if the given value is not nil.
+func (iuo *InvoiceUpdateOne) SetNillableWorkspaceID(u *uuid.UUID) *InvoiceUpdateOne {
+	if u != nil {
+		iuo.SetWorkspaceID(*u)
+	}
+	return iuo
+}
+
 // SetStatusID sets the "status" edge to the PaymentStatus entity by ID.
 func (iuo *InvoiceUpdateOne) SetStatusID(id uuid.UUID) *InvoiceUpdateOne {
 	iuo.mutation.SetStatusID(id)
@@ -342,6 +672,41 @@ func (iuo *InvoiceUpdateOne) SetStatus(p *PaymentStatus) *InvoiceUpdateOne {
 	return iuo.SetStatusID(p.ID)
 }
 
+// SetWorkspace sets the "workspace" edge to the Workspace entity.
+func (iuo *InvoiceUpdateOne) SetWorkspace(w *Workspace) *InvoiceUpdateOne {
+	return iuo.SetWorkspaceID(w.ID)
+}
+
+// AddAttachmentIDs adds the "attachments" edge to the Attachment entity by IDs.
+func (iuo *InvoiceUpdateOne) AddAttachmentIDs(ids ...uuid.UUID) *InvoiceUpdateOne {
+	iuo.mutation.AddAttachmentIDs(ids...)
+	return iuo
+}
+
+// AddAttachments adds the "attachments" edges to the Attachment entity.
+func (iuo *InvoiceUpdateOne) AddAttachments(a ...*Attachment) *InvoiceUpdateOne {
+	ids := make([]uuid.UUID, len(a))
+	for i := range a {
+		ids[i] = a[i].ID
+	}
+	return iuo.AddAttachmentIDs(ids...)
+}
+
+// AddDebtIDs adds the "debts" edge to the Debt entity by IDs.
+func (iuo *InvoiceUpdateOne) AddDebtIDs(ids ...uuid.UUID) *InvoiceUpdateOne {
+	iuo.mutation.AddDebtIDs(ids...)
+	return iuo
+}
+
+// AddDebts adds the "debts" edges to the Debt entity.
+func (iuo *InvoiceUpdateOne) AddDebts(d ...*Debt) *InvoiceUpdateOne {
+	ids := make([]uuid.UUID, len(d))
+	for i := range d {
+		ids[i] = d[i].ID
+	}
+	return iuo.AddDebtIDs(ids...)
+}
+
 // Mutation returns the InvoiceMutation object of the builder.
 func (iuo *InvoiceUpdateOne) Mutation() *InvoiceMutation {
 	return iuo.mutation
@@ -353,6 +718,54 @@ func (iuo *InvoiceUpdateOne) ClearStatus() *InvoiceUpdateOne {
 	return iuo
 }
 
+// ClearWorkspace clears the "workspace" edge to the Workspace entity.
+func (iuo *InvoiceUpdateOne) ClearWorkspace() *InvoiceUpdateOne {
+	iuo.mutation.ClearWorkspace()
+	return iuo
+}
+
+// ClearAttachments clears all "attachments" edges to the Attachment entity.
+func (iuo *InvoiceUpdateOne) ClearAttachments() *InvoiceUpdateOne {
+	iuo.mutation.ClearAttachments()
+	return iuo
+}
+
+// RemoveAttachmentIDs removes the "attachments" edge to Attachment entities by IDs.
+func (iuo *InvoiceUpdateOne) RemoveAttachmentIDs(ids ...uuid.UUID) *InvoiceUpdateOne {
+	iuo.mutation.RemoveAttachmentIDs(ids...)
+	return iuo
+}
+
+// RemoveAttachments removes "attachments" edges to Attachment entities.
+func (iuo *InvoiceUpdateOne) RemoveAttachments(a ...*Attachment) *InvoiceUpdateOne {
+	ids := make([]uuid.UUID, len(a))
+	for i := range a {
+		ids[i] = a[i].ID
+	}
+	return iuo.RemoveAttachmentIDs(ids...)
+}
+
+// ClearDebts clears all "debts" edges to the Debt entity.
+func (iuo *InvoiceUpdateOne) ClearDebts() *InvoiceUpdateOne {
+	iuo.mutation.ClearDebts()
+	return iuo
+}
+
+// RemoveDebtIDs removes the "debts" edge to Debt entities by IDs.
+func (iuo *InvoiceUpdateOne) RemoveDebtIDs(ids ...uuid.UUID) *InvoiceUpdateOne {
+	iuo.mutation.RemoveDebtIDs(ids...)
+	return iuo
+}
+
+// RemoveDebts removes "debts" edges to Debt entities.
+func (iuo *InvoiceUpdateOne) RemoveDebts(d ...*Debt) *InvoiceUpdateOne {
+	ids := make([]uuid.UUID, len(d))
+	for i := range d {
+		ids[i] = d[i].ID
+	}
+	return iuo.RemoveDebtIDs(ids...)
+}
+
 // Where appends a list predicates to the InvoiceUpdate builder.
 func (iuo *InvoiceUpdateOne) Where(ps ...predicate.Invoice) *InvoiceUpdateOne {
 	iuo.mutation.Where(ps...)
@@ -409,6 +822,9 @@ func (iuo *InvoiceUpdateOne) check() error {
 			return &ValidationError{Name: "title", err: fmt.Errorf(`ent: validator failed for field "Invoice.title": %w`, err)}
 		}
 	}
+	if iuo.mutation.WorkspaceCleared() && len(iuo.mutation.WorkspaceIDs()) > 0 {
+		return errors.New(`ent: clearing a required unique edge "Invoice.workspace"`)
+	}
 	return nil
 }
 
@@ -444,6 +860,18 @@ func (iuo *InvoiceUpdateOne) sqlSave(ctx context.Context) (_node *Invoice, err e
 	if value, ok := iuo.mutation.UpdatedAt(); ok {
 		_spec.SetField(invoice.FieldUpdatedAt, field.TypeTime, value)
 	}
+	if value, ok := iuo.mutation.Version(); ok {
+		_spec.SetField(invoice.FieldVersion, field.TypeInt, value)
+	}
+	if value, ok := iuo.mutation.AddedVersion(); ok {
+		_spec.AddField(invoice.FieldVersion, field.TypeInt, value)
+	}
+	if value, ok := iuo.mutation.DeletedAt(); ok {
+		_spec.SetField(invoice.FieldDeletedAt, field.TypeTime, value)
+	}
+	if iuo.mutation.DeletedAtCleared() {
+		_spec.ClearField(invoice.FieldDeletedAt, field.TypeTime)
+	}
 	if value, ok := iuo.mutation.Amount(); ok {
 		_spec.SetField(invoice.FieldAmount, field.TypeFloat64, value)
 	}
@@ -488,6 +916,125 @@ func (iuo *InvoiceUpdateOne) sqlSave(ctx context.Context) (_node *Invoice, err e
 		}
 		_spec.Edges.Add = append(_spec.Edges.Add, edge)
 	}
+	if iuo.mutation.WorkspaceCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: false,
+			Table:   invoice.WorkspaceTable,
+			Columns: []string{invoice.WorkspaceColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(workspace.FieldID, field.TypeUUID),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := iuo.mutation.WorkspaceIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: false,
+			Table:   invoice.WorkspaceTable,
+			Columns: []string{invoice.WorkspaceColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(workspace.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if iuo.mutation.AttachmentsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   invoice.AttachmentsTable,
+			Columns: []string{invoice.AttachmentsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(attachment.FieldID, field.TypeUUID),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := iuo.mutation.RemovedAttachmentsIDs(); len(nodes) > 0 && !iuo.mutation.AttachmentsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   invoice.AttachmentsTable,
+			Columns: []string{invoice.AttachmentsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(attachment.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := iuo.mutation.AttachmentsIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   invoice.AttachmentsTable,
+			Columns: []string{invoice.AttachmentsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(attachment.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if iuo.mutation.DebtsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: true,
+			Table:   invoice.DebtsTable,
+			Columns: []string{invoice.DebtsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(debt.FieldID, field.TypeUUID),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := iuo.mutation.RemovedDebtsIDs(); len(nodes) > 0 && !iuo.mutation.DebtsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: true,
+			Table:   invoice.DebtsTable,
+			Columns: []string{invoice.DebtsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(debt.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := iuo.mutation.DebtsIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: true,
+			Table:   invoice.DebtsTable,
+			Columns: []string{invoice.DebtsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(debt.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
 	_node = &Invoice{config: iuo.config}
 	_spec.Assign = _node.assignValues
 	_spec.ScanValues = _node.scanValues
@@ -3,37 +3,179 @@
 package migrate
 
 import (
+	"entgo.io/ent/dialect/entsql"
 	"entgo.io/ent/dialect/sql/schema"
 	"entgo.io/ent/schema/field"
 )
 
 var (
+	// APIKeysColumns holds the columns for the "api_keys" table.
+	APIKeysColumns = []*schema.Column{
+		{Name: "id", Type: field.TypeUUID, Unique: true},
+		{Name: "created_at", Type: field.TypeTime},
+		{Name: "updated_at", Type: field.TypeTime},
+		{Name: "name", Type: field.TypeString, Size: 255},
+		{Name: "prefix", Type: field.TypeString, Size: 32},
+		{Name: "key_hash", Type: field.TypeString, Unique: true},
+		{Name: "scopes", Type: field.TypeJSON},
+		{Name: "last_used_at", Type: field.TypeTime, Nullable: true},
+		{Name: "expires_at", Type: field.TypeTime, Nullable: true},
+		{Name: "revoked_at", Type: field.TypeTime, Nullable: true},
+		{Name: "user_id", Type: field.TypeUUID},
+	}
+	// APIKeysTable holds the schema information for the "api_keys" table.
+	APIKeysTable = &schema.Table{
+		Name:       "api_keys",
+		Columns:    APIKeysColumns,
+		PrimaryKey: []*schema.Column{APIKeysColumns[0]},
+		ForeignKeys: []*schema.ForeignKey{
+			{
+				Symbol:     "api_keys_users_user",
+				Columns:    []*schema.Column{APIKeysColumns[10]},
+				RefColumns: []*schema.Column{UsersColumns[0]},
+				OnDelete:   schema.NoAction,
+			},
+		},
+		Indexes: []*schema.Index{
+			{
+				Name:    "apikey_user_id",
+				Unique:  false,
+				Columns: []*schema.Column{APIKeysColumns[10]},
+			},
+		},
+	}
+	// AttachmentsColumns holds the columns for the "attachments" table.
+	AttachmentsColumns = []*schema.Column{
+		{Name: "id", Type: field.TypeUUID, Unique: true},
+		{Name: "created_at", Type: field.TypeTime},
+		{Name: "updated_at", Type: field.TypeTime},
+		{Name: "file_name", Type: field.TypeString, Size: 255},
+		{Name: "content_type", Type: field.TypeString, Size: 127},
+		{Name: "size", Type: field.TypeInt64},
+		{Name: "storage_key", Type: field.TypeString, Unique: true},
+		{Name: "workspace_id", Type: field.TypeUUID},
+		{Name: "debt_id", Type: field.TypeUUID, Nullable: true},
+		{Name: "invoice_id", Type: field.TypeUUID, Nullable: true},
+	}
+	// AttachmentsTable holds the schema information for the "attachments" table.
+	AttachmentsTable = &schema.Table{
+		Name:       "attachments",
+		Columns:    AttachmentsColumns,
+		PrimaryKey: []*schema.Column{AttachmentsColumns[0]},
+		ForeignKeys: []*schema.ForeignKey{
+			{
+				Symbol:     "attachments_workspaces_workspace",
+				Columns:    []*schema.Column{AttachmentsColumns[7]},
+				RefColumns: []*schema.Column{WorkspacesColumns[0]},
+				OnDelete:   schema.NoAction,
+			},
+			{
+				Symbol:     "attachments_debts_attachments",
+				Columns:    []*schema.Column{AttachmentsColumns[8]},
+				RefColumns: []*schema.Column{DebtsColumns[0]},
+				OnDelete:   schema.Cascade,
+			},
+			{
+				Symbol:     "attachments_invoices_attachments",
+				Columns:    []*schema.Column{AttachmentsColumns[9]},
+				RefColumns: []*schema.Column{InvoicesColumns[0]},
+				OnDelete:   schema.Cascade,
+			},
+		},
+		Indexes: []*schema.Index{
+			{
+				Name:    "attachment_debt_id",
+				Unique:  false,
+				Columns: []*schema.Column{AttachmentsColumns[8]},
+			},
+			{
+				Name:    "attachment_invoice_id",
+				Unique:  false,
+				Columns: []*schema.Column{AttachmentsColumns[9]},
+			},
+		},
+	}
+	// AuditEntriesColumns holds the columns for the "audit_entries" table.
+	AuditEntriesColumns = []*schema.Column{
+		{Name: "id", Type: field.TypeUUID, Unique: true},
+		{Name: "entity_type", Type: field.TypeString, Size: 64},
+		{Name: "entity_id", Type: field.TypeUUID},
+		{Name: "action", Type: field.TypeEnum, Enums: []string{"create", "update", "delete", "restore"}},
+		{Name: "actor_id", Type: field.TypeUUID, Nullable: true},
+		{Name: "via_api_key", Type: field.TypeBool, Default: false},
+		{Name: "request_id", Type: field.TypeString, Nullable: true, Size: 128},
+		{Name: "workspace_id", Type: field.TypeUUID, Nullable: true},
+		{Name: "old_values", Type: field.TypeJSON, Nullable: true},
+		{Name: "new_values", Type: field.TypeJSON, Nullable: true},
+		{Name: "created_at", Type: field.TypeTime},
+	}
+	// AuditEntriesTable holds the schema information for the "audit_entries" table.
+	AuditEntriesTable = &schema.Table{
+		Name:       "audit_entries",
+		Columns:    AuditEntriesColumns,
+		PrimaryKey: []*schema.Column{AuditEntriesColumns[0]},
+		Indexes: []*schema.Index{
+			{
+				Name:    "auditentry_entity_type_entity_id_created_at",
+				Unique:  false,
+				Columns: []*schema.Column{AuditEntriesColumns[1], AuditEntriesColumns[2], AuditEntriesColumns[10]},
+			},
+			{
+				Name:    "auditentry_workspace_id",
+				Unique:  false,
+				Columns: []*schema.Column{AuditEntriesColumns[7]},
+			},
+		},
+	}
 	// CategoriesColumns holds the columns for the "categories" table.
 	CategoriesColumns = []*schema.Column{
 		{Name: "id", Type: field.TypeUUID, Unique: true},
 		{Name: "created_at", Type: field.TypeTime},
 		{Name: "updated_at", Type: field.TypeTime},
+		{Name: "version", Type: field.TypeInt, Default: 1},
+		{Name: "deleted_at", Type: field.TypeTime, Nullable: true},
 		{Name: "name", Type: field.TypeString, Size: 255},
 		{Name: "description", Type: field.TypeString, Nullable: true},
+		{Name: "workspace_id", Type: field.TypeUUID},
 	}
 	// CategoriesTable holds the schema information for the "categories" table.
 	CategoriesTable = &schema.Table{
 		Name:       "categories",
 		Columns:    CategoriesColumns,
 		PrimaryKey: []*schema.Column{CategoriesColumns[0]},
+		ForeignKeys: []*schema.ForeignKey{
+			{
+				Symbol:     "categories_workspaces_workspace",
+				Columns:    []*schema.Column{CategoriesColumns[7]},
+				RefColumns: []*schema.Column{WorkspacesColumns[0]},
+				OnDelete:   schema.NoAction,
+			},
+		},
+		Indexes: []*schema.Index{
+			{
+				Name:    "category_workspace_id",
+				Unique:  false,
+				Columns: []*schema.Column{CategoriesColumns[7]},
+			},
+		},
 	}
 	// DebtsColumns holds the columns for the "debts" table.
 	DebtsColumns = []*schema.Column{
 		{Name: "id", Type: field.TypeUUID, Unique: true},
 		{Name: "created_at", Type: field.TypeTime},
 		{Name: "updated_at", Type: field.TypeTime},
+		{Name: "version", Type: field.TypeInt, Default: 1},
+		{Name: "deleted_at", Type: field.TypeTime, Nullable: true},
 		{Name: "amount", Type: field.TypeFloat64, SchemaType: map[string]string{"postgres": "decimal(10,2)"}},
 		{Name: "title", Type: field.TypeString, Size: 255},
 		{Name: "purchase_date", Type: field.TypeTime},
 		{Name: "due_date", Type: field.TypeTime},
+		{Name: "kind", Type: field.TypeEnum, Enums: []string{"purchase", "refund", "fee", "interest", "iof"}, Default: "purchase"},
 		{Name: "invoice_id", Type: field.TypeUUID, Nullable: true},
 		{Name: "category_id", Type: field.TypeUUID, Nullable: true},
 		{Name: "status_id", Type: field.TypeUUID, Nullable: true},
+		{Name: "workspace_id", Type: field.TypeUUID},
+		{Name: "refund_of_id", Type: field.TypeUUID, Nullable: true},
 	}
 	// DebtsTable holds the schema information for the "debts" table.
 	DebtsTable = &schema.Table{
@@ -43,22 +185,89 @@ var (
 		ForeignKeys: []*schema.ForeignKey{
 			{
 				Symbol:     "debts_invoices_invoice",
-				Columns:    []*schema.Column{DebtsColumns[7]},
+				Columns:    []*schema.Column{DebtsColumns[10]},
 				RefColumns: []*schema.Column{InvoicesColumns[0]},
 				OnDelete:   schema.SetNull,
 			},
 			{
 				Symbol:     "debts_categories_category",
-				Columns:    []*schema.Column{DebtsColumns[8]},
+				Columns:    []*schema.Column{DebtsColumns[11]},
 				RefColumns: []*schema.Column{CategoriesColumns[0]},
 				OnDelete:   schema.SetNull,
 			},
 			{
 				Symbol:     "debts_payment_status_status",
-				Columns:    []*schema.Column{DebtsColumns[9]},
+				Columns:    []*schema.Column{DebtsColumns[12]},
 				RefColumns: []*schema.Column{PaymentStatusColumns[0]},
 				OnDelete:   schema.SetNull,
 			},
+			{
+				Symbol:     "debts_workspaces_workspace",
+				Columns:    []*schema.Column{DebtsColumns[13]},
+				RefColumns: []*schema.Column{WorkspacesColumns[0]},
+				OnDelete:   schema.NoAction,
+			},
+			{
+				Symbol:     "debts_debts_refunds",
+				Columns:    []*schema.Column{DebtsColumns[14]},
+				RefColumns: []*schema.Column{DebtsColumns[0]},
+				OnDelete:   schema.SetNull,
+			},
+		},
+		Indexes: []*schema.Index{
+			{
+				Name:    "debt_workspace_id",
+				Unique:  false,
+				Columns: []*schema.Column{DebtsColumns[13]},
+			},
+			{
+				Name:    "debt_refund_of_id",
+				Unique:  false,
+				Columns: []*schema.Column{DebtsColumns[14]},
+			},
+		},
+	}
+	// DebtSharesColumns holds the columns for the "debt_shares" table.
+	DebtSharesColumns = []*schema.Column{
+		{Name: "id", Type: field.TypeUUID, Unique: true},
+		{Name: "created_at", Type: field.TypeTime},
+		{Name: "updated_at", Type: field.TypeTime},
+		{Name: "amount", Type: field.TypeFloat64, SchemaType: map[string]string{"postgres": "decimal(10,2)"}},
+		{Name: "percentage", Type: field.TypeFloat64, Nullable: true, SchemaType: map[string]string{"postgres": "decimal(5,2)"}},
+		{Name: "settled", Type: field.TypeBool, Default: false},
+		{Name: "debt_id", Type: field.TypeUUID},
+		{Name: "person_id", Type: field.TypeUUID},
+	}
+	// DebtSharesTable holds the schema information for the "debt_shares" table.
+	DebtSharesTable = &schema.Table{
+		Name:       "debt_shares",
+		Columns:    DebtSharesColumns,
+		PrimaryKey: []*schema.Column{DebtSharesColumns[0]},
+		ForeignKeys: []*schema.ForeignKey{
+			{
+				Symbol:     "debt_shares_debts_shares",
+				Columns:    []*schema.Column{DebtSharesColumns[6]},
+				RefColumns: []*schema.Column{DebtsColumns[0]},
+				OnDelete:   schema.Cascade,
+			},
+			{
+				Symbol:     "debt_shares_people_person",
+				Columns:    []*schema.Column{DebtSharesColumns[7]},
+				RefColumns: []*schema.Column{PeopleColumns[0]},
+				OnDelete:   schema.NoAction,
+			},
+		},
+		Indexes: []*schema.Index{
+			{
+				Name:    "debtshare_debt_id_person_id",
+				Unique:  true,
+				Columns: []*schema.Column{DebtSharesColumns[6], DebtSharesColumns[7]},
+			},
+			{
+				Name:    "debtshare_person_id",
+				Unique:  false,
+				Columns: []*schema.Column{DebtSharesColumns[7]},
+			},
 		},
 	}
 	// InvoicesColumns holds the columns for the "invoices" table.
@@ -66,11 +275,14 @@ var (
 		{Name: "id", Type: field.TypeUUID, Unique: true},
 		{Name: "created_at", Type: field.TypeTime},
 		{Name: "updated_at", Type: field.TypeTime},
+		{Name: "version", Type: field.TypeInt, Default: 1},
+		{Name: "deleted_at", Type: field.TypeTime, Nullable: true},
 		{Name: "amount", Type: field.TypeFloat64, SchemaType: map[string]string{"postgres": "decimal(10,2)"}},
 		{Name: "title", Type: field.TypeString, Size: 255},
 		{Name: "issue_date", Type: field.TypeTime},
 		{Name: "due_date", Type: field.TypeTime},
 		{Name: "status_id", Type: field.TypeUUID, Nullable: true},
+		{Name: "workspace_id", Type: field.TypeUUID},
 	}
 	// InvoicesTable holds the schema information for the "invoices" table.
 	InvoicesTable = &schema.Table{
@@ -80,10 +292,23 @@ var (
 		ForeignKeys: []*schema.ForeignKey{
 			{
 				Symbol:     "invoices_payment_status_status",
-				Columns:    []*schema.Column{InvoicesColumns[7]},
+				Columns:    []*schema.Column{InvoicesColumns[9]},
 				RefColumns: []*schema.Column{PaymentStatusColumns[0]},
 				OnDelete:   schema.SetNull,
 			},
+			{
+				Symbol:     "invoices_workspaces_workspace",
+				Columns:    []*schema.Column{InvoicesColumns[10]},
+				RefColumns: []*schema.Column{WorkspacesColumns[0]},
+				OnDelete:   schema.NoAction,
+			},
+		},
+		Indexes: []*schema.Index{
+			{
+				Name:    "invoice_workspace_id",
+				Unique:  false,
+				Columns: []*schema.Column{InvoicesColumns[10]},
+			},
 		},
 	}
 	// PaymentStatusColumns holds the columns for the "payment_status" table.
@@ -91,6 +316,7 @@ var (
 		{Name: "id", Type: field.TypeUUID, Unique: true},
 		{Name: "created_at", Type: field.TypeTime},
 		{Name: "updated_at", Type: field.TypeTime},
+		{Name: "version", Type: field.TypeInt, Default: 1},
 		{Name: "name", Type: field.TypeString, Size: 100},
 		{Name: "description", Type: field.TypeString, Nullable: true},
 	}
@@ -100,18 +326,203 @@ var (
 		Columns:    PaymentStatusColumns,
 		PrimaryKey: []*schema.Column{PaymentStatusColumns[0]},
 	}
+	// PeopleColumns holds the columns for the "people" table.
+	PeopleColumns = []*schema.Column{
+		{Name: "id", Type: field.TypeUUID, Unique: true},
+		{Name: "created_at", Type: field.TypeTime},
+		{Name: "updated_at", Type: field.TypeTime},
+		{Name: "version", Type: field.TypeInt, Default: 1},
+		{Name: "deleted_at", Type: field.TypeTime, Nullable: true},
+		{Name: "name", Type: field.TypeString, Size: 255},
+		{Name: "email", Type: field.TypeString, Nullable: true},
+		{Name: "workspace_id", Type: field.TypeUUID},
+	}
+	// PeopleTable holds the schema information for the "people" table.
+	PeopleTable = &schema.Table{
+		Name:       "people",
+		Columns:    PeopleColumns,
+		PrimaryKey: []*schema.Column{PeopleColumns[0]},
+		ForeignKeys: []*schema.ForeignKey{
+			{
+				Symbol:     "people_workspaces_workspace",
+				Columns:    []*schema.Column{PeopleColumns[7]},
+				RefColumns: []*schema.Column{WorkspacesColumns[0]},
+				OnDelete:   schema.NoAction,
+			},
+		},
+		Indexes: []*schema.Index{
+			{
+				Name:    "person_workspace_id",
+				Unique:  false,
+				Columns: []*schema.Column{PeopleColumns[7]},
+			},
+		},
+	}
+	// TagsColumns holds the columns for the "tags" table.
+	TagsColumns = []*schema.Column{
+		{Name: "id", Type: field.TypeUUID, Unique: true},
+		{Name: "created_at", Type: field.TypeTime},
+		{Name: "updated_at", Type: field.TypeTime},
+		{Name: "version", Type: field.TypeInt, Default: 1},
+		{Name: "name", Type: field.TypeString, Size: 64},
+		{Name: "workspace_id", Type: field.TypeUUID},
+	}
+	// TagsTable holds the schema information for the "tags" table.
+	TagsTable = &schema.Table{
+		Name:       "tags",
+		Columns:    TagsColumns,
+		PrimaryKey: []*schema.Column{TagsColumns[0]},
+		ForeignKeys: []*schema.ForeignKey{
+			{
+				Symbol:     "tags_workspaces_workspace",
+				Columns:    []*schema.Column{TagsColumns[5]},
+				RefColumns: []*schema.Column{WorkspacesColumns[0]},
+				OnDelete:   schema.NoAction,
+			},
+		},
+		Indexes: []*schema.Index{
+			{
+				Name:    "tag_workspace_id_name",
+				Unique:  true,
+				Columns: []*schema.Column{TagsColumns[5], TagsColumns[4]},
+			},
+		},
+	}
+	// UsersColumns holds the columns for the "users" table.
+	UsersColumns = []*schema.Column{
+		{Name: "id", Type: field.TypeUUID, Unique: true},
+		{Name: "created_at", Type: field.TypeTime},
+		{Name: "updated_at", Type: field.TypeTime},
+		{Name: "name", Type: field.TypeString, Size: 255},
+		{Name: "email", Type: field.TypeString, Unique: true, Size: 255},
+		{Name: "password_hash", Type: field.TypeString},
+	}
+	// UsersTable holds the schema information for the "users" table.
+	UsersTable = &schema.Table{
+		Name:       "users",
+		Columns:    UsersColumns,
+		PrimaryKey: []*schema.Column{UsersColumns[0]},
+	}
+	// WorkspacesColumns holds the columns for the "workspaces" table.
+	WorkspacesColumns = []*schema.Column{
+		{Name: "id", Type: field.TypeUUID, Unique: true},
+		{Name: "created_at", Type: field.TypeTime},
+		{Name: "updated_at", Type: field.TypeTime},
+		{Name: "name", Type: field.TypeString, Size: 255},
+	}
+	// WorkspacesTable holds the schema information for the "workspaces" table.
+	WorkspacesTable = &schema.Table{
+		Name:       "workspaces",
+		Columns:    WorkspacesColumns,
+		PrimaryKey: []*schema.Column{WorkspacesColumns[0]},
+	}
+	// WorkspaceMembersColumns holds the columns for the "workspace_members" table.
+	WorkspaceMembersColumns = []*schema.Column{
+		{Name: "id", Type: field.TypeUUID, Unique: true},
+		{Name: "created_at", Type: field.TypeTime},
+		{Name: "updated_at", Type: field.TypeTime},
+		{Name: "role", Type: field.TypeEnum, Enums: []string{"owner", "editor", "viewer"}, Default: "viewer"},
+		{Name: "workspace_id", Type: field.TypeUUID},
+		{Name: "user_id", Type: field.TypeUUID},
+	}
+	// WorkspaceMembersTable holds the schema information for the "workspace_members" table.
+	WorkspaceMembersTable = &schema.Table{
+		Name:       "workspace_members",
+		Columns:    WorkspaceMembersColumns,
+		PrimaryKey: []*schema.Column{WorkspaceMembersColumns[0]},
+		ForeignKeys: []*schema.ForeignKey{
+			{
+				Symbol:     "workspace_members_workspaces_workspace",
+				Columns:    []*schema.Column{WorkspaceMembersColumns[4]},
+				RefColumns: []*schema.Column{WorkspacesColumns[0]},
+				OnDelete:   schema.NoAction,
+			},
+			{
+				Symbol:     "workspace_members_users_user",
+				Columns:    []*schema.Column{WorkspaceMembersColumns[5]},
+				RefColumns: []*schema.Column{UsersColumns[0]},
+				OnDelete:   schema.NoAction,
+			},
+		},
+		Indexes: []*schema.Index{
+			{
+				Name:    "workspacemember_workspace_id_user_id",
+				Unique:  true,
+				Columns: []*schema.Column{WorkspaceMembersColumns[4], WorkspaceMembersColumns[5]},
+			},
+			{
+				Name:    "workspacemember_user_id",
+				Unique:  false,
+				Columns: []*schema.Column{WorkspaceMembersColumns[5]},
+			},
+		},
+	}
+	// DebtTagsColumns holds the columns for the "debt_tags" table.
+	DebtTagsColumns = []*schema.Column{
+		{Name: "debt_id", Type: field.TypeUUID},
+		{Name: "tag_id", Type: field.TypeUUID},
+	}
+	// DebtTagsTable holds the schema information for the "debt_tags" table.
+	DebtTagsTable = &schema.Table{
+		Name:       "debt_tags",
+		Columns:    DebtTagsColumns,
+		PrimaryKey: []*schema.Column{DebtTagsColumns[0], DebtTagsColumns[1]},
+		ForeignKeys: []*schema.ForeignKey{
+			{
+				Symbol:     "debt_tags_debt_id",
+				Columns:    []*schema.Column{DebtTagsColumns[0]},
+				RefColumns: []*schema.Column{DebtsColumns[0]},
+				OnDelete:   schema.Cascade,
+			},
+			{
+				Symbol:     "debt_tags_tag_id",
+				Columns:    []*schema.Column{DebtTagsColumns[1]},
+				RefColumns: []*schema.Column{TagsColumns[0]},
+				OnDelete:   schema.Cascade,
+			},
+		},
+	}
 	// Tables holds all the tables in the schema.
 	Tables = []*schema.Table{
+		APIKeysTable,
+		AttachmentsTable,
+		AuditEntriesTable,
 		CategoriesTable,
 		DebtsTable,
+		DebtSharesTable,
 		InvoicesTable,
 		PaymentStatusTable,
+		PeopleTable,
+		TagsTable,
+		UsersTable,
+		WorkspacesTable,
+		WorkspaceMembersTable,
+		DebtTagsTable,
 	}
 )
 
 func init() {
+	APIKeysTable.ForeignKeys[0].RefTable = UsersTable
+	AttachmentsTable.ForeignKeys[0].RefTable = WorkspacesTable
+	AttachmentsTable.ForeignKeys[1].RefTable = DebtsTable
+	AttachmentsTable.ForeignKeys[2].RefTable = InvoicesTable
+	CategoriesTable.ForeignKeys[0].RefTable = WorkspacesTable
 	DebtsTable.ForeignKeys[0].RefTable = InvoicesTable
 	DebtsTable.ForeignKeys[1].RefTable = CategoriesTable
 	DebtsTable.ForeignKeys[2].RefTable = PaymentStatusTable
+	DebtsTable.ForeignKeys[3].RefTable = WorkspacesTable
+	DebtsTable.ForeignKeys[4].RefTable = DebtsTable
+	DebtSharesTable.ForeignKeys[0].RefTable = DebtsTable
+	DebtSharesTable.ForeignKeys[1].RefTable = PeopleTable
 	InvoicesTable.ForeignKeys[0].RefTable = PaymentStatusTable
+	InvoicesTable.ForeignKeys[1].RefTable = WorkspacesTable
+	PeopleTable.ForeignKeys[0].RefTable = WorkspacesTable
+	PeopleTable.Annotation = &entsql.Annotation{
+		Table: "people",
+	}
+	TagsTable.ForeignKeys[0].RefTable = WorkspacesTable
+	WorkspaceMembersTable.ForeignKeys[0].RefTable = WorkspacesTable
+	WorkspaceMembersTable.ForeignKeys[1].RefTable = UsersTable
+	DebtTagsTable.ForeignKeys[0].RefTable = DebtsTable
+	DebtTagsTable.ForeignKeys[1].RefTable = TagsTable
 }
@@ -22,6 +22,8 @@ type PaymentStatus struct {
 	CreatedAt time.Time `json:"created_at,omitempty"`
 	// UpdatedAt holds the value of the "updated_at" field.
 	UpdatedAt time.Time `json:"updated_at,omitempty"`
+	// Version holds the value of the "version" field.
+	Version int `json:"version,omitempty"`
 	// Name holds the value of the "name" field.
 	Name string `json:"name,omitempty"`
 	// Description holds the value of the "description" field.
@@ -34,6 +36,8 @@ func (*PaymentStatus) scanValues(columns []string) ([]any, error) {
 	values := make([]any, len(columns))
 	for i := range columns {
 		switch columns[i] {
+		case paymentstatus.FieldVersion:
+			values[i] = new(sql.NullInt64)
 		case paymentstatus.FieldName, paymentstatus.FieldDescription:
 			values[i] = new(sql.NullString)
 		case paymentstatus.FieldCreatedAt, paymentstatus.FieldUpdatedAt:
@@ -73,6 +77,12 @@ func (ps *PaymentStatus) assignValues(columns []string, values []any) error {
 			} else if value.Valid {
 				ps.UpdatedAt = value.Time
 			}
+		case paymentstatus.FieldVersion:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field version", values[i])
+			} else if value.Valid {
+				ps.Version = int(value.Int64)
+			}
 		case paymentstatus.FieldName:
 			if value, ok := values[i].(*sql.NullString); !ok {
 				return fmt.Errorf("unexpected type %T for field name", values[i])
@@ -128,6 +138,9 @@ func (ps *PaymentStatus) String() string {
 	builder.WriteString("updated_at=")
 	builder.WriteString(ps.UpdatedAt.Format(time.ANSIC))
 	builder.WriteString(", ")
+	builder.WriteString("version=")
+	builder.WriteString(fmt.Sprintf("%v", ps.Version))
+	builder.WriteString(", ")
 	builder.WriteString("name=")
 	builder.WriteString(ps.Name)
 	builder.WriteString(", ")
@@ -65,6 +65,11 @@ func UpdatedAt(v time.Time) predicate.PaymentStatus {
 	return predicate.PaymentStatus(sql.FieldEQ(FieldUpdatedAt, v))
 }
 
+// Version applies equality check predicate on the "version" field. It's identical to VersionEQ.
+func Version(v int) predicate.PaymentStatus {
+	return predicate.PaymentStatus(sql.FieldEQ(FieldVersion, v))
+}
+
 // Name applies equality check predicate on the "name" field. It's identical to NameEQ.
 func Name(v string) predicate.PaymentStatus {
 	return predicate.PaymentStatus(sql.FieldEQ(FieldName, v))
@@ -155,6 +160,46 @@ func UpdatedAtLTE(v time.Time) predicate.PaymentStatus {
 	return predicate.PaymentStatus(sql.FieldLTE(FieldUpdatedAt, v))
 }
 
+// VersionEQ applies the EQ predicate on the "version" field.
+func VersionEQ(v int) predicate.PaymentStatus {
+	return predicate.PaymentStatus(sql.FieldEQ(FieldVersion, v))
+}
+
+// VersionNEQ applies the NEQ predicate on the "version" field.
+func VersionNEQ(v int) predicate.PaymentStatus {
+	return predicate.PaymentStatus(sql.FieldNEQ(FieldVersion, v))
+}
+
+// VersionIn applies the In predicate on the "version" field.
+func VersionIn(vs ...int) predicate.PaymentStatus {
+	return predicate.PaymentStatus(sql.FieldIn(FieldVersion, vs...))
+}
+
+// VersionNotIn applies the NotIn predicate on the "version" field.
+func VersionNotIn(vs ...int) predicate.PaymentStatus {
+	return predicate.PaymentStatus(sql.FieldNotIn(FieldVersion, vs...))
+}
+
+// VersionGT applies the GT predicate on the "version" field.
+func VersionGT(v int) predicate.PaymentStatus {
+	return predicate.PaymentStatus(sql.FieldGT(FieldVersion, v))
+}
+
+// VersionGTE applies the GTE predicate on the "version" field.
+func VersionGTE(v int) predicate.PaymentStatus {
+	return predicate.PaymentStatus(sql.FieldGTE(FieldVersion, v))
+}
+
+// VersionLT applies the LT predicate on the "version" field.
+func VersionLT(v int) predicate.PaymentStatus {
+	return predicate.PaymentStatus(sql.FieldLT(FieldVersion, v))
+}
+
+// VersionLTE applies the LTE predicate on the "version" field.
+func VersionLTE(v int) predicate.PaymentStatus {
+	return predicate.PaymentStatus(sql.FieldLTE(FieldVersion, v))
+}
+
 // NameEQ applies the EQ predicate on the "name" field.
 func NameEQ(v string) predicate.PaymentStatus {
 	return predicate.PaymentStatus(sql.FieldEQ(FieldName, v))
@@ -49,6 +49,20 @@ func (psc *PaymentStatusCreate) SetNillableUpdatedAt(t *time.Time) *PaymentStatu
 	return psc
 }
 
+// SetVersion sets the "version" field.
+func (psc *PaymentStatusCreate) SetVersion(i int) *PaymentStatusCreate {
+	psc.mutation.SetVersion(i)
+	return psc
+}
+
+// SetNillableVersion sets the "version" field if the given value is not nil.
+func (psc *PaymentStatusCreate) SetNillableVersion(i *int) *PaymentStatusCreate {
+	if i != nil {
+		psc.SetVersion(*i)
+	}
+	return psc
+}
+
 // SetName sets the "name" field.
 func (psc *PaymentStatusCreate) SetName(s string) *PaymentStatusCreate {
 	psc.mutation.SetName(s)
@@ -126,6 +140,10 @@ func (psc *PaymentStatusCreate) defaults() {
 		v := paymentstatus.DefaultUpdatedAt()
 		psc.mutation.SetUpdatedAt(v)
 	}
+	if _, ok := psc.mutation.Version(); !ok {
+		v := paymentstatus.DefaultVersion
+		psc.mutation.SetVersion(v)
+	}
 	if _, ok := psc.mutation.ID(); !ok {
 		v := paymentstatus.DefaultID()
 		psc.mutation.SetID(v)
@@ -140,6 +158,9 @@ func (psc *PaymentStatusCreate) check() error {
 	if _, ok := psc.mutation.UpdatedAt(); !ok {
 		return &ValidationError{Name: "updated_at", err: errors.New(`ent: missing required field "PaymentStatus.updated_at"`)}
 	}
+	if _, ok := psc.mutation.Version(); !ok {
+		return &ValidationError{Name: "version", err: errors.New(`ent: missing required field "PaymentStatus.version"`)}
+	}
 	if _, ok := psc.mutation.Name(); !ok {
 		return &ValidationError{Name: "name", err: errors.New(`ent: missing required field "PaymentStatus.name"`)}
 	}
@@ -191,6 +212,10 @@ func (psc *PaymentStatusCreate) createSpec() (*PaymentStatus, *sqlgraph.CreateSp
 		_spec.SetField(paymentstatus.FieldUpdatedAt, field.TypeTime, value)
 		_node.UpdatedAt = value
 	}
+	if value, ok := psc.mutation.Version(); ok {
+		_spec.SetField(paymentstatus.FieldVersion, field.TypeInt, value)
+		_node.Version = value
+	}
 	if value, ok := psc.mutation.Name(); ok {
 		_spec.SetField(paymentstatus.FieldName, field.TypeString, value)
 		_node.Name = value
@@ -34,6 +34,27 @@ func (psu *PaymentStatusUpdate) SetUpdatedAt(t time.Time) *PaymentStatusUpdate {
 	return psu
 }
 
+// SetVersion sets the "version" field.
+func (psu *PaymentStatusUpdate) SetVersion(i int) *PaymentStatusUpdate {
+	psu.mutation.ResetVersion()
+	psu.mutation.SetVersion(i)
+	return psu
+}
+
+// SetNillableVersion sets the "version" field if the given value is not nil.
+func (psu *PaymentStatusUpdate) SetNillableVersion(i *int) *PaymentStatusUpdate {
+	if i != nil {
+		psu.SetVersion(*i)
+	}
+	return psu
+}
+
+// AddVersion adds i to the "version" field.
+func (psu *PaymentStatusUpdate) AddVersion(i int) *PaymentStatusUpdate {
+	psu.mutation.AddVersion(i)
+	return psu
+}
+
 // SetName sets the "name" field.
 func (psu *PaymentStatusUpdate) SetName(s string) *PaymentStatusUpdate {
 	psu.mutation.SetName(s)
@@ -134,6 +155,12 @@ func (psu *PaymentStatusUpdate) sqlSave(ctx context.Context) (n int, err error)
 	if value, ok := psu.mutation.UpdatedAt(); ok {
 		_spec.SetField(paymentstatus.FieldUpdatedAt, field.TypeTime, value)
 	}
+	if value, ok := psu.mutation.Version(); ok {
+		_spec.SetField(paymentstatus.FieldVersion, field.TypeInt, value)
+	}
+	if value, ok := psu.mutation.AddedVersion(); ok {
+		_spec.AddField(paymentstatus.FieldVersion, field.TypeInt, value)
+	}
 	if value, ok := psu.mutation.Name(); ok {
 		_spec.SetField(paymentstatus.FieldName, field.TypeString, value)
 	}
@@ -169,6 +196,27 @@ func (psuo *PaymentStatusUpdateOne) SetUpdatedAt(t time.Time) *PaymentStatusUpda
 	return psuo
 }
 
+// SetVersion sets the "version" field.
+func (psuo *PaymentStatusUpdateOne) SetVersion(i int) *PaymentStatusUpdateOne {
+	psuo.mutation.ResetVersion()
+	psuo.mutation.SetVersion(i)
+	return psuo
+}
+
+// SetNillableVersion sets the "version" field if the given value is not nil.
+func (psuo *PaymentStatusUpdateOne) SetNillableVersion(i *int) *PaymentStatusUpdateOne {
+	if i != nil {
+		psuo.SetVersion(*i)
+	}
+	return psuo
+}
+
+// AddVersion adds i to the "version" field.
+func (psuo *PaymentStatusUpdateOne) AddVersion(i int) *PaymentStatusUpdateOne {
+	psuo.mutation.AddVersion(i)
+	return psuo
+}
+
 // SetName sets the "name" field.
 func (psuo *PaymentStatusUpdateOne) SetName(s string) *PaymentStatusUpdateOne {
 	psuo.mutation.SetName(s)
@@ -299,6 +347,12 @@ func (psuo *PaymentStatusUpdateOne) sqlSave(ctx context.Context) (_node *Payment
 	if value, ok := psuo.mutation.UpdatedAt(); ok {
 		_spec.SetField(paymentstatus.FieldUpdatedAt, field.TypeTime, value)
 	}
+	if value, ok := psuo.mutation.Version(); ok {
+		_spec.SetField(paymentstatus.FieldVersion, field.TypeInt, value)
+	}
+	if value, ok := psuo.mutation.AddedVersion(); ok {
+		_spec.AddField(paymentstatus.FieldVersion, field.TypeInt, value)
+	}
 	if value, ok := psuo.mutation.Name(); ok {
 		_spec.SetField(paymentstatus.FieldName, field.TypeString, value)
 	}
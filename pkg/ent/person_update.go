@@ -0,0 +1,709 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"backend-go/pkg/ent/debtshare"
+	"backend-go/pkg/ent/person"
+	"backend-go/pkg/ent/predicate"
+	"backend-go/pkg/ent/workspace"
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/google/uuid"
+)
+
+// PersonUpdate is the builder for updating Person entities.
+type PersonUpdate struct {
+	config
+	hooks    []Hook
+	mutation *PersonMutation
+}
+
+// Where appends a list predicates to the PersonUpdate builder.
+func (pu *PersonUpdate) Where(ps ...predicate.Person) *PersonUpdate {
+	pu.mutation.Where(ps...)
+	return pu
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (pu *PersonUpdate) SetUpdatedAt(t time.Time) *PersonUpdate {
+	pu.mutation.SetUpdatedAt(t)
+	return pu
+}
+
+// SetVersion sets the "version" field.
+func (pu *PersonUpdate) SetVersion(i int) *PersonUpdate {
+	pu.mutation.ResetVersion()
+	pu.mutation.SetVersion(i)
+	return pu
+}
+
+// SetNillableVersion sets the "version" field if the given value is not nil.
+func (pu *PersonUpdate) SetNillableVersion(i *int) *PersonUpdate {
+	if i != nil {
+		pu.SetVersion(*i)
+	}
+	return pu
+}
+
+// AddVersion adds i to the "version" field.
+func (pu *PersonUpdate) AddVersion(i int) *PersonUpdate {
+	pu.mutation.AddVersion(i)
+	return pu
+}
+
+// SetDeletedAt sets the "deleted_at" field.
+func (pu *PersonUpdate) SetDeletedAt(t time.Time) *PersonUpdate {
+	pu.mutation.SetDeletedAt(t)
+	return pu
+}
+
+// SetNillableDeletedAt sets the "deleted_at" field if the given value is not nil.
+func (pu *PersonUpdate) SetNillableDeletedAt(t *time.Time) *PersonUpdate {
+	if t != nil {
+		pu.SetDeletedAt(*t)
+	}
+	return pu
+}
+
+// ClearDeletedAt clears the value of the "deleted_at" field.
+func (pu *PersonUpdate) ClearDeletedAt() *PersonUpdate {
+	pu.mutation.ClearDeletedAt()
+	return pu
+}
+
+// SetName sets the "name" field.
+func (pu *PersonUpdate) SetName(s string) *PersonUpdate {
+	pu.mutation.SetName(s)
+	return pu
+}
+
+// SetNillableName sets the "name" field if the given value is not nil.
+func (pu *PersonUpdate) SetNillableName(s *string) *PersonUpdate {
+	if s != nil {
+		pu.SetName(*s)
+	}
+	return pu
+}
+
+// SetEmail sets the "email" field.
+func (pu *PersonUpdate) SetEmail(s string) *PersonUpdate {
+	pu.mutation.SetEmail(s)
+	return pu
+}
+
+// SetNillableEmail sets the "email" field if the given value is not nil.
+func (pu *PersonUpdate) SetNillableEmail(s *string) *PersonUpdate {
+	if s != nil {
+		pu.SetEmail(*s)
+	}
+	return pu
+}
+
+// ClearEmail clears the value of the "email" field.
+func (pu *PersonUpdate) ClearEmail() *PersonUpdate {
+	pu.mutation.ClearEmail()
+	return pu
+}
+
+// SetWorkspaceID sets the "workspace_id" field.
+func (pu *PersonUpdate) SetWorkspaceID(u uuid.UUID) *PersonUpdate {
+	pu.mutation.SetWorkspaceID(u)
+	return pu
+}
+
+// SetNillableWorkspaceID sets the "workspace_id" field if the given value is not nil.
+func (pu *PersonUpdate) SetNillableWorkspaceID(u *uuid.UUID) *PersonUpdate {
+	if u != nil {
+		pu.SetWorkspaceID(*u)
+	}
+	return pu
+}
+
+// SetWorkspace sets the "workspace" edge to the Workspace entity.
+func (pu *PersonUpdate) SetWorkspace(w *Workspace) *PersonUpdate {
+	return pu.SetWorkspaceID(w.ID)
+}
+
+// AddShareIDs adds the "shares" edge to the DebtShare entity by IDs.
+func (pu *PersonUpdate) AddShareIDs(ids ...uuid.UUID) *PersonUpdate {
+	pu.mutation.AddShareIDs(ids...)
+	return pu
+}
+
+// AddShares adds the "shares" edges to the DebtShare entity.
+func (pu *PersonUpdate) AddShares(d ...*DebtShare) *PersonUpdate {
+	ids := make([]uuid.UUID, len(d))
+	for i := range d {
+		ids[i] = d[i].ID
+	}
+	return pu.AddShareIDs(ids...)
+}
+
+// Mutation returns the PersonMutation object of the builder.
+func (pu *PersonUpdate) Mutation() *PersonMutation {
+	return pu.mutation
+}
+
+// ClearWorkspace clears the "workspace" edge to the Workspace entity.
+func (pu *PersonUpdate) ClearWorkspace() *PersonUpdate {
+	pu.mutation.ClearWorkspace()
+	return pu
+}
+
+// ClearShares clears all "shares" edges to the DebtShare entity.
+func (pu *PersonUpdate) ClearShares() *PersonUpdate {
+	pu.mutation.ClearShares()
+	return pu
+}
+
+// RemoveShareIDs removes the "shares" edge to DebtShare entities by IDs.
+func (pu *PersonUpdate) RemoveShareIDs(ids ...uuid.UUID) *PersonUpdate {
+	pu.mutation.RemoveShareIDs(ids...)
+	return pu
+}
+
+// RemoveShares removes "shares" edges to DebtShare entities.
+func (pu *PersonUpdate) RemoveShares(d ...*DebtShare) *PersonUpdate {
+	ids := make([]uuid.UUID, len(d))
+	for i := range d {
+		ids[i] = d[i].ID
+	}
+	return pu.RemoveShareIDs(ids...)
+}
+
+// Save executes the query and returns the number of nodes affected by the update operation.
+func (pu *PersonUpdate) Save(ctx context.Context) (int, error) {
+	pu.defaults()
+	return withHooks(ctx, pu.sqlSave, pu.mutation, pu.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (pu *PersonUpdate) SaveX(ctx context.Context) int {
+	affected, err := pu.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return affected
+}
+
+// Exec executes the query.
+func (pu *PersonUpdate) Exec(ctx context.Context) error {
+	_, err := pu.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (pu *PersonUpdate) ExecX(ctx context.Context) {
+	if err := pu.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (pu *PersonUpdate) defaults() {
+	if _, ok := pu.mutation.UpdatedAt(); !ok {
+		v := person.UpdateDefaultUpdatedAt()
+		pu.mutation.SetUpdatedAt(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (pu *PersonUpdate) check() error {
+	if v, ok := pu.mutation.Name(); ok {
+		if err := person.NameValidator(v); err != nil {
+			return &ValidationError{Name: "name", err: fmt.Errorf(`ent: validator failed for field "Person.name": %w`, err)}
+		}
+	}
+	if pu.mutation.WorkspaceCleared() && len(pu.mutation.WorkspaceIDs()) > 0 {
+		return errors.New(`ent: clearing a required unique edge "Person.workspace"`)
+	}
+	return nil
+}
+
+func (pu *PersonUpdate) sqlSave(ctx context.Context) (n int, err error) {
+	if err := pu.check(); err != nil {
+		return n, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(person.Table, person.Columns, sqlgraph.NewFieldSpec(person.FieldID, field.TypeUUID))
+	if ps := pu.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := pu.mutation.UpdatedAt(); ok {
+		_spec.SetField(person.FieldUpdatedAt, field.TypeTime, value)
+	}
+	if value, ok := pu.mutation.Version(); ok {
+		_spec.SetField(person.FieldVersion, field.TypeInt, value)
+	}
+	if value, ok := pu.mutation.AddedVersion(); ok {
+		_spec.AddField(person.FieldVersion, field.TypeInt, value)
+	}
+	if value, ok := pu.mutation.DeletedAt(); ok {
+		_spec.SetField(person.FieldDeletedAt, field.TypeTime, value)
+	}
+	if pu.mutation.DeletedAtCleared() {
+		_spec.ClearField(person.FieldDeletedAt, field.TypeTime)
+	}
+	if value, ok := pu.mutation.Name(); ok {
+		_spec.SetField(person.FieldName, field.TypeString, value)
+	}
+	if value, ok := pu.mutation.Email(); ok {
+		_spec.SetField(person.FieldEmail, field.TypeString, value)
+	}
+	if pu.mutation.EmailCleared() {
+		_spec.ClearField(person.FieldEmail, field.TypeString)
+	}
+	if pu.mutation.WorkspaceCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: false,
+			Table:   person.WorkspaceTable,
+			Columns: []string{person.WorkspaceColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(workspace.FieldID, field.TypeUUID),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := pu.mutation.WorkspaceIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: false,
+			Table:   person.WorkspaceTable,
+			Columns: []string{person.WorkspaceColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(workspace.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if pu.mutation.SharesCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: true,
+			Table:   person.SharesTable,
+			Columns: []string{person.SharesColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(debtshare.FieldID, field.TypeUUID),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := pu.mutation.RemovedSharesIDs(); len(nodes) > 0 && !pu.mutation.SharesCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: true,
+			Table:   person.SharesTable,
+			Columns: []string{person.SharesColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(debtshare.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := pu.mutation.SharesIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: true,
+			Table:   person.SharesTable,
+			Columns: []string{person.SharesColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(debtshare.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if n, err = sqlgraph.UpdateNodes(ctx, pu.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{person.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return 0, err
+	}
+	pu.mutation.done = true
+	return n, nil
+}
+
+// PersonUpdateOne is the builder for updating a single Person entity.
+type PersonUpdateOne struct {
+	config
+	fields   []string
+	hooks    []Hook
+	mutation *PersonMutation
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (puo *PersonUpdateOne) SetUpdatedAt(t time.Time) *PersonUpdateOne {
+	puo.mutation.SetUpdatedAt(t)
+	return puo
+}
+
+// SetVersion sets the "version" field.
+func (puo *PersonUpdateOne) SetVersion(i int) *PersonUpdateOne {
+	puo.mutation.ResetVersion()
+	puo.mutation.SetVersion(i)
+	return puo
+}
+
+// SetNillableVersion sets the "version" field if the given value is not nil.
+func (puo *PersonUpdateOne) SetNillableVersion(i *int) *PersonUpdateOne {
+	if i != nil {
+		puo.SetVersion(*i)
+	}
+	return puo
+}
+
+// AddVersion adds i to the "version" field.
+func (puo *PersonUpdateOne) AddVersion(i int) *PersonUpdateOne {
+	puo.mutation.AddVersion(i)
+	return puo
+}
+
+// SetDeletedAt sets the "deleted_at" field.
+func (puo *PersonUpdateOne) SetDeletedAt(t time.Time) *PersonUpdateOne {
+	puo.mutation.SetDeletedAt(t)
+	return puo
+}
+
+// SetNillableDeletedAt sets the "deleted_at" field if the given value is not nil.
+func (puo *PersonUpdateOne) SetNillableDeletedAt(t *time.Time) *PersonUpdateOne {
+	if t != nil {
+		puo.SetDeletedAt(*t)
+	}
+	return puo
+}
+
+// ClearDeletedAt clears the value of the "deleted_at" field.
+func (puo *PersonUpdateOne) ClearDeletedAt() *PersonUpdateOne {
+	puo.mutation.ClearDeletedAt()
+	return puo
+}
+
+// SetName sets the "name" field.
+func (puo *PersonUpdateOne) SetName(s string) *PersonUpdateOne {
+	puo.mutation.SetName(s)
+	return puo
+}
+
+// SetNillableName sets the "name" field if the given value is not nil.
+func (puo *PersonUpdateOne) SetNillableName(s *string) *PersonUpdateOne {
+	if s != nil {
+		puo.SetName(*s)
+	}
+	return puo
+}
+
+// SetEmail sets the "email" field.
+func (puo *PersonUpdateOne) SetEmail(s string) *PersonUpdateOne {
+	puo.mutation.SetEmail(s)
+	return puo
+}
+
+// SetNillableEmail sets the "email" field if the given value is not nil.
+func (puo *PersonUpdateOne) SetNillableEmail(s *string) *PersonUpdateOne {
+	if s != nil {
+		puo.SetEmail(*s)
+	}
+	return puo
+}
+
+// ClearEmail clears the value of the "email" field.
+func (puo *PersonUpdateOne) ClearEmail() *PersonUpdateOne {
+	puo.mutation.ClearEmail()
+	return puo
+}
+
+// SetWorkspaceID sets the "workspace_id" field.
+func (puo *PersonUpdateOne) SetWorkspaceID(u uuid.UUID) *PersonUpdateOne {
+	puo.mutation.SetWorkspaceID(u)
+	return puo
+}
+
+// SetNillableWorkspaceID sets the "workspace_id" field if the given value is not nil.
+func (puo *PersonUpdateOne) SetNillableWorkspaceID(u *uuid.UUID) *PersonUpdateOne {
+	if u != nil {
+		puo.SetWorkspaceID(*u)
+	}
+	return puo
+}
+
+// SetWorkspace sets the "workspace" edge to the Workspace entity.
+func (puo *PersonUpdateOne) SetWorkspace(w *Workspace) *PersonUpdateOne {
+	return puo.SetWorkspaceID(w.ID)
+}
+
+// AddShareIDs adds the "shares" edge to the DebtShare entity by IDs.
+func (puo *PersonUpdateOne) AddShareIDs(ids ...uuid.UUID) *PersonUpdateOne {
+	puo.mutation.AddShareIDs(ids...)
+	return puo
+}
+
+// AddShares adds the "shares" edges to the DebtShare entity.
+func (puo *PersonUpdateOne) AddShares(d ...*DebtShare) *PersonUpdateOne {
+	ids := make([]uuid.UUID, len(d))
+	for i := range d {
+		ids[i] = d[i].ID
+	}
+	return puo.AddShareIDs(ids...)
+}
+
+// Mutation returns the PersonMutation object of the builder.
+func (puo *PersonUpdateOne) Mutation() *PersonMutation {
+	return puo.mutation
+}
+
+// ClearWorkspace clears the "workspace" edge to the Workspace entity.
+func (puo *PersonUpdateOne) ClearWorkspace() *PersonUpdateOne {
+	puo.mutation.ClearWorkspace()
+	return puo
+}
+
+// ClearShares clears all "shares" edges to the DebtShare entity.
+func (puo *PersonUpdateOne) ClearShares() *PersonUpdateOne {
+	puo.mutation.ClearShares()
+	return puo
+}
+
+// RemoveShareIDs removes the "shares" edge to DebtShare entities by IDs.
+func (puo *PersonUpdateOne) RemoveShareIDs(ids ...uuid.UUID) *PersonUpdateOne {
+	puo.mutation.RemoveShareIDs(ids...)
+	return puo
+}
+
+// RemoveShares removes "shares" edges to DebtShare entities.
+func (puo *PersonUpdateOne) RemoveShares(d ...*DebtShare) *PersonUpdateOne {
+	ids := make([]uuid.UUID, len(d))
+	for i := range d {
+		ids[i] = d[i].ID
+	}
+	return puo.RemoveShareIDs(ids...)
+}
+
+// Where appends a list predicates to the PersonUpdate builder.
+func (puo *PersonUpdateOne) Where(ps ...predicate.Person) *PersonUpdateOne {
+	puo.mutation.Where(ps...)
+	return puo
+}
+
+// Select allows selecting one or more fields (columns) of the returned entity.
+// The default is selecting all fields defined in the entity schema.
+func (puo *PersonUpdateOne) Select(field string, fields ...string) *PersonUpdateOne {
+	puo.fields = append([]string{field}, fields...)
+	return puo
+}
+
+// Save executes the query and returns the updated Person entity.
+func (puo *PersonUpdateOne) Save(ctx context.Context) (*Person, error) {
+	puo.defaults()
+	return withHooks(ctx, puo.sqlSave, puo.mutation, puo.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (puo *PersonUpdateOne) SaveX(ctx context.Context) *Person {
+	node, err := puo.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// Exec executes the query on the entity.
+func (puo *PersonUpdateOne) Exec(ctx context.Context) error {
+	_, err := puo.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (puo *PersonUpdateOne) ExecX(ctx context.Context) {
+	if err := puo.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (puo *PersonUpdateOne) defaults() {
+	if _, ok := puo.mutation.UpdatedAt(); !ok {
+		v := person.UpdateDefaultUpdatedAt()
+		puo.mutation.SetUpdatedAt(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (puo *PersonUpdateOne) check() error {
+	if v, ok := puo.mutation.Name(); ok {
+		if err := person.NameValidator(v); err != nil {
+			return &ValidationError{Name: "name", err: fmt.Errorf(`ent: validator failed for field "Person.name": %w`, err)}
+		}
+	}
+	if puo.mutation.WorkspaceCleared() && len(puo.mutation.WorkspaceIDs()) > 0 {
+		return errors.New(`ent: clearing a required unique edge "Person.workspace"`)
+	}
+	return nil
+}
+
+func (puo *PersonUpdateOne) sqlSave(ctx context.Context) (_node *Person, err error) {
+	if err := puo.check(); err != nil {
+		return _node, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(person.Table, person.Columns, sqlgraph.NewFieldSpec(person.FieldID, field.TypeUUID))
+	id, ok := puo.mutation.ID()
+	if !ok {
+		return nil, &ValidationError{Name: "id", err: errors.New(`ent: missing "Person.id" for update`)}
+	}
+	_spec.Node.ID.Value = id
+	if fields := puo.fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields))
+		_spec.Node.Columns = append(_spec.Node.Columns, person.FieldID)
+		for _, f := range fields {
+			if !person.ValidColumn(f) {
+				return nil, &ValidationError{Name: f, err: fmt.Errorf("ent: invalid field %q for query", f)}
+			}
+			if f != person.FieldID {
+				_spec.Node.Columns = append(_spec.Node.Columns, f)
+			}
+		}
+	}
+	if ps := puo.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := puo.mutation.UpdatedAt(); ok {
+		_spec.SetField(person.FieldUpdatedAt, field.TypeTime, value)
+	}
+	if value, ok := puo.mutation.Version(); ok {
+		_spec.SetField(person.FieldVersion, field.TypeInt, value)
+	}
+	if value, ok := puo.mutation.AddedVersion(); ok {
+		_spec.AddField(person.FieldVersion, field.TypeInt, value)
+	}
+	if value, ok := puo.mutation.DeletedAt(); ok {
+		_spec.SetField(person.FieldDeletedAt, field.TypeTime, value)
+	}
+	if puo.mutation.DeletedAtCleared() {
+		_spec.ClearField(person.FieldDeletedAt, field.TypeTime)
+	}
+	if value, ok := puo.mutation.Name(); ok {
+		_spec.SetField(person.FieldName, field.TypeString, value)
+	}
+	if value, ok := puo.mutation.Email(); ok {
+		_spec.SetField(person.FieldEmail, field.TypeString, value)
+	}
+	if puo.mutation.EmailCleared() {
+		_spec.ClearField(person.FieldEmail, field.TypeString)
+	}
+	if puo.mutation.WorkspaceCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: false,
+			Table:   person.WorkspaceTable,
+			Columns: []string{person.WorkspaceColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(workspace.FieldID, field.TypeUUID),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := puo.mutation.WorkspaceIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: false,
+			Table:   person.WorkspaceTable,
+			Columns: []string{person.WorkspaceColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(workspace.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if puo.mutation.SharesCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: true,
+			Table:   person.SharesTable,
+			Columns: []string{person.SharesColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(debtshare.FieldID, field.TypeUUID),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := puo.mutation.RemovedSharesIDs(); len(nodes) > 0 && !puo.mutation.SharesCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: true,
+			Table:   person.SharesTable,
+			Columns: []string{person.SharesColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(debtshare.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := puo.mutation.SharesIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: true,
+			Table:   person.SharesTable,
+			Columns: []string{person.SharesColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(debtshare.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	_node = &Person{config: puo.config}
+	_spec.Assign = _node.assignValues
+	_spec.ScanValues = _node.scanValues
+	if err = sqlgraph.UpdateNode(ctx, puo.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{person.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	puo.mutation.done = true
+	return _node, nil
+}
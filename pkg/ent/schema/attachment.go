@@ -0,0 +1,51 @@
+package schema
+
+import (
+	"backend-go/pkg/mixins"
+
+	"entgo.io/ent"
+	"entgo.io/ent/schema/edge"
+	"entgo.io/ent/schema/field"
+	"entgo.io/ent/schema/index"
+	"github.com/google/uuid"
+)
+
+// Attachment é um arquivo (comprovante, PDF da fatura) ligado a um débito ou
+// a uma fatura. O conteúdo fica no storage, identificado por storage_key.
+type Attachment struct {
+	ent.Schema
+}
+
+func (Attachment) Mixin() []ent.Mixin {
+	return []ent.Mixin{
+		mixins.UUIDMixin{},
+		mixins.TimestampsMixin{},
+	}
+}
+
+func (Attachment) Fields() []ent.Field {
+	return []ent.Field{
+		field.String("file_name").MaxLen(255),
+		field.String("content_type").MaxLen(127),
+		field.Int64("size").NonNegative(),
+		field.String("storage_key").Unique().Immutable(),
+		field.UUID("workspace_id", uuid.UUID{}),
+		field.UUID("debt_id", uuid.UUID{}).Optional().Nillable(),
+		field.UUID("invoice_id", uuid.UUID{}).Optional().Nillable(),
+	}
+}
+
+func (Attachment) Edges() []ent.Edge {
+	return []ent.Edge{
+		edge.To("workspace", Workspace.Type).Unique().Required().Field("workspace_id"),
+		edge.From("debt", Debt.Type).Ref("attachments").Unique().Field("debt_id"),
+		edge.From("invoice", Invoice.Type).Ref("attachments").Unique().Field("invoice_id"),
+	}
+}
+
+func (Attachment) Indexes() []ent.Index {
+	return []ent.Index{
+		index.Fields("debt_id"),
+		index.Fields("invoice_id"),
+	}
+}
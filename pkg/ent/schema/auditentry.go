@@ -0,0 +1,46 @@
+package schema
+
+import (
+	"backend-go/pkg/mixins"
+	"time"
+
+	"entgo.io/ent"
+	"entgo.io/ent/schema/field"
+	"entgo.io/ent/schema/index"
+	"github.com/google/uuid"
+)
+
+// AuditEntry registra uma alteração feita em uma entidade. Não possui edges
+// para que o histórico continue existindo depois que a entidade é apagada.
+type AuditEntry struct {
+	ent.Schema
+}
+
+func (AuditEntry) Mixin() []ent.Mixin {
+	return []ent.Mixin{
+		mixins.UUIDMixin{},
+	}
+}
+
+func (AuditEntry) Fields() []ent.Field {
+	return []ent.Field{
+		field.String("entity_type").MaxLen(64).Immutable(),
+		field.UUID("entity_id", uuid.UUID{}).Immutable(),
+		field.Enum("action").Values("create", "update", "delete", "restore").Immutable(),
+		field.UUID("actor_id", uuid.UUID{}).Optional().Nillable().Immutable(),
+		field.Bool("via_api_key").Default(false).Immutable(),
+		field.String("request_id").MaxLen(128).Optional().Nillable().Immutable(),
+		field.UUID("workspace_id", uuid.UUID{}).Optional().Nillable().Immutable(),
+		// Apenas os campos alterados; na criação e na remoção, todos os campos
+		field.JSON("old_values", map[string]any{}).Optional().Immutable(),
+		field.JSON("new_values", map[string]any{}).Optional().Immutable(),
+		field.Time("created_at").Default(time.Now).Immutable(),
+	}
+}
+
+func (AuditEntry) Indexes() []ent.Index {
+	return []ent.Index{
+		index.Fields("entity_type", "entity_id", "created_at"),
+		index.Fields("workspace_id"),
+	}
+}
@@ -4,7 +4,10 @@ import (
 	"backend-go/pkg/mixins"
 
 	"entgo.io/ent"
+	"entgo.io/ent/schema/edge"
 	"entgo.io/ent/schema/field"
+	"entgo.io/ent/schema/index"
+	"github.com/google/uuid"
 )
 
 type Category struct {
@@ -15,6 +18,8 @@ func (Category) Mixin() []ent.Mixin {
 	return []ent.Mixin{
 		mixins.UUIDMixin{},
 		mixins.TimestampsMixin{},
+		mixins.VersionMixin{},
+		mixins.SoftDeleteMixin{},
 	}
 }
 
@@ -22,5 +27,19 @@ func (Category) Fields() []ent.Field {
 	return []ent.Field{
 		field.String("name").MaxLen(255),
 		field.String("description").Optional().Nillable(),
+		field.UUID("workspace_id", uuid.UUID{}),
+	}
+}
+
+func (Category) Edges() []ent.Edge {
+	return []ent.Edge{
+		edge.To("workspace", Workspace.Type).Unique().Required().Field("workspace_id"),
+		edge.From("debts", Debt.Type).Ref("category"),
+	}
+}
+
+func (Category) Indexes() []ent.Index {
+	return []ent.Index{
+		index.Fields("workspace_id"),
 	}
 }
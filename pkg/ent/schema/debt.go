@@ -4,8 +4,11 @@ import (
 	"backend-go/pkg/mixins"
 
 	"entgo.io/ent"
+	"entgo.io/ent/dialect/entsql"
 	"entgo.io/ent/schema/edge"
 	"entgo.io/ent/schema/field"
+	"entgo.io/ent/schema/index"
+	"github.com/google/uuid"
 )
 
 type Debt struct {
@@ -16,6 +19,8 @@ func (Debt) Mixin() []ent.Mixin {
 	return []ent.Mixin{
 		mixins.UUIDMixin{},
 		mixins.TimestampsMixin{},
+		mixins.VersionMixin{},
+		mixins.SoftDeleteMixin{},
 		mixins.MoneyMixin{Name: "amount"},
 	}
 }
@@ -25,6 +30,12 @@ func (Debt) Fields() []ent.Field {
 		field.String("title").MaxLen(255),
 		field.Time("purchase_date"),
 		field.Time("due_date"),
+		field.UUID("workspace_id", uuid.UUID{}),
+		// Estornos têm o valor positivo e são subtraídos nos totais
+		field.Enum("kind").
+			Values("purchase", "refund", "fee", "interest", "iof").
+			Default("purchase"),
+		field.UUID("refund_of_id", uuid.UUID{}).Optional().Nillable(),
 	}
 }
 
@@ -33,5 +44,20 @@ func (Debt) Edges() []ent.Edge {
 		edge.To("invoice", Invoice.Type).Unique().StorageKey(edge.Column("invoice_id")),
 		edge.To("category", Category.Type).Unique().StorageKey(edge.Column("category_id")),
 		edge.To("status", PaymentStatus.Type).Unique().StorageKey(edge.Column("status_id")),
+		edge.To("workspace", Workspace.Type).Unique().Required().Field("workspace_id"),
+		edge.To("shares", DebtShare.Type).Annotations(entsql.OnDelete(entsql.Cascade)),
+		edge.To("tags", Tag.Type),
+		edge.To("attachments", Attachment.Type).Annotations(entsql.OnDelete(entsql.Cascade)),
+		edge.To("refunds", Debt.Type).
+			From("refund_of").
+			Unique().
+			Field("refund_of_id"),
+	}
+}
+
+func (Debt) Indexes() []ent.Index {
+	return []ent.Index{
+		index.Fields("workspace_id"),
+		index.Fields("refund_of_id"),
 	}
 }
@@ -0,0 +1,51 @@
+package schema
+
+import (
+	"backend-go/pkg/mixins"
+
+	"entgo.io/ent"
+	"entgo.io/ent/schema/edge"
+	"entgo.io/ent/schema/field"
+	"entgo.io/ent/schema/index"
+	"github.com/google/uuid"
+)
+
+// DebtShare é a parte de um débito que cabe a uma pessoa. O valor é sempre
+// salvo; percentage só é preenchido quando a divisão foi feita em percentual.
+type DebtShare struct {
+	ent.Schema
+}
+
+func (DebtShare) Mixin() []ent.Mixin {
+	return []ent.Mixin{
+		mixins.UUIDMixin{},
+		mixins.TimestampsMixin{},
+		mixins.MoneyMixin{Name: "amount"},
+	}
+}
+
+func (DebtShare) Fields() []ent.Field {
+	return []ent.Field{
+		field.Float("percentage").
+			Optional().
+			Nillable().
+			SchemaType(map[string]string{"postgres": "decimal(5,2)"}),
+		field.Bool("settled").Default(false),
+		field.UUID("debt_id", uuid.UUID{}),
+		field.UUID("person_id", uuid.UUID{}),
+	}
+}
+
+func (DebtShare) Edges() []ent.Edge {
+	return []ent.Edge{
+		edge.From("debt", Debt.Type).Ref("shares").Unique().Required().Field("debt_id"),
+		edge.To("person", Person.Type).Unique().Required().Field("person_id"),
+	}
+}
+
+func (DebtShare) Indexes() []ent.Index {
+	return []ent.Index{
+		index.Fields("debt_id", "person_id").Unique(),
+		index.Fields("person_id"),
+	}
+}
@@ -4,8 +4,11 @@ import (
 	"backend-go/pkg/mixins"
 
 	"entgo.io/ent"
+	"entgo.io/ent/dialect/entsql"
 	"entgo.io/ent/schema/edge"
 	"entgo.io/ent/schema/field"
+	"entgo.io/ent/schema/index"
+	"github.com/google/uuid"
 )
 
 type Invoice struct {
@@ -16,6 +19,8 @@ func (Invoice) Mixin() []ent.Mixin {
 	return []ent.Mixin{
 		mixins.UUIDMixin{},
 		mixins.TimestampsMixin{},
+		mixins.VersionMixin{},
+		mixins.SoftDeleteMixin{},
 		mixins.MoneyMixin{Name: "amount"},
 	}
 }
@@ -25,11 +30,21 @@ func (Invoice) Fields() []ent.Field {
 		field.String("title").MaxLen(255),
 		field.Time("issue_date"),
 		field.Time("due_date"),
+		field.UUID("workspace_id", uuid.UUID{}),
 	}
 }
 
 func (Invoice) Edges() []ent.Edge {
 	return []ent.Edge{
 		edge.To("status", PaymentStatus.Type).Unique().StorageKey(edge.Column("status_id")),
+		edge.To("workspace", Workspace.Type).Unique().Required().Field("workspace_id"),
+		edge.To("attachments", Attachment.Type).Annotations(entsql.OnDelete(entsql.Cascade)),
+		edge.From("debts", Debt.Type).Ref("invoice"),
+	}
+}
+
+func (Invoice) Indexes() []ent.Index {
+	return []ent.Index{
+		index.Fields("workspace_id"),
 	}
 }
@@ -15,6 +15,7 @@ func (PaymentStatus) Mixin() []ent.Mixin {
 	return []ent.Mixin{
 		mixins.UUIDMixin{},
 		mixins.TimestampsMixin{},
+		mixins.VersionMixin{},
 	}
 }
 
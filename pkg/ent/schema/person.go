@@ -0,0 +1,55 @@
+package schema
+
+import (
+	"backend-go/pkg/mixins"
+
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/entsql"
+	"entgo.io/ent/schema"
+	"entgo.io/ent/schema/edge"
+	"entgo.io/ent/schema/field"
+	"entgo.io/ent/schema/index"
+	"github.com/google/uuid"
+)
+
+// Person é alguém com quem o usuário divide despesas. Não precisa ser um
+// usuário do sistema.
+type Person struct {
+	ent.Schema
+}
+
+func (Person) Annotations() []schema.Annotation {
+	return []schema.Annotation{
+		entsql.Annotation{Table: "people"},
+	}
+}
+
+func (Person) Mixin() []ent.Mixin {
+	return []ent.Mixin{
+		mixins.UUIDMixin{},
+		mixins.TimestampsMixin{},
+		mixins.VersionMixin{},
+		mixins.SoftDeleteMixin{},
+	}
+}
+
+func (Person) Fields() []ent.Field {
+	return []ent.Field{
+		field.String("name").MaxLen(255),
+		field.String("email").Optional().Nillable(),
+		field.UUID("workspace_id", uuid.UUID{}),
+	}
+}
+
+func (Person) Edges() []ent.Edge {
+	return []ent.Edge{
+		edge.To("workspace", Workspace.Type).Unique().Required().Field("workspace_id"),
+		edge.From("shares", DebtShare.Type).Ref("person"),
+	}
+}
+
+func (Person) Indexes() []ent.Index {
+	return []ent.Index{
+		index.Fields("workspace_id"),
+	}
+}
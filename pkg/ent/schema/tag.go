@@ -0,0 +1,45 @@
+package schema
+
+import (
+	"backend-go/pkg/mixins"
+
+	"entgo.io/ent"
+	"entgo.io/ent/schema/edge"
+	"entgo.io/ent/schema/field"
+	"entgo.io/ent/schema/index"
+	"github.com/google/uuid"
+)
+
+// Tag é um rótulo livre, como trip-2025 ou reimbursable. Ao contrário da
+// categoria, um débito pode ter várias tags.
+type Tag struct {
+	ent.Schema
+}
+
+func (Tag) Mixin() []ent.Mixin {
+	return []ent.Mixin{
+		mixins.UUIDMixin{},
+		mixins.TimestampsMixin{},
+		mixins.VersionMixin{},
+	}
+}
+
+func (Tag) Fields() []ent.Field {
+	return []ent.Field{
+		field.String("name").MaxLen(64),
+		field.UUID("workspace_id", uuid.UUID{}),
+	}
+}
+
+func (Tag) Edges() []ent.Edge {
+	return []ent.Edge{
+		edge.To("workspace", Workspace.Type).Unique().Required().Field("workspace_id"),
+		edge.From("debts", Debt.Type).Ref("tags"),
+	}
+}
+
+func (Tag) Indexes() []ent.Index {
+	return []ent.Index{
+		index.Fields("workspace_id", "name").Unique(),
+	}
+}
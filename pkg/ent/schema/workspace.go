@@ -0,0 +1,32 @@
+package schema
+
+import (
+	"backend-go/pkg/mixins"
+
+	"entgo.io/ent"
+	"entgo.io/ent/schema/edge"
+	"entgo.io/ent/schema/field"
+)
+
+type Workspace struct {
+	ent.Schema
+}
+
+func (Workspace) Mixin() []ent.Mixin {
+	return []ent.Mixin{
+		mixins.UUIDMixin{},
+		mixins.TimestampsMixin{},
+	}
+}
+
+func (Workspace) Fields() []ent.Field {
+	return []ent.Field{
+		field.String("name").MaxLen(255),
+	}
+}
+
+func (Workspace) Edges() []ent.Edge {
+	return []ent.Edge{
+		edge.From("members", WorkspaceMember.Type).Ref("workspace"),
+	}
+}
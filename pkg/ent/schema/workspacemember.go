@@ -0,0 +1,44 @@
+package schema
+
+import (
+	"backend-go/pkg/mixins"
+
+	"entgo.io/ent"
+	"entgo.io/ent/schema/edge"
+	"entgo.io/ent/schema/field"
+	"entgo.io/ent/schema/index"
+	"github.com/google/uuid"
+)
+
+type WorkspaceMember struct {
+	ent.Schema
+}
+
+func (WorkspaceMember) Mixin() []ent.Mixin {
+	return []ent.Mixin{
+		mixins.UUIDMixin{},
+		mixins.TimestampsMixin{},
+	}
+}
+
+func (WorkspaceMember) Fields() []ent.Field {
+	return []ent.Field{
+		field.Enum("role").Values("owner", "editor", "viewer").Default("viewer"),
+		field.UUID("workspace_id", uuid.UUID{}),
+		field.UUID("user_id", uuid.UUID{}),
+	}
+}
+
+func (WorkspaceMember) Edges() []ent.Edge {
+	return []ent.Edge{
+		edge.To("workspace", Workspace.Type).Unique().Required().Field("workspace_id"),
+		edge.To("user", User.Type).Unique().Required().Field("user_id"),
+	}
+}
+
+func (WorkspaceMember) Indexes() []ent.Index {
+	return []ent.Index{
+		index.Fields("workspace_id", "user_id").Unique(),
+		index.Fields("user_id"),
+	}
+}
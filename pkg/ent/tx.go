@@ -12,14 +12,32 @@ import (
 // Tx is a transactional client that is created by calling Client.Tx().
 type Tx struct {
 	config
+	// APIKey is the client for interacting with the APIKey builders.
+	APIKey *APIKeyClient
+	// Attachment is the client for interacting with the Attachment builders.
+	Attachment *AttachmentClient
+	// AuditEntry is the client for interacting with the AuditEntry builders.
+	AuditEntry *AuditEntryClient
 	// Category is the client for interacting with the Category builders.
 	Category *CategoryClient
 	// Debt is the client for interacting with the Debt builders.
 	Debt *DebtClient
+	// DebtShare is the client for interacting with the DebtShare builders.
+	DebtShare *DebtShareClient
 	// Invoice is the client for interacting with the Invoice builders.
 	Invoice *InvoiceClient
 	// PaymentStatus is the client for interacting with the PaymentStatus builders.
 	PaymentStatus *PaymentStatusClient
+	// Person is the client for interacting with the Person builders.
+	Person *PersonClient
+	// Tag is the client for interacting with the Tag builders.
+	Tag *TagClient
+	// User is the client for interacting with the User builders.
+	User *UserClient
+	// Workspace is the client for interacting with the Workspace builders.
+	Workspace *WorkspaceClient
+	// WorkspaceMember is the client for interacting with the WorkspaceMember builders.
+	WorkspaceMember *WorkspaceMemberClient
 
 	// lazily loaded.
 	client     *Client
@@ -151,10 +169,19 @@ func (tx *Tx) Client() *Client {
 }
 
 func (tx *Tx) init() {
+	tx.APIKey = NewAPIKeyClient(tx.config)
+	tx.Attachment = NewAttachmentClient(tx.config)
+	tx.AuditEntry = NewAuditEntryClient(tx.config)
 	tx.Category = NewCategoryClient(tx.config)
 	tx.Debt = NewDebtClient(tx.config)
+	tx.DebtShare = NewDebtShareClient(tx.config)
 	tx.Invoice = NewInvoiceClient(tx.config)
 	tx.PaymentStatus = NewPaymentStatusClient(tx.config)
+	tx.Person = NewPersonClient(tx.config)
+	tx.Tag = NewTagClient(tx.config)
+	tx.User = NewUserClient(tx.config)
+	tx.Workspace = NewWorkspaceClient(tx.config)
+	tx.WorkspaceMember = NewWorkspaceMemberClient(tx.config)
 }
 
 // txDriver wraps the given dialect.Tx with a nop dialect.Driver implementation.
@@ -164,7 +191,7 @@ func (tx *Tx) init() {
 // of them in order to commit or rollback the transaction.
 //
 // If a closed transaction is embedded in one of the generated entities, and the entity
-// applies a query, for example: Category.QueryXXX(), the query will be executed
+// applies a query, for example: APIKey.QueryXXX(), the query will be executed
 // through the driver which created this transaction.
 //
 // Note that txDriver is not goroutine safe.
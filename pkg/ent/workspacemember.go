@@ -0,0 +1,199 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"backend-go/pkg/ent/user"
+	"backend-go/pkg/ent/workspace"
+	"backend-go/pkg/ent/workspacemember"
+	"fmt"
+	"strings"
+	"time"
+
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/sql"
+	"github.com/google/uuid"
+)
+
+// WorkspaceMember is the model entity for the WorkspaceMember schema.
+type WorkspaceMember struct {
+	config `json:"-"`
+	// ID of the ent.
+	ID uuid.UUID `json:"id,omitempty"`
+	// CreatedAt holds the value of the "created_at" field.
+	CreatedAt time.Time `json:"created_at,omitempty"`
+	// UpdatedAt holds the value of the "updated_at" field.
+	UpdatedAt time.Time `json:"updated_at,omitempty"`
+	// Role holds the value of the "role" field.
+	Role workspacemember.Role `json:"role,omitempty"`
+	// WorkspaceID holds the value of the "workspace_id" field.
+	WorkspaceID uuid.UUID `json:"workspace_id,omitempty"`
+	// UserID holds the value of the "user_id" field.
+	UserID uuid.UUID `json:"user_id,omitempty"`
+	// Edges holds the relations/edges for other nodes in the graph.
+	// The values are being populated by the WorkspaceMemberQuery when eager-loading is set.
+	Edges        WorkspaceMemberEdges `json:"edges"`
+	selectValues sql.SelectValues
+}
+
+// WorkspaceMemberEdges holds the relations/edges for other nodes in the graph.
+type WorkspaceMemberEdges struct {
+	// Workspace holds the value of the workspace edge.
+	Workspace *Workspace `json:"workspace,omitempty"`
+	// User holds the value of the user edge.
+	User *User `json:"user,omitempty"`
+	// loadedTypes holds the information for reporting if a
+	// type was loaded (or requested) in eager-loading or not.
+	loadedTypes [2]bool
+}
+
+// WorkspaceOrErr returns the Workspace value or an error if the edge
+// was not loaded in eager-loading, or loaded but was not found.
+func (e WorkspaceMemberEdges) WorkspaceOrErr() (*Workspace, error) {
+	if e.Workspace != nil {
+		return e.Workspace, nil
+	} else if e.loadedTypes[0] {
+		return nil, &NotFoundError{label: workspace.Label}
+	}
+	return nil, &NotLoadedError{edge: "workspace"}
+}
+
+// UserOrErr returns the User value or an error if the edge
+// was not loaded in eager-loading, or loaded but was not found.
+func (e WorkspaceMemberEdges) UserOrErr() (*User, error) {
+	if e.User != nil {
+		return e.User, nil
+	} else if e.loadedTypes[1] {
+		return nil, &NotFoundError{label: user.Label}
+	}
+	return nil, &NotLoadedError{edge: "user"}
+}
+
+// scanValues returns the types for scanning values from sql.Rows.
+func (*WorkspaceMember) scanValues(columns []string) ([]any, error) {
+	values := make([]any, len(columns))
+	for i := range columns {
+		switch columns[i] {
+		case workspacemember.FieldRole:
+			values[i] = new(sql.NullString)
+		case workspacemember.FieldCreatedAt, workspacemember.FieldUpdatedAt:
+			values[i] = new(sql.NullTime)
+		case workspacemember.FieldID, workspacemember.FieldWorkspaceID, workspacemember.FieldUserID:
+			values[i] = new(uuid.UUID)
+		default:
+			values[i] = new(sql.UnknownType)
+		}
+	}
+	return values, nil
+}
+
+// assignValues assigns the values that were returned from sql.Rows (after scanning)
+// to the WorkspaceMember fields.
+func (wm *WorkspaceMember) assignValues(columns []string, values []any) error {
+	if m, n := len(values), len(columns); m < n {
+		return fmt.Errorf("mismatch number of scan values: %d != %d", m, n)
+	}
+	for i := range columns {
+		switch columns[i] {
+		case workspacemember.FieldID:
+			if value, ok := values[i].(*uuid.UUID); !ok {
+				return fmt.Errorf("unexpected type %T for field id", values[i])
+			} else if value != nil {
+				wm.ID = *value
+			}
+		case workspacemember.FieldCreatedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field created_at", values[i])
+			} else if value.Valid {
+				wm.CreatedAt = value.Time
+			}
+		case workspacemember.FieldUpdatedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field updated_at", values[i])
+			} else if value.Valid {
+				wm.UpdatedAt = value.Time
+			}
+		case workspacemember.FieldRole:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field role", values[i])
+			} else if value.Valid {
+				wm.Role = workspacemember.Role(value.String)
+			}
+		case workspacemember.FieldWorkspaceID:
+			if value, ok := values[i].(*uuid.UUID); !ok {
+				return fmt.Errorf("unexpected type %T for field workspace_id", values[i])
+			} else if value != nil {
+				wm.WorkspaceID = *value
+			}
+		case workspacemember.FieldUserID:
+			if value, ok := values[i].(*uuid.UUID); !ok {
+				return fmt.Errorf("unexpected type %T for field user_id", values[i])
+			} else if value != nil {
+				wm.UserID = *value
+			}
+		default:
+			wm.selectValues.Set(columns[i], values[i])
+		}
+	}
+	return nil
+}
+
+// Value returns the ent.Value that was dynamically selected and assigned to the WorkspaceMember.
+// This includes values selected through modifiers, order, etc.
+func (wm *WorkspaceMember) Value(name string) (ent.Value, error) {
+	return wm.selectValues.Get(name)
+}
+
+// QueryWorkspace queries the "workspace" edge of the WorkspaceMember entity.
+func (wm *WorkspaceMember) QueryWorkspace() *WorkspaceQuery {
+	return NewWorkspaceMemberClient(wm.config).QueryWorkspace(wm)
+}
+
+// QueryUser queries the "user" edge of the WorkspaceMember entity.
+func (wm *WorkspaceMember) QueryUser() *UserQuery {
+	return NewWorkspaceMemberClient(wm.config).QueryUser(wm)
+}
+
+// Update returns a builder for updating this WorkspaceMember.
+// Note that you need to call WorkspaceMember.Unwrap() before calling this method if this WorkspaceMember
+// was returned from a transaction, and the transaction was committed or rolled back.
+func (wm *WorkspaceMember) Update() *WorkspaceMemberUpdateOne {
+	return NewWorkspaceMemberClient(wm.config).UpdateOne(wm)
+}
+
+// Unwrap unwraps the WorkspaceMember entity that was returned from a transaction after it was closed,
+// so that all future queries will be executed through the driver which created the transaction.
+func (wm *WorkspaceMember) Unwrap() *WorkspaceMember {
+	_tx, ok := wm.config.driver.(*txDriver)
+	if !ok {
+		panic("ent: WorkspaceMember is not a transactional entity")
+	}
+	wm.config.driver = _tx.drv
+	return wm
+}
+
+// String implements the fmt.Stringer.
+func (wm *WorkspaceMember) String() string {
+	var builder strings.Builder
+	builder.WriteString("WorkspaceMember(")
+	builder.WriteString(fmt.Sprintf("id=%v, ", wm.ID))
+	builder.WriteString("created_at=")
+	builder.WriteString(wm.CreatedAt.Format(time.ANSIC))
+	builder.WriteString(", ")
+	builder.WriteString("updated_at=")
+	builder.WriteString(wm.UpdatedAt.Format(time.ANSIC))
+	builder.WriteString(", ")
+	builder.WriteString("role=")
+	builder.WriteString(fmt.Sprintf("%v", wm.Role))
+	builder.WriteString(", ")
+	builder.WriteString("workspace_id=")
+	builder.WriteString(fmt.Sprintf("%v", wm.WorkspaceID))
+	builder.WriteString(", ")
+	builder.WriteString("user_id=")
+	builder.WriteString(fmt.Sprintf("%v", wm.UserID))
+	builder.WriteByte(')')
+	return builder.String()
+}
+
+// WorkspaceMembers is a parsable slice of WorkspaceMember.
+type WorkspaceMembers []*WorkspaceMember
@@ -0,0 +1,88 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"backend-go/pkg/ent/predicate"
+	"backend-go/pkg/ent/workspacemember"
+	"context"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+)
+
+// WorkspaceMemberDelete is the builder for deleting a WorkspaceMember entity.
+type WorkspaceMemberDelete struct {
+	config
+	hooks    []Hook
+	mutation *WorkspaceMemberMutation
+}
+
+// Where appends a list predicates to the WorkspaceMemberDelete builder.
+func (wmd *WorkspaceMemberDelete) Where(ps ...predicate.WorkspaceMember) *WorkspaceMemberDelete {
+	wmd.mutation.Where(ps...)
+	return wmd
+}
+
+// Exec executes the deletion query and returns how many vertices were deleted.
+func (wmd *WorkspaceMemberDelete) Exec(ctx context.Context) (int, error) {
+	return withHooks(ctx, wmd.sqlExec, wmd.mutation, wmd.hooks)
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (wmd *WorkspaceMemberDelete) ExecX(ctx context.Context) int {
+	n, err := wmd.Exec(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return n
+}
+
+func (wmd *WorkspaceMemberDelete) sqlExec(ctx context.Context) (int, error) {
+	_spec := sqlgraph.NewDeleteSpec(workspacemember.Table, sqlgraph.NewFieldSpec(workspacemember.FieldID, field.TypeUUID))
+	if ps := wmd.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	affected, err := sqlgraph.DeleteNodes(ctx, wmd.driver, _spec)
+	if err != nil && sqlgraph.IsConstraintError(err) {
+		err = &ConstraintError{msg: err.Error(), wrap: err}
+	}
+	wmd.mutation.done = true
+	return affected, err
+}
+
+// WorkspaceMemberDeleteOne is the builder for deleting a single WorkspaceMember entity.
+type WorkspaceMemberDeleteOne struct {
+	wmd *WorkspaceMemberDelete
+}
+
+// Where appends a list predicates to the WorkspaceMemberDelete builder.
+func (wmdo *WorkspaceMemberDeleteOne) Where(ps ...predicate.WorkspaceMember) *WorkspaceMemberDeleteOne {
+	wmdo.wmd.mutation.Where(ps...)
+	return wmdo
+}
+
+// Exec executes the deletion query.
+func (wmdo *WorkspaceMemberDeleteOne) Exec(ctx context.Context) error {
+	n, err := wmdo.wmd.Exec(ctx)
+	switch {
+	case err != nil:
+		return err
+	case n == 0:
+		return &NotFoundError{workspacemember.Label}
+	default:
+		return nil
+	}
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (wmdo *WorkspaceMemberDeleteOne) ExecX(ctx context.Context) {
+	if err := wmdo.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
@@ -0,0 +1,682 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"backend-go/pkg/ent/predicate"
+	"backend-go/pkg/ent/user"
+	"backend-go/pkg/ent/workspace"
+	"backend-go/pkg/ent/workspacemember"
+	"context"
+	"fmt"
+	"math"
+
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/google/uuid"
+)
+
+// WorkspaceMemberQuery is the builder for querying WorkspaceMember entities.
+type WorkspaceMemberQuery struct {
+	config
+	ctx           *QueryContext
+	order         []workspacemember.OrderOption
+	inters        []Interceptor
+	predicates    []predicate.WorkspaceMember
+	withWorkspace *WorkspaceQuery
+	withUser      *UserQuery
+	// intermediate query (i.e. traversal path).
+	sql  *sql.Selector
+	path func(context.Context) (*sql.Selector, error)
+}
+
+// Where adds a new predicate for the WorkspaceMemberQuery builder.
+func (wmq *WorkspaceMemberQuery) Where(ps ...predicate.WorkspaceMember) *WorkspaceMemberQuery {
+	wmq.predicates = append(wmq.predicates, ps...)
+	return wmq
+}
+
+// Limit the number of records to be returned by this query.
+func (wmq *WorkspaceMemberQuery) Limit(limit int) *WorkspaceMemberQuery {
+	wmq.ctx.Limit = &limit
+	return wmq
+}
+
+// Offset to start from.
+func (wmq *WorkspaceMemberQuery) Offset(offset int) *WorkspaceMemberQuery {
+	wmq.ctx.Offset = &offset
+	return wmq
+}
+
+// Unique configures the query builder to filter duplicate records on query.
+// By default, unique is set to true, and can be disabled using this method.
+func (wmq *WorkspaceMemberQuery) Unique(unique bool) *WorkspaceMemberQuery {
+	wmq.ctx.Unique = &unique
+	return wmq
+}
+
+// Order specifies how the records should be ordered.
+func (wmq *WorkspaceMemberQuery) Order(o ...workspacemember.OrderOption) *WorkspaceMemberQuery {
+	wmq.order = append(wmq.order, o...)
+	return wmq
+}
+
+// QueryWorkspace chains the current query on the "workspace" edge.
+func (wmq *WorkspaceMemberQuery) QueryWorkspace() *WorkspaceQuery {
+	query := (&WorkspaceClient{config: wmq.config}).Query()
+	query.path = func(ctx context.Context) (fromU *sql.Selector, err error) {
+		if err := wmq.prepareQuery(ctx); err != nil {
+			return nil, err
+		}
+		selector := wmq.sqlQuery(ctx)
+		if err := selector.Err(); err != nil {
+			return nil, err
+		}
+		step := sqlgraph.NewStep(
+			sqlgraph.From(workspacemember.Table, workspacemember.FieldID, selector),
+			sqlgraph.To(workspace.Table, workspace.FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, false, workspacemember.WorkspaceTable, workspacemember.WorkspaceColumn),
+		)
+		fromU = sqlgraph.SetNeighbors(wmq.driver.Dialect(), step)
+		return fromU, nil
+	}
+	return query
+}
+
+// QueryUser chains the current query on the "user" edge.
+func (wmq *WorkspaceMemberQuery) QueryUser() *UserQuery {
+	query := (&UserClient{config: wmq.config}).Query()
+	query.path = func(ctx context.Context) (fromU *sql.Selector, err error) {
+		if err := wmq.prepareQuery(ctx); err != nil {
+			return nil, err
+		}
+		selector := wmq.sqlQuery(ctx)
+		if err := selector.Err(); err != nil {
+			return nil, err
+		}
+		step := sqlgraph.NewStep(
+			sqlgraph.From(workspacemember.Table, workspacemember.FieldID, selector),
+			sqlgraph.To(user.Table, user.FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, false, workspacemember.UserTable, workspacemember.UserColumn),
+		)
+		fromU = sqlgraph.SetNeighbors(wmq.driver.Dialect(), step)
+		return fromU, nil
+	}
+	return query
+}
+
+// First returns the first WorkspaceMember entity from the query.
+// Returns a *NotFoundError when no WorkspaceMember was found.
+func (wmq *WorkspaceMemberQuery) First(ctx context.Context) (*WorkspaceMember, error) {
+	nodes, err := wmq.Limit(1).All(setContextOp(ctx, wmq.ctx, ent.OpQueryFirst))
+	if err != nil {
+		return nil, err
+	}
+	if len(nodes) == 0 {
+		return nil, &NotFoundError{workspacemember.Label}
+	}
+	return nodes[0], nil
+}
+
+// FirstX is like First, but panics if an error occurs.
+func (wmq *WorkspaceMemberQuery) FirstX(ctx context.Context) *WorkspaceMember {
+	node, err := wmq.First(ctx)
+	if err != nil && !IsNotFound(err) {
+		panic(err)
+	}
+	return node
+}
+
+// FirstID returns the first WorkspaceMember ID from the query.
+// Returns a *NotFoundError when no WorkspaceMember ID was found.
+func (wmq *WorkspaceMemberQuery) FirstID(ctx context.Context) (id uuid.UUID, err error) {
+	var ids []uuid.UUID
+	if ids, err = wmq.Limit(1).IDs(setContextOp(ctx, wmq.ctx, ent.OpQueryFirstID)); err != nil {
+		return
+	}
+	if len(ids) == 0 {
+		err = &NotFoundError{workspacemember.Label}
+		return
+	}
+	return ids[0], nil
+}
+
+// FirstIDX is like FirstID, but panics if an error occurs.
+func (wmq *WorkspaceMemberQuery) FirstIDX(ctx context.Context) uuid.UUID {
+	id, err := wmq.FirstID(ctx)
+	if err != nil && !IsNotFound(err) {
+		panic(err)
+	}
+	return id
+}
+
+// Only returns a single WorkspaceMember entity found by the query, ensuring it only returns one.
+// Returns a *NotSingularError when more than one WorkspaceMember entity is found.
+// Returns a *NotFoundError when no WorkspaceMember entities are found.
+func (wmq *WorkspaceMemberQuery) Only(ctx context.Context) (*WorkspaceMember, error) {
+	nodes, err := wmq.Limit(2).All(setContextOp(ctx, wmq.ctx, ent.OpQueryOnly))
+	if err != nil {
+		return nil, err
+	}
+	switch len(nodes) {
+	case 1:
+		return nodes[0], nil
+	case 0:
+		return nil, &NotFoundError{workspacemember.Label}
+	default:
+		return nil, &NotSingularError{workspacemember.Label}
+	}
+}
+
+// OnlyX is like Only, but panics if an error occurs.
+func (wmq *WorkspaceMemberQuery) OnlyX(ctx context.Context) *WorkspaceMember {
+	node, err := wmq.Only(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// OnlyID is like Only, but returns the only WorkspaceMember ID in the query.
+// Returns a *NotSingularError when more than one WorkspaceMember ID is found.
+// Returns a *NotFoundError when no entities are found.
+func (wmq *WorkspaceMemberQuery) OnlyID(ctx context.Context) (id uuid.UUID, err error) {
+	var ids []uuid.UUID
+	if ids, err = wmq.Limit(2).IDs(setContextOp(ctx, wmq.ctx, ent.OpQueryOnlyID)); err != nil {
+		return
+	}
+	switch len(ids) {
+	case 1:
+		id = ids[0]
+	case 0:
+		err = &NotFoundError{workspacemember.Label}
+	default:
+		err = &NotSingularError{workspacemember.Label}
+	}
+	return
+}
+
+// OnlyIDX is like OnlyID, but panics if an error occurs.
+func (wmq *WorkspaceMemberQuery) OnlyIDX(ctx context.Context) uuid.UUID {
+	id, err := wmq.OnlyID(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return id
+}
+
+// All executes the query and returns a list of WorkspaceMembers.
+func (wmq *WorkspaceMemberQuery) All(ctx context.Context) ([]*WorkspaceMember, error) {
+	ctx = setContextOp(ctx, wmq.ctx, ent.OpQueryAll)
+	if err := wmq.prepareQuery(ctx); err != nil {
+		return nil, err
+	}
+	qr := querierAll[[]*WorkspaceMember, *WorkspaceMemberQuery]()
+	return withInterceptors[[]*WorkspaceMember](ctx, wmq, qr, wmq.inters)
+}
+
+// AllX is like All, but panics if an error occurs.
+func (wmq *WorkspaceMemberQuery) AllX(ctx context.Context) []*WorkspaceMember {
+	nodes, err := wmq.All(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return nodes
+}
+
+// IDs executes the query and returns a list of WorkspaceMember IDs.
+func (wmq *WorkspaceMemberQuery) IDs(ctx context.Context) (ids []uuid.UUID, err error) {
+	if wmq.ctx.Unique == nil && wmq.path != nil {
+		wmq.Unique(true)
+	}
+	ctx = setContextOp(ctx, wmq.ctx, ent.OpQueryIDs)
+	if err = wmq.Select(workspacemember.FieldID).Scan(ctx, &ids); err != nil {
+		return nil, err
+	}
+	return ids, nil
+}
+
+// IDsX is like IDs, but panics if an error occurs.
+func (wmq *WorkspaceMemberQuery) IDsX(ctx context.Context) []uuid.UUID {
+	ids, err := wmq.IDs(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return ids
+}
+
+// Count returns the count of the given query.
+func (wmq *WorkspaceMemberQuery) Count(ctx context.Context) (int, error) {
+	ctx = setContextOp(ctx, wmq.ctx, ent.OpQueryCount)
+	if err := wmq.prepareQuery(ctx); err != nil {
+		return 0, err
+	}
+	return withInterceptors[int](ctx, wmq, querierCount[*WorkspaceMemberQuery](), wmq.inters)
+}
+
+// CountX is like Count, but panics if an error occurs.
+func (wmq *WorkspaceMemberQuery) CountX(ctx context.Context) int {
+	count, err := wmq.Count(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return count
+}
+
+// Exist returns true if the query has elements in the graph.
+func (wmq *WorkspaceMemberQuery) Exist(ctx context.Context) (bool, error) {
+	ctx = setContextOp(ctx, wmq.ctx, ent.OpQueryExist)
+	switch _, err := wmq.FirstID(ctx); {
+	case IsNotFound(err):
+		return false, nil
+	case err != nil:
+		return false, fmt.Errorf("ent: check existence: %w", err)
+	default:
+		return true, nil
+	}
+}
+
+// ExistX is like Exist, but panics if an error occurs.
+func (wmq *WorkspaceMemberQuery) ExistX(ctx context.Context) bool {
+	exist, err := wmq.Exist(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return exist
+}
+
+// Clone returns a duplicate of the WorkspaceMemberQuery builder, including all associated steps. It can be
+// used to prepare common query builders and use them differently after the clone is made.
+func (wmq *WorkspaceMemberQuery) Clone() *WorkspaceMemberQuery {
+	if wmq == nil {
+		return nil
+	}
+	return &WorkspaceMemberQuery{
+		config:        wmq.config,
+		ctx:           wmq.ctx.Clone(),
+		order:         append([]workspacemember.OrderOption{}, wmq.order...),
+		inters:        append([]Interceptor{}, wmq.inters...),
+		predicates:    append([]predicate.WorkspaceMember{}, wmq.predicates...),
+		withWorkspace: wmq.withWorkspace.Clone(),
+		withUser:      wmq.withUser.Clone(),
+		// clone intermediate query.
+		sql:  wmq.sql.Clone(),
+		path: wmq.path,
+	}
+}
+
+// WithWorkspace tells the query-builder to eager-load the nodes that are connected to
+// the "workspace" edge. The optional arguments are used to configure the query builder of the edge.
+func (wmq *WorkspaceMemberQuery) WithWorkspace(opts ...func(*WorkspaceQuery)) *WorkspaceMemberQuery {
+	query := (&WorkspaceClient{config: wmq.config}).Query()
+	for _, opt := range opts {
+		opt(query)
+	}
+	wmq.withWorkspace = query
+	return wmq
+}
+
+// WithUser tells the query-builder to eager-load the nodes that are connected to
+// the "user" edge. The optional arguments are used to configure the query builder of the edge.
+func (wmq *WorkspaceMemberQuery) WithUser(opts ...func(*UserQuery)) *WorkspaceMemberQuery {
+	query := (&UserClient{config: wmq.config}).Query()
+	for _, opt := range opts {
+		opt(query)
+	}
+	wmq.withUser = query
+	return wmq
+}
+
+// GroupBy is used to group vertices by one or more fields/columns.
+// It is often used with aggregate functions, like: count, max, mean, min, sum.
+//
+// Example:
+//
+//	var v []struct {
+//		CreatedAt time.Time `json:"created_at,omitempty"`
+//		Count int `json:"count,omitempty"`
+//	}
+//
+//	client.WorkspaceMember.Query().
+//		GroupBy(workspacemember.FieldCreatedAt).
+//		Aggregate(ent.Count()).
+//		Scan(ctx, &v)
+func (wmq *WorkspaceMemberQuery) GroupBy(field string, fields ...string) *WorkspaceMemberGroupBy {
+	wmq.ctx.Fields = append([]string{field}, fields...)
+	grbuild := &WorkspaceMemberGroupBy{build: wmq}
+	grbuild.flds = &wmq.ctx.Fields
+	grbuild.label = workspacemember.Label
+	grbuild.scan = grbuild.Scan
+	return grbuild
+}
+
+// Select allows the selection one or more fields/columns for the given query,
+// instead of selecting all fields in the entity.
+//
+// Example:
+//
+//	var v []struct {
+//		CreatedAt time.Time `json:"created_at,omitempty"`
+//	}
+//
+//	client.WorkspaceMember.Query().
+//		Select(workspacemember.FieldCreatedAt).
+//		Scan(ctx, &v)
+func (wmq *WorkspaceMemberQuery) Select(fields ...string) *WorkspaceMemberSelect {
+	wmq.ctx.Fields = append(wmq.ctx.Fields, fields...)
+	sbuild := &WorkspaceMemberSelect{WorkspaceMemberQuery: wmq}
+	sbuild.label = workspacemember.Label
+	sbuild.flds, sbuild.scan = &wmq.ctx.Fields, sbuild.Scan
+	return sbuild
+}
+
+// Aggregate returns a WorkspaceMemberSelect configured with the given aggregations.
+func (wmq *WorkspaceMemberQuery) Aggregate(fns ...AggregateFunc) *WorkspaceMemberSelect {
+	return wmq.Select().Aggregate(fns...)
+}
+
+func (wmq *WorkspaceMemberQuery) prepareQuery(ctx context.Context) error {
+	for _, inter := range wmq.inters {
+		if inter == nil {
+			return fmt.Errorf("ent: uninitialized interceptor (forgotten import ent/runtime?)")
+		}
+		if trv, ok := inter.(Traverser); ok {
+			if err := trv.Traverse(ctx, wmq); err != nil {
+				return err
+			}
+		}
+	}
+	for _, f := range wmq.ctx.Fields {
+		if !workspacemember.ValidColumn(f) {
+			return &ValidationError{Name: f, err: fmt.Errorf("ent: invalid field %q for query", f)}
+		}
+	}
+	if wmq.path != nil {
+		prev, err := wmq.path(ctx)
+		if err != nil {
+			return err
+		}
+		wmq.sql = prev
+	}
+	return nil
+}
+
+func (wmq *WorkspaceMemberQuery) sqlAll(ctx context.Context, hooks ...queryHook) ([]*WorkspaceMember, error) {
+	var (
+		nodes       = []*WorkspaceMember{}
+		_spec       = wmq.querySpec()
+		loadedTypes = [2]bool{
+			wmq.withWorkspace != nil,
+			wmq.withUser != nil,
+		}
+	)
+	_spec.ScanValues = func(columns []string) ([]any, error) {
+		return (*WorkspaceMember).scanValues(nil, columns)
+	}
+	_spec.Assign = func(columns []string, values []any) error {
+		node := &WorkspaceMember{config: wmq.config}
+		nodes = append(nodes, node)
+		node.Edges.loadedTypes = loadedTypes
+		return node.assignValues(columns, values)
+	}
+	for i := range hooks {
+		hooks[i](ctx, _spec)
+	}
+	if err := sqlgraph.QueryNodes(ctx, wmq.driver, _spec); err != nil {
+		return nil, err
+	}
+	if len(nodes) == 0 {
+		return nodes, nil
+	}
+	if query := wmq.withWorkspace; query != nil {
+		if err := wmq.loadWorkspace(ctx, query, nodes, nil,
+			func(n *WorkspaceMember, e *Workspace) { n.Edges.Workspace = e }); err != nil {
+			return nil, err
+		}
+	}
+	if query := wmq.withUser; query != nil {
+		if err := wmq.loadUser(ctx, query, nodes, nil,
+			func(n *WorkspaceMember, e *User) { n.Edges.User = e }); err != nil {
+			return nil, err
+		}
+	}
+	return nodes, nil
+}
+
+func (wmq *WorkspaceMemberQuery) loadWorkspace(ctx context.Context, query *WorkspaceQuery, nodes []*WorkspaceMember, init func(*WorkspaceMember), assign func(*WorkspaceMember, *Workspace)) error {
+	ids := make([]uuid.UUID, 0, len(nodes))
+	nodeids := make(map[uuid.UUID][]*WorkspaceMember)
+	for i := range nodes {
+		fk := nodes[i].WorkspaceID
+		if _, ok := nodeids[fk]; !ok {
+			ids = append(ids, fk)
+		}
+		nodeids[fk] = append(nodeids[fk], nodes[i])
+	}
+	if len(ids) == 0 {
+		return nil
+	}
+	query.Where(workspace.IDIn(ids...))
+	neighbors, err := query.All(ctx)
+	if err != nil {
+		return err
+	}
+	for _, n := range neighbors {
+		nodes, ok := nodeids[n.ID]
+		if !ok {
+			return fmt.Errorf(`unexpected foreign-key "workspace_id" returned %v`, n.ID)
+		}
+		for i := range nodes {
+			assign(nodes[i], n)
+		}
+	}
+	return nil
+}
+func (wmq *WorkspaceMemberQuery) loadUser(ctx context.Context, query *UserQuery, nodes []*WorkspaceMember, init func(*WorkspaceMember), assign func(*WorkspaceMember, *User)) error {
+	ids := make([]uuid.UUID, 0, len(nodes))
+	nodeids := make(map[uuid.UUID][]*WorkspaceMember)
+	for i := range nodes {
+		fk := nodes[i].UserID
+		if _, ok := nodeids[fk]; !ok {
+			ids = append(ids, fk)
+		}
+		nodeids[fk] = append(nodeids[fk], nodes[i])
+	}
+	if len(ids) == 0 {
+		return nil
+	}
+	query.Where(user.IDIn(ids...))
+	neighbors, err := query.All(ctx)
+	if err != nil {
+		return err
+	}
+	for _, n := range neighbors {
+		nodes, ok := nodeids[n.ID]
+		if !ok {
+			return fmt.Errorf(`unexpected foreign-key "user_id" returned %v`, n.ID)
+		}
+		for i := range nodes {
+			assign(nodes[i], n)
+		}
+	}
+	return nil
+}
+
+func (wmq *WorkspaceMemberQuery) sqlCount(ctx context.Context) (int, error) {
+	_spec := wmq.querySpec()
+	_spec.Node.Columns = wmq.ctx.Fields
+	if len(wmq.ctx.Fields) > 0 {
+		_spec.Unique = wmq.ctx.Unique != nil && *wmq.ctx.Unique
+	}
+	return sqlgraph.CountNodes(ctx, wmq.driver, _spec)
+}
+
+func (wmq *WorkspaceMemberQuery) querySpec() *sqlgraph.QuerySpec {
+	_spec := sqlgraph.NewQuerySpec(workspacemember.Table, workspacemember.Columns, sqlgraph.NewFieldSpec(workspacemember.FieldID, field.TypeUUID))
+	_spec.From = wmq.sql
+	if unique := wmq.ctx.Unique; unique != nil {
+		_spec.Unique = *unique
+	} else if wmq.path != nil {
+		_spec.Unique = true
+	}
+	if fields := wmq.ctx.Fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields))
+		_spec.Node.Columns = append(_spec.Node.Columns, workspacemember.FieldID)
+		for i := range fields {
+			if fields[i] != workspacemember.FieldID {
+				_spec.Node.Columns = append(_spec.Node.Columns, fields[i])
+			}
+		}
+		if wmq.withWorkspace != nil {
+			_spec.Node.AddColumnOnce(workspacemember.FieldWorkspaceID)
+		}
+		if wmq.withUser != nil {
+			_spec.Node.AddColumnOnce(workspacemember.FieldUserID)
+		}
+	}
+	if ps := wmq.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if limit := wmq.ctx.Limit; limit != nil {
+		_spec.Limit = *limit
+	}
+	if offset := wmq.ctx.Offset; offset != nil {
+		_spec.Offset = *offset
+	}
+	if ps := wmq.order; len(ps) > 0 {
+		_spec.Order = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	return _spec
+}
+
+func (wmq *WorkspaceMemberQuery) sqlQuery(ctx context.Context) *sql.Selector {
+	builder := sql.Dialect(wmq.driver.Dialect())
+	t1 := builder.Table(workspacemember.Table)
+	columns := wmq.ctx.Fields
+	if len(columns) == 0 {
+		columns = workspacemember.Columns
+	}
+	selector := builder.Select(t1.Columns(columns...)...).From(t1)
+	if wmq.sql != nil {
+		selector = wmq.sql
+		selector.Select(selector.Columns(columns...)...)
+	}
+	if wmq.ctx.Unique != nil && *wmq.ctx.Unique {
+		selector.Distinct()
+	}
+	for _, p := range wmq.predicates {
+		p(selector)
+	}
+	for _, p := range wmq.order {
+		p(selector)
+	}
+	if offset := wmq.ctx.Offset; offset != nil {
+		// limit is mandatory for offset clause. We start
+		// with default value, and override it below if needed.
+		selector.Offset(*offset).Limit(math.MaxInt32)
+	}
+	if limit := wmq.ctx.Limit; limit != nil {
+		selector.Limit(*limit)
+	}
+	return selector
+}
+
+// WorkspaceMemberGroupBy is the group-by builder for WorkspaceMember entities.
+type WorkspaceMemberGroupBy struct {
+	selector
+	build *WorkspaceMemberQuery
+}
+
+// Aggregate adds the given aggregation functions to the group-by query.
+func (wmgb *WorkspaceMemberGroupBy) Aggregate(fns ...AggregateFunc) *WorkspaceMemberGroupBy {
+	wmgb.fns = append(wmgb.fns, fns...)
+	return wmgb
+}
+
+// Scan applies the selector query and scans the result into the given value.
+func (wmgb *WorkspaceMemberGroupBy) Scan(ctx context.Context, v any) error {
+	ctx = setContextOp(ctx, wmgb.build.ctx, ent.OpQueryGroupBy)
+	if err := wmgb.build.prepareQuery(ctx); err != nil {
+		return err
+	}
+	return scanWithInterceptors[*WorkspaceMemberQuery, *WorkspaceMemberGroupBy](ctx, wmgb.build, wmgb, wmgb.build.inters, v)
+}
+
+func (wmgb *WorkspaceMemberGroupBy) sqlScan(ctx context.Context, root *WorkspaceMemberQuery, v any) error {
+	selector := root.sqlQuery(ctx).Select()
+	aggregation := make([]string, 0, len(wmgb.fns))
+	for _, fn := range wmgb.fns {
+		aggregation = append(aggregation, fn(selector))
+	}
+	if len(selector.SelectedColumns()) == 0 {
+		columns := make([]string, 0, len(*wmgb.flds)+len(wmgb.fns))
+		for _, f := range *wmgb.flds {
+			columns = append(columns, selector.C(f))
+		}
+		columns = append(columns, aggregation...)
+		selector.Select(columns...)
+	}
+	selector.GroupBy(selector.Columns(*wmgb.flds...)...)
+	if err := selector.Err(); err != nil {
+		return err
+	}
+	rows := &sql.Rows{}
+	query, args := selector.Query()
+	if err := wmgb.build.driver.Query(ctx, query, args, rows); err != nil {
+		return err
+	}
+	defer rows.Close()
+	return sql.ScanSlice(rows, v)
+}
+
+// WorkspaceMemberSelect is the builder for selecting fields of WorkspaceMember entities.
+type WorkspaceMemberSelect struct {
+	*WorkspaceMemberQuery
+	selector
+}
+
+// Aggregate adds the given aggregation functions to the selector query.
+func (wms *WorkspaceMemberSelect) Aggregate(fns ...AggregateFunc) *WorkspaceMemberSelect {
+	wms.fns = append(wms.fns, fns...)
+	return wms
+}
+
+// Scan applies the selector query and scans the result into the given value.
+func (wms *WorkspaceMemberSelect) Scan(ctx context.Context, v any) error {
+	ctx = setContextOp(ctx, wms.ctx, ent.OpQuerySelect)
+	if err := wms.prepareQuery(ctx); err != nil {
+		return err
+	}
+	return scanWithInterceptors[*WorkspaceMemberQuery, *WorkspaceMemberSelect](ctx, wms.WorkspaceMemberQuery, wms, wms.inters, v)
+}
+
+func (wms *WorkspaceMemberSelect) sqlScan(ctx context.Context, root *WorkspaceMemberQuery, v any) error {
+	selector := root.sqlQuery(ctx)
+	aggregation := make([]string, 0, len(wms.fns))
+	for _, fn := range wms.fns {
+		aggregation = append(aggregation, fn(selector))
+	}
+	switch n := len(*wms.selector.flds); {
+	case n == 0 && len(aggregation) > 0:
+		selector.Select(aggregation...)
+	case n != 0 && len(aggregation) > 0:
+		selector.AppendSelect(aggregation...)
+	}
+	rows := &sql.Rows{}
+	query, args := selector.Query()
+	if err := wms.driver.Query(ctx, query, args, rows); err != nil {
+		return err
+	}
+	defer rows.Close()
+	return sql.ScanSlice(rows, v)
+}
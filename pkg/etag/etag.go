@@ -0,0 +1,63 @@
+package etag
+
+import (
+	"context"
+	"errors"
+	"strconv"
+	"strings"
+)
+
+// ErrPreconditionFailed é retornado quando a versão enviada no If-Match não é
+// mais a atual, ou quando o registro muda entre a leitura e a gravação
+var ErrPreconditionFailed = errors.New("o registro foi alterado por outra requisição")
+
+type contextKey struct{}
+
+// Format gera o ETag de uma versão do registro
+func Format(version int) string {
+	return strconv.Quote(strconv.Itoa(version))
+}
+
+// Match indica se algum dos ETags do cabeçalho If-Match corresponde à
+// versão. ETags fracos nunca correspondem, como pede a comparação forte.
+func Match(header string, version int) bool {
+	return matches(header, version, false)
+}
+
+// MatchWeak indica se algum dos ETags do cabeçalho If-None-Match corresponde
+// à versão, ignorando o prefixo W/
+func MatchWeak(header string, version int) bool {
+	return matches(header, version, true)
+}
+
+func matches(header string, version int, weak bool) bool {
+	tag := Format(version)
+	for _, candidate := range strings.Split(header, ",") {
+		candidate = strings.TrimSpace(candidate)
+		if candidate == "*" {
+			return true
+		}
+		if strings.HasPrefix(candidate, "W/") {
+			if !weak {
+				continue
+			}
+			candidate = strings.TrimPrefix(candidate, "W/")
+		}
+		if candidate == tag {
+			return true
+		}
+	}
+	return false
+}
+
+// WithExpectedVersion retorna um contexto em que as alterações só são
+// aplicadas se o registro ainda estiver na versão informada
+func WithExpectedVersion(ctx context.Context, version int) context.Context {
+	return context.WithValue(ctx, contextKey{}, version)
+}
+
+// ExpectedVersion retorna a versão esperada presente no contexto
+func ExpectedVersion(ctx context.Context) (int, bool) {
+	version, ok := ctx.Value(contextKey{}).(int)
+	return version, ok
+}
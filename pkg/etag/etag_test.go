@@ -0,0 +1,59 @@
+package etag
+
+import (
+	"context"
+	"testing"
+)
+
+func TestFormat(t *testing.T) {
+	if got := Format(3); got != `"3"` {
+		t.Errorf(`Format(3) = %s, esperado "3"`, got)
+	}
+}
+
+func TestMatch(t *testing.T) {
+	tests := []struct {
+		name   string
+		header string
+		strong bool
+		weak   bool
+	}{
+		{"vazio", ``, false, false},
+		{"mesma versão", `"3"`, true, true},
+		{"outra versão", `"4"`, false, false},
+		{"sem aspas", `3`, false, false},
+		{"aspas simples", `'3'`, false, false},
+		{"fraco", `W/"3"`, false, true},
+		{"fraco de outra versão", `W/"4"`, false, false},
+		{"w minúsculo não é fraco", `w/"3"`, false, false},
+		{"qualquer versão", `*`, true, true},
+		{"lista", `"1", "3"`, true, true},
+		{"lista sem espaços", `"1","3"`, true, true},
+		{"lista com fraco", `"1", W/"3"`, false, true},
+		{"lista com asterisco", `"1", *`, true, true},
+		{"espaços ao redor", `  "3"  `, true, true},
+		{"espaço dentro das aspas", `" 3"`, false, false},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := Match(tt.header, 3); got != tt.strong {
+				t.Errorf("Match(%q, 3) = %v, esperado %v", tt.header, got, tt.strong)
+			}
+			if got := MatchWeak(tt.header, 3); got != tt.weak {
+				t.Errorf("MatchWeak(%q, 3) = %v, esperado %v", tt.header, got, tt.weak)
+			}
+		})
+	}
+}
+
+func TestExpectedVersion(t *testing.T) {
+	if _, ok := ExpectedVersion(context.Background()); ok {
+		t.Errorf("ExpectedVersion() sem versão no contexto retornou ok")
+	}
+
+	ctx := WithExpectedVersion(context.Background(), 7)
+	if version, ok := ExpectedVersion(ctx); !ok || version != 7 {
+		t.Errorf("ExpectedVersion() = %d, %v; esperado 7, true", version, ok)
+	}
+}
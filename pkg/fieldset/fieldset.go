@@ -0,0 +1,126 @@
+package fieldset
+
+import (
+	"encoding/json"
+	"fmt"
+	"reflect"
+	"strings"
+
+	"github.com/gin-gonic/gin"
+)
+
+// Campo da resposta com os objetos relacionados pedidos em expand
+const ExpandedField = "expanded"
+
+// Fieldset guarda os campos pedidos em fields e os relacionamentos pedidos em
+// expand. Sem fields, todos os campos são devolvidos. Um Fieldset nil
+// equivale a não pedir nenhum dos dois.
+type Fieldset struct {
+	fields map[string]bool
+	expand map[string]bool
+}
+
+// Parse lê os parâmetros fields e expand, listas separadas por vírgula. Os
+// campos aceitos são os nomes JSON de response; os relacionamentos, os de
+// validExpand.
+func Parse(c *gin.Context, response any, validExpand map[string]bool) (*Fieldset, error) {
+	fs := &Fieldset{}
+
+	if raw := c.Query("fields"); raw != "" {
+		validFields := jsonFields(reflect.TypeOf(response))
+		fs.fields = make(map[string]bool)
+		for _, field := range strings.Split(raw, ",") {
+			field = strings.TrimSpace(field)
+			if !validFields[field] {
+				return nil, fmt.Errorf("%s: %s", "fields", "valor invalido")
+			}
+			fs.fields[field] = true
+		}
+	}
+
+	if raw := c.Query("expand"); raw != "" {
+		fs.expand = make(map[string]bool)
+		for _, edge := range strings.Split(raw, ",") {
+			edge = strings.TrimSpace(edge)
+			if !validExpand[edge] {
+				return nil, fmt.Errorf("%s: %s", "expand", "valor invalido")
+			}
+			fs.expand[edge] = true
+		}
+	}
+
+	return fs, nil
+}
+
+// jsonFields retorna os nomes JSON dos campos de uma struct
+func jsonFields(t reflect.Type) map[string]bool {
+	fields := make(map[string]bool)
+	for i := 0; i < t.NumField(); i++ {
+		name, _, _ := strings.Cut(t.Field(i).Tag.Get("json"), ",")
+		if name != "" && name != "-" && name != ExpandedField {
+			fields[name] = true
+		}
+	}
+	return fields
+}
+
+// All indica se todos os campos serão devolvidos, sem o parâmetro fields
+func (f *Fieldset) All() bool {
+	return f == nil || f.fields == nil
+}
+
+// Requires indica se algum dos campos será devolvido
+func (f *Fieldset) Requires(fields ...string) bool {
+	if f.All() {
+		return true
+	}
+	for _, field := range fields {
+		if f.fields[field] {
+			return true
+		}
+	}
+	return false
+}
+
+// Expands indica se o relacionamento foi pedido em expand
+func (f *Fieldset) Expands(edge string) bool {
+	return f != nil && f.expand[edge]
+}
+
+// Apply remove da resposta (um objeto ou uma lista de objetos) os campos que
+// não foram pedidos. O id e os objetos expandidos são sempre mantidos.
+func (f *Fieldset) Apply(response any) (any, error) {
+	if f.All() {
+		return response, nil
+	}
+
+	data, err := json.Marshal(response)
+	if err != nil {
+		return nil, err
+	}
+
+	var decoded any
+	if err := json.Unmarshal(data, &decoded); err != nil {
+		return nil, err
+	}
+
+	switch v := decoded.(type) {
+	case []any:
+		for _, item := range v {
+			if object, ok := item.(map[string]any); ok {
+				f.filter(object)
+			}
+		}
+	case map[string]any:
+		f.filter(v)
+	}
+	return decoded, nil
+}
+
+func (f *Fieldset) filter(object map[string]any) {
+	for key := range object {
+		if key != "id" && key != ExpandedField && !f.fields[key] {
+			delete(object, key)
+		}
+	}
+}
@@ -0,0 +1,173 @@
+package fieldset
+
+import (
+	"net/http/httptest"
+	"net/url"
+	"reflect"
+	"testing"
+
+	"github.com/gin-gonic/gin"
+)
+
+func init() {
+	gin.SetMode(gin.TestMode)
+}
+
+type testResponse struct {
+	ID       string         `json:"id"`
+	Title    string         `json:"title"`
+	Amount   float64        `json:"amount,omitempty"`
+	Internal string         `json:"-"`
+	Expanded map[string]any `json:"expanded,omitempty"`
+}
+
+var testExpand = map[string]bool{"invoice": true, "tags": true}
+
+func newContext(query url.Values) *gin.Context {
+	c, _ := gin.CreateTestContext(httptest.NewRecorder())
+	c.Request = httptest.NewRequest("GET", "/?"+query.Encode(), nil)
+	return c
+}
+
+func TestParse(t *testing.T) {
+	tests := []struct {
+		name    string
+		query   url.Values
+		all     bool
+		fields  []string
+		expands []string
+	}{
+		{
+			name: "sem parâmetros",
+			all:  true,
+		},
+		{
+			name:   "fields com espaços",
+			query:  url.Values{"fields": {"title, amount"}},
+			fields: []string{"title", "amount"},
+		},
+		{
+			name:    "expand sem fields",
+			query:   url.Values{"expand": {"invoice,tags"}},
+			all:     true,
+			expands: []string{"invoice", "tags"},
+		},
+		{
+			name:    "fields e expand",
+			query:   url.Values{"fields": {"id"}, "expand": {"tags"}},
+			fields:  []string{"id"},
+			expands: []string{"tags"},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			fs, err := Parse(newContext(tt.query), testResponse{}, testExpand)
+			if err != nil {
+				t.Fatalf("Parse() erro inesperado: %v", err)
+			}
+			if fs.All() != tt.all {
+				t.Errorf("All() = %v, esperado %v", fs.All(), tt.all)
+			}
+			for _, field := range tt.fields {
+				if !fs.Requires(field) {
+					t.Errorf("Requires(%q) = false", field)
+				}
+			}
+			if !tt.all && fs.Requires("internal", "expanded") {
+				t.Errorf("Requires() aceitou um campo não pedido")
+			}
+			for _, edge := range tt.expands {
+				if !fs.Expands(edge) {
+					t.Errorf("Expands(%q) = false", edge)
+				}
+			}
+		})
+	}
+}
+
+func TestParseInvalid(t *testing.T) {
+	tests := []struct {
+		name  string
+		query url.Values
+	}{
+		{"campo desconhecido", url.Values{"fields": {"title,owner"}}},
+		{"nome do campo Go", url.Values{"fields": {"Title"}}},
+		{"campo ignorado no JSON", url.Values{"fields": {"-"}}},
+		{"expanded como campo", url.Values{"fields": {"expanded"}}},
+		{"campo vazio", url.Values{"fields": {"title,"}}},
+		{"relacionamento desconhecido", url.Values{"expand": {"owner"}}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if _, err := Parse(newContext(tt.query), testResponse{}, testExpand); err == nil {
+				t.Errorf("Parse() esperado erro")
+			}
+		})
+	}
+}
+
+func TestNilFieldset(t *testing.T) {
+	var fs *Fieldset
+	if !fs.All() || !fs.Requires("title") || fs.Expands("tags") {
+		t.Errorf("Fieldset nil deveria devolver todos os campos e nenhum relacionamento")
+	}
+}
+
+func TestApply(t *testing.T) {
+	fs := &Fieldset{fields: map[string]bool{"title": true}}
+	item := testResponse{
+		ID:       "1",
+		Title:    "Mercado",
+		Amount:   10,
+		Expanded: map[string]any{"tags": []any{"casa"}},
+	}
+
+	tests := []struct {
+		name     string
+		response any
+		want     any
+	}{
+		{
+			name:     "objeto",
+			response: item,
+			want: map[string]any{
+				"id":       "1",
+				"title":    "Mercado",
+				"expanded": map[string]any{"tags": []any{"casa"}},
+			},
+		},
+		{
+			name:     "lista",
+			response: []testResponse{{ID: "1", Title: "A", Amount: 1}, {ID: "2", Title: "B"}},
+			want: []any{
+				map[string]any{"id": "1", "title": "A"},
+				map[string]any{"id": "2", "title": "B"},
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := fs.Apply(tt.response)
+			if err != nil {
+				t.Fatalf("Apply() erro inesperado: %v", err)
+			}
+			if !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("Apply() = %#v, esperado %#v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestApplyAll(t *testing.T) {
+	item := testResponse{ID: "1", Title: "Mercado"}
+	got, err := (&Fieldset{}).Apply(item)
+	if err != nil {
+		t.Fatalf("Apply() erro inesperado: %v", err)
+	}
+	if !reflect.DeepEqual(got, item) {
+		t.Errorf("Apply() sem fields deveria devolver a resposta sem alterações, recebido %#v", got)
+	}
+}
@@ -0,0 +1,230 @@
+package filter
+
+import (
+	"fmt"
+	"net/url"
+	"regexp"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"github.com/google/uuid"
+)
+
+// Type define como o valor de um campo é interpretado e quais operadores
+// ele aceita
+type Type int
+
+const (
+	String Type = iota
+	Number
+	Date
+	UUID
+	Bool
+)
+
+type Op string
+
+const (
+	OpEQ          Op = "eq"
+	OpNE          Op = "ne"
+	OpGT          Op = "gt"
+	OpGTE         Op = "gte"
+	OpLT          Op = "lt"
+	OpLTE         Op = "lte"
+	OpIn          Op = "in"
+	OpNotIn       Op = "nin"
+	OpContains    Op = "contains"
+	OpNotContains Op = "ncontains"
+	OpNull        Op = "null"
+)
+
+// operadores aceitos por cada tipo
+var typeOps = map[Type][]Op{
+	String: {OpEQ, OpNE, OpIn, OpNotIn, OpContains, OpNotContains, OpNull},
+	Number: {OpEQ, OpNE, OpGT, OpGTE, OpLT, OpLTE, OpIn, OpNotIn, OpNull},
+	Date:   {OpEQ, OpNE, OpGT, OpGTE, OpLT, OpLTE, OpNull},
+	UUID:   {OpEQ, OpNE, OpIn, OpNotIn, OpNull},
+	Bool:   {OpEQ, OpNE, OpNull},
+}
+
+// negações e os operadores que elas invertem
+var negated = map[Op]Op{
+	OpNE:          OpEQ,
+	OpNotIn:       OpIn,
+	OpNotContains: OpContains,
+}
+
+// Fields lista os campos que podem ser filtrados em um recurso e seus tipos
+type Fields map[string]Type
+
+// Condition é um filtro já validado. Value tem o tipo do campo (string,
+// float64, time.Time, uuid.UUID ou bool), é um []any nos operadores in e nin
+// e um bool no operador null.
+type Condition struct {
+	Field string
+	Op    Op
+	Value any
+}
+
+type Filters []Condition
+
+// Custom gera o predicado de um campo que não é coluna da tabela, como as
+// tags de um débito
+type Custom func(c Condition) func(*sql.Selector)
+
+var keyPattern = regexp.MustCompile(`^filter\[([a-z_]+)\](?:\[([a-z]+)\])?$`)
+
+// Parse lê os filtros no formato filter[campo][operador]=valor. Sem o
+// operador, usa eq. Nos operadores in e nin, os valores são separados por
+// vírgula ou repetidos.
+func Parse(query url.Values, fields Fields) (Filters, error) {
+	// Ordena as chaves para que a mesma URL gere sempre a mesma consulta
+	keys := make([]string, 0, len(query))
+	for key := range query {
+		if strings.HasPrefix(key, "filter[") {
+			keys = append(keys, key)
+		}
+	}
+	sort.Strings(keys)
+
+	var filters Filters
+	for _, key := range keys {
+		values := query[key]
+
+		match := keyPattern.FindStringSubmatch(key)
+		if match == nil {
+			return nil, fmt.Errorf("%s: %s", key, "filtro invalido")
+		}
+
+		field, op := match[1], Op(match[2])
+		if op == "" {
+			op = OpEQ
+		}
+
+		fieldType, ok := fields[field]
+		if !ok || !accepts(fieldType, op) {
+			return nil, fmt.Errorf("%s: %s", key, "filtro invalido")
+		}
+
+		value, err := parseValue(fieldType, op, values)
+		if err != nil {
+			return nil, fmt.Errorf("%s: %s", key, "valor invalido")
+		}
+		filters = append(filters, Condition{Field: field, Op: op, Value: value})
+	}
+	return filters, nil
+}
+
+func accepts(fieldType Type, op Op) bool {
+	for _, accepted := range typeOps[fieldType] {
+		if accepted == op {
+			return true
+		}
+	}
+	return false
+}
+
+func parseValue(fieldType Type, op Op, values []string) (any, error) {
+	switch op {
+	case OpNull:
+		if len(values) != 1 {
+			return nil, fmt.Errorf("esperado um valor")
+		}
+		return strconv.ParseBool(values[0])
+	case OpIn, OpNotIn:
+		var list []any
+		for _, value := range values {
+			for _, item := range strings.Split(value, ",") {
+				parsed, err := parseScalar(fieldType, strings.TrimSpace(item))
+				if err != nil {
+					return nil, err
+				}
+				list = append(list, parsed)
+			}
+		}
+		return list, nil
+	}
+
+	if len(values) != 1 {
+		return nil, fmt.Errorf("esperado um valor")
+	}
+	return parseScalar(fieldType, values[0])
+}
+
+func parseScalar(fieldType Type, value string) (any, error) {
+	switch fieldType {
+	case Number:
+		return strconv.ParseFloat(value, 64)
+	case Date:
+		if t, err := time.Parse("2006-01-02", value); err == nil {
+			return t, nil
+		}
+		return time.Parse(time.RFC3339, value)
+	case UUID:
+		return uuid.Parse(value)
+	case Bool:
+		return strconv.ParseBool(value)
+	}
+	return value, nil
+}
+
+// Positive retorna a condição sem a negação (ne, nin e ncontains viram eq, in
+// e contains) e se ela era negada
+func (c Condition) Positive() (Condition, bool) {
+	if op, ok := negated[c.Op]; ok {
+		return Condition{Field: c.Field, Op: op, Value: c.Value}, true
+	}
+	return c, false
+}
+
+// Predicate aplica a condição sobre a coluna column
+func (c Condition) Predicate(column string) func(*sql.Selector) {
+	return func(s *sql.Selector) {
+		col := s.C(column)
+		switch c.Op {
+		case OpEQ:
+			s.Where(sql.EQ(col, c.Value))
+		case OpNE:
+			s.Where(sql.NEQ(col, c.Value))
+		case OpGT:
+			s.Where(sql.GT(col, c.Value))
+		case OpGTE:
+			s.Where(sql.GTE(col, c.Value))
+		case OpLT:
+			s.Where(sql.LT(col, c.Value))
+		case OpLTE:
+			s.Where(sql.LTE(col, c.Value))
+		case OpIn:
+			s.Where(sql.In(col, c.Value.([]any)...))
+		case OpNotIn:
+			s.Where(sql.NotIn(col, c.Value.([]any)...))
+		case OpContains:
+			s.Where(sql.ContainsFold(col, c.Value.(string)))
+		case OpNotContains:
+			s.Where(sql.Not(sql.ContainsFold(col, c.Value.(string))))
+		case OpNull:
+			if c.Value.(bool) {
+				s.Where(sql.IsNull(col))
+			} else {
+				s.Where(sql.NotNull(col))
+			}
+		}
+	}
+}
+
+// Predicate combina todas as condições. Os campos em custom usam o próprio
+// predicado; os demais são tratados como colunas da tabela.
+func (f Filters) Predicate(custom map[string]Custom) func(*sql.Selector) {
+	return func(s *sql.Selector) {
+		for _, c := range f {
+			if predicate, ok := custom[c.Field]; ok {
+				predicate(c)(s)
+				continue
+			}
+			c.Predicate(c.Field)(s)
+		}
+	}
+}
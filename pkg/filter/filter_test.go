@@ -0,0 +1,178 @@
+package filter
+
+import (
+	"net/url"
+	"reflect"
+	"testing"
+	"time"
+
+	"entgo.io/ent/dialect"
+	"entgo.io/ent/dialect/sql"
+	"github.com/google/uuid"
+)
+
+var testFields = Fields{
+	"title":      String,
+	"amount":     Number,
+	"due_date":   Date,
+	"status_id":  UUID,
+	"is_overdue": Bool,
+}
+
+func TestParse(t *testing.T) {
+	id := uuid.MustParse("7f1c0a56-3c3e-4b9a-9a55-1f0b8f3f2a10")
+	other := uuid.MustParse("0b3d4a2e-8d6f-4c57-8d8e-5b2f8f7d1c20")
+
+	tests := []struct {
+		name  string
+		query url.Values
+		want  Filters
+	}{
+		{
+			name:  "sem operador usa eq",
+			query: url.Values{"filter[title]": {"mercado"}},
+			want:  Filters{{Field: "title", Op: OpEQ, Value: "mercado"}},
+		},
+		{
+			name:  "número",
+			query: url.Values{"filter[amount][gte]": {"10.5"}},
+			want:  Filters{{Field: "amount", Op: OpGTE, Value: 10.5}},
+		},
+		{
+			name:  "data sem hora",
+			query: url.Values{"filter[due_date][lt]": {"2026-10-19"}},
+			want:  Filters{{Field: "due_date", Op: OpLT, Value: time.Date(2026, 10, 19, 0, 0, 0, 0, time.UTC)}},
+		},
+		{
+			name:  "data em RFC 3339",
+			query: url.Values{"filter[due_date][gt]": {"2026-10-19T12:30:00Z"}},
+			want:  Filters{{Field: "due_date", Op: OpGT, Value: time.Date(2026, 10, 19, 12, 30, 0, 0, time.UTC)}},
+		},
+		{
+			name:  "in separado por vírgula",
+			query: url.Values{"filter[amount][in]": {"1, 2,3"}},
+			want:  Filters{{Field: "amount", Op: OpIn, Value: []any{1.0, 2.0, 3.0}}},
+		},
+		{
+			name:  "nin com valores repetidos",
+			query: url.Values{"filter[status_id][nin]": {id.String(), other.String()}},
+			want:  Filters{{Field: "status_id", Op: OpNotIn, Value: []any{id, other}}},
+		},
+		{
+			name:  "null",
+			query: url.Values{"filter[status_id][null]": {"true"}},
+			want:  Filters{{Field: "status_id", Op: OpNull, Value: true}},
+		},
+		{
+			name: "ordenado pela chave e ignorando outros parâmetros",
+			query: url.Values{
+				"filter[title][contains]": {"luz"},
+				"filter[amount][lte]":     {"100"},
+				"page":                    {"2"},
+			},
+			want: Filters{
+				{Field: "amount", Op: OpLTE, Value: 100.0},
+				{Field: "title", Op: OpContains, Value: "luz"},
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := Parse(tt.query, testFields)
+			if err != nil {
+				t.Fatalf("Parse() erro inesperado: %v", err)
+			}
+			if !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("Parse() = %#v, esperado %#v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestParseInvalid(t *testing.T) {
+	tests := []struct {
+		name  string
+		query url.Values
+	}{
+		{"chave malformada", url.Values{"filter[title": {"x"}}},
+		{"campo em maiúsculas", url.Values{"filter[Title]": {"x"}}},
+		{"campo desconhecido", url.Values{"filter[owner]": {"x"}}},
+		{"operador desconhecido", url.Values{"filter[title][like]": {"x"}}},
+		{"operador não aceito pelo tipo", url.Values{"filter[title][gt]": {"x"}}},
+		{"contains em número", url.Values{"filter[amount][contains]": {"1"}}},
+		{"in em data", url.Values{"filter[due_date][in]": {"2026-10-19"}}},
+		{"número inválido", url.Values{"filter[amount]": {"dez"}}},
+		{"item inválido no in", url.Values{"filter[amount][in]": {"1,dois"}}},
+		{"data inválida", url.Values{"filter[due_date]": {"19/10/2026"}}},
+		{"uuid inválido", url.Values{"filter[status_id]": {"123"}}},
+		{"bool inválido", url.Values{"filter[is_overdue]": {"talvez"}}},
+		{"null sem bool", url.Values{"filter[title][null]": {"sim"}}},
+		{"valor repetido fora do in", url.Values{"filter[title]": {"a", "b"}}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got, err := Parse(tt.query, testFields); err == nil {
+				t.Errorf("Parse() = %#v, esperado erro", got)
+			}
+		})
+	}
+}
+
+func TestConditionPositive(t *testing.T) {
+	tests := []struct {
+		op      Op
+		want    Op
+		negated bool
+	}{
+		{OpNE, OpEQ, true},
+		{OpNotIn, OpIn, true},
+		{OpNotContains, OpContains, true},
+		{OpEQ, OpEQ, false},
+		{OpGT, OpGT, false},
+		{OpNull, OpNull, false},
+	}
+
+	for _, tt := range tests {
+		t.Run(string(tt.op), func(t *testing.T) {
+			c := Condition{Field: "title", Op: tt.op, Value: "x"}
+			got, negated := c.Positive()
+			if got.Op != tt.want || negated != tt.negated {
+				t.Errorf("Positive() = %s, %v; esperado %s, %v", got.Op, negated, tt.want, tt.negated)
+			}
+			if got.Field != c.Field || got.Value != c.Value {
+				t.Errorf("Positive() alterou campo ou valor: %#v", got)
+			}
+		})
+	}
+}
+
+func TestFiltersPredicate(t *testing.T) {
+	filters := Filters{
+		{Field: "amount", Op: OpIn, Value: []any{1.0, 2.0}},
+		{Field: "title", Op: OpNotContains, Value: "luz"},
+		{Field: "status_id", Op: OpNull, Value: false},
+		{Field: "tags", Op: OpEQ, Value: "casa"},
+	}
+	custom := map[string]Custom{
+		"tags": func(c Condition) func(*sql.Selector) {
+			return func(s *sql.Selector) {
+				s.Where(sql.EQ(s.C("tag_name"), c.Value))
+			}
+		},
+	}
+
+	s := sql.Dialect(dialect.Postgres).Select("*").From(sql.Table("debts"))
+	filters.Predicate(custom)(s)
+	query, args := s.Query()
+
+	wantQuery := `SELECT * FROM "debts" WHERE (("debts"."amount" IN ($1, $2) AND (NOT ("debts"."title" ILIKE $3))) AND "debts"."status_id" IS NOT NULL) AND "debts"."tag_name" = $4`
+	if query != wantQuery {
+		t.Errorf("query = %s\nesperado %s", query, wantQuery)
+	}
+	wantArgs := []any{1.0, 2.0, "%luz%", "casa"}
+	if !reflect.DeepEqual(args, wantArgs) {
+		t.Errorf("args = %#v, esperado %#v", args, wantArgs)
+	}
+}
@@ -0,0 +1,202 @@
+package hooks
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"reflect"
+
+	"backend-go/pkg/auth"
+	"backend-go/pkg/ent"
+	"backend-go/pkg/ent/auditentry"
+	"backend-go/pkg/requestid"
+
+	"github.com/google/uuid"
+)
+
+// Campos que mudam em toda alteração e não entram no histórico
+var auditIgnoredFields = map[string]bool{
+	"edges":      true,
+	"updated_at": true,
+	"version":    true,
+}
+
+// auditMutation é implementada pelas mutations das entidades com ID UUID
+type auditMutation interface {
+	ent.Mutation
+	ID() (uuid.UUID, bool)
+	IDs(ctx context.Context) ([]uuid.UUID, error)
+	Client() *ent.Client
+}
+
+// AuditHook grava em audit_entries quem alterou a entidade, em qual requisição
+// e os valores antes e depois. A entrada é salva na mesma transação da
+// alteração. Deve ser registrado depois do SoftDeleteHook, para que a ida e a
+// volta da lixeira sejam registradas como delete e restore.
+func AuditHook() ent.Hook {
+	return func(next ent.Mutator) ent.Mutator {
+		return ent.MutateFunc(func(ctx context.Context, m ent.Mutation) (ent.Value, error) {
+			mx, ok := m.(auditMutation)
+			if !ok {
+				return nil, fmt.Errorf("unexpected mutation type: %T", m)
+			}
+
+			// Registros na lixeira também precisam ser lidos no antes e depois
+			readCtx := IncludeDeleted(ctx)
+
+			var ids []uuid.UUID
+			before := map[uuid.UUID]map[string]any{}
+			if !m.Op().Is(ent.OpCreate) {
+				var err error
+				if ids, err = mx.IDs(readCtx); err != nil {
+					return nil, err
+				}
+				for _, id := range ids {
+					if before[id], err = auditSnapshot(readCtx, mx, id); err != nil {
+						return nil, err
+					}
+				}
+			}
+
+			value, err := next.Mutate(ctx, m)
+			if err != nil {
+				return nil, err
+			}
+
+			if m.Op().Is(ent.OpCreate) {
+				id, ok := mx.ID()
+				if !ok {
+					return value, nil
+				}
+				ids = []uuid.UUID{id}
+			}
+
+			entries := make([]*ent.AuditEntryCreate, 0, len(ids))
+			for _, id := range ids {
+				var after map[string]any
+				if !m.Op().Is(ent.OpDelete | ent.OpDeleteOne) {
+					if after, err = auditSnapshot(readCtx, mx, id); err != nil {
+						return nil, err
+					}
+				}
+
+				oldValues, newValues := auditDiff(before[id], after)
+				if m.Op().Is(ent.OpUpdate|ent.OpUpdateOne) && len(newValues) == 0 {
+					continue
+				}
+
+				entries = append(entries, newAuditEntry(ctx, mx, id, auditAction(m), oldValues, newValues))
+			}
+
+			if len(entries) > 0 {
+				if err := mx.Client().AuditEntry.CreateBulk(entries...).Exec(ctx); err != nil {
+					return nil, fmt.Errorf("failed to save audit entry: %w", err)
+				}
+			}
+			return value, nil
+		})
+	}
+}
+
+// auditAction traduz a operação do ent. A remoção de entidades com lixeira
+// chega aqui como atualização do deleted_at.
+func auditAction(m ent.Mutation) auditentry.Action {
+	switch {
+	case m.Op().Is(ent.OpCreate):
+		return auditentry.ActionCreate
+	case m.Op().Is(ent.OpDelete | ent.OpDeleteOne):
+		return auditentry.ActionDelete
+	}
+
+	if _, ok := m.Field(deletedAtField); ok {
+		return auditentry.ActionDelete
+	}
+	if m.FieldCleared(deletedAtField) {
+		return auditentry.ActionRestore
+	}
+	return auditentry.ActionUpdate
+}
+
+func newAuditEntry(ctx context.Context, m auditMutation, id uuid.UUID, action auditentry.Action, oldValues, newValues map[string]any) *ent.AuditEntryCreate {
+	create := m.Client().AuditEntry.Create().
+		SetEntityType(m.Type()).
+		SetEntityID(id).
+		SetAction(action).
+		SetViaAPIKey(auth.IsAPIKey(ctx)).
+		SetOldValues(oldValues).
+		SetNewValues(newValues)
+
+	if userID, ok := auth.UserIDFromContext(ctx); ok {
+		create = create.SetActorID(userID)
+	}
+	if workspace, ok := auth.WorkspaceFromContext(ctx); ok {
+		create = create.SetWorkspaceID(workspace.ID)
+	}
+	if reqID, ok := requestid.FromContext(ctx); ok {
+		create = create.SetRequestID(reqID)
+	}
+	return create
+}
+
+// auditSnapshot lê a entidade e a converte para um mapa com os campos no
+// mesmo formato da serialização JSON do ent
+func auditSnapshot(ctx context.Context, m ent.Mutation, id uuid.UUID) (map[string]any, error) {
+	var row any
+	var err error
+
+	client := m.(auditMutation).Client()
+	switch m.(type) {
+	case *ent.DebtMutation:
+		row, err = client.Debt.Get(ctx, id)
+	case *ent.InvoiceMutation:
+		row, err = client.Invoice.Get(ctx, id)
+	case *ent.CategoryMutation:
+		row, err = client.Category.Get(ctx, id)
+	case *ent.PaymentStatusMutation:
+		row, err = client.PaymentStatus.Get(ctx, id)
+	default:
+		return nil, fmt.Errorf("unexpected mutation type: %T", m)
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	data, err := json.Marshal(row)
+	if err != nil {
+		return nil, err
+	}
+
+	values := map[string]any{}
+	if err := json.Unmarshal(data, &values); err != nil {
+		return nil, err
+	}
+	for field := range auditIgnoredFields {
+		delete(values, field)
+	}
+	return values, nil
+}
+
+// auditDiff mantém apenas os campos alterados. Na criação e na remoção, um
+// dos lados é nil e o outro é retornado completo. Campos ausentes no JSON do
+// ent (omitempty) são tratados como null.
+func auditDiff(before, after map[string]any) (map[string]any, map[string]any) {
+	if before == nil || after == nil {
+		return before, after
+	}
+
+	oldValues := map[string]any{}
+	newValues := map[string]any{}
+	for field, value := range after {
+		if !reflect.DeepEqual(before[field], value) {
+			oldValues[field] = before[field]
+			newValues[field] = value
+		}
+	}
+	for field, value := range before {
+		if _, ok := after[field]; !ok {
+			oldValues[field] = value
+			newValues[field] = nil
+		}
+	}
+	return oldValues, newValues
+}
@@ -0,0 +1,90 @@
+package hooks
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"backend-go/pkg/ent"
+	"backend-go/pkg/ent/category"
+	"backend-go/pkg/ent/debt"
+	"backend-go/pkg/ent/invoice"
+	"backend-go/pkg/ent/person"
+
+	"entgo.io/ent/dialect/sql"
+)
+
+// Campo adicionado pelo SoftDeleteMixin
+const deletedAtField = "deleted_at"
+
+type includeDeletedKey struct{}
+
+// IncludeDeleted retorna um contexto em que as consultas enxergam os registros
+// removidos e as remoções apagam de fato as linhas. Usado pela lixeira e pelo purge.
+func IncludeDeleted(ctx context.Context) context.Context {
+	return context.WithValue(ctx, includeDeletedKey{}, true)
+}
+
+func includesDeleted(ctx context.Context) bool {
+	skip, _ := ctx.Value(includeDeletedKey{}).(bool)
+	return skip
+}
+
+// softDeleteMutation é implementada pelas mutations das entidades com SoftDeleteMixin
+type softDeleteMutation interface {
+	ent.Mutation
+	SetOp(ent.Op)
+	SetDeletedAt(time.Time)
+	WhereP(...func(*sql.Selector))
+	Client() *ent.Client
+}
+
+// SoftDeleteHook transforma a remoção em atualização do deleted_at e impede
+// que registros já removidos sejam alterados
+func SoftDeleteHook() ent.Hook {
+	return func(next ent.Mutator) ent.Mutator {
+		return ent.MutateFunc(func(ctx context.Context, m ent.Mutation) (ent.Value, error) {
+			if includesDeleted(ctx) || m.Op().Is(ent.OpCreate) {
+				return next.Mutate(ctx, m)
+			}
+
+			mx, ok := m.(softDeleteMutation)
+			if !ok {
+				return nil, fmt.Errorf("unexpected mutation type: %T", m)
+			}
+
+			mx.WhereP(sql.FieldIsNull(deletedAtField))
+			if !m.Op().Is(ent.OpDelete | ent.OpDeleteOne) {
+				return next.Mutate(ctx, m)
+			}
+
+			mx.SetOp(ent.OpUpdate)
+			mx.SetDeletedAt(time.Now())
+			return mx.Client().Mutate(ctx, mx)
+		})
+	}
+}
+
+// SoftDeleteInterceptor esconde os registros removidos das consultas,
+// inclusive no carregamento de edges
+func SoftDeleteInterceptor() ent.Interceptor {
+	return ent.TraverseFunc(func(ctx context.Context, q ent.Query) error {
+		if includesDeleted(ctx) {
+			return nil
+		}
+
+		switch q := q.(type) {
+		case *ent.DebtQuery:
+			q.Where(debt.DeletedAtIsNil())
+		case *ent.InvoiceQuery:
+			q.Where(invoice.DeletedAtIsNil())
+		case *ent.CategoryQuery:
+			q.Where(category.DeletedAtIsNil())
+		case *ent.PersonQuery:
+			q.Where(person.DeletedAtIsNil())
+		default:
+			return fmt.Errorf("unexpected query type: %T", q)
+		}
+		return nil
+	})
+}
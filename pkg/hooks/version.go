@@ -0,0 +1,62 @@
+package hooks
+
+import (
+	"context"
+	"fmt"
+
+	"backend-go/pkg/ent"
+	"backend-go/pkg/etag"
+
+	"entgo.io/ent/dialect/sql"
+)
+
+// Campo adicionado pelo VersionMixin
+const versionField = "version"
+
+// versionMutation é implementada pelas mutations das entidades com VersionMixin
+type versionMutation interface {
+	ent.Mutation
+	AddVersion(int)
+	WhereP(...func(*sql.Selector))
+}
+
+// VersionHook incrementa a versão a cada alteração. Quando o contexto traz a
+// versão esperada (etag.WithExpectedVersion), a alteração e a remoção só são
+// aplicadas se o registro ainda estiver nessa versão; caso contrário, retorna
+// etag.ErrPreconditionFailed.
+func VersionHook() ent.Hook {
+	return func(next ent.Mutator) ent.Mutator {
+		return ent.MutateFunc(func(ctx context.Context, m ent.Mutation) (ent.Value, error) {
+			if m.Op().Is(ent.OpCreate) {
+				return next.Mutate(ctx, m)
+			}
+
+			mx, ok := m.(versionMutation)
+			if !ok {
+				return nil, fmt.Errorf("unexpected mutation type: %T", m)
+			}
+
+			if m.Op().Is(ent.OpUpdate | ent.OpUpdateOne) {
+				mx.AddVersion(1)
+			}
+
+			expected, ok := etag.ExpectedVersion(ctx)
+			if !ok {
+				return next.Mutate(ctx, m)
+			}
+
+			mx.WhereP(sql.FieldEQ(versionField, expected))
+			value, err := next.Mutate(ctx, m)
+			if ent.IsNotFound(err) {
+				return nil, etag.ErrPreconditionFailed
+			}
+			if err != nil {
+				return nil, err
+			}
+			if affected, ok := value.(int); ok && affected == 0 {
+				return nil, etag.ErrPreconditionFailed
+			}
+			return value, nil
+		})
+	}
+}
@@ -0,0 +1,87 @@
+package logger
+
+import (
+	"backend-go/pkg/requestid"
+	"context"
+	"fmt"
+	"io"
+	"log/slog"
+	"os"
+	"strings"
+
+	"go.opentelemetry.io/otel/trace"
+)
+
+// Formatos aceitos pelo Setup
+const (
+	FormatJSON = "json"
+	FormatText = "text"
+)
+
+// Setup troca o logger padrão do slog e do pacote log por um que escreve em
+// stderr no nível e formato pedidos. Mensagens do pacote log, como as do
+// log.Fatalf, saem com nível ERROR.
+func Setup(level, format string) error {
+	logger, err := New(os.Stderr, level, format)
+	if err != nil {
+		return err
+	}
+
+	slog.SetDefault(logger)
+	slog.SetLogLoggerLevel(slog.LevelError)
+	return nil
+}
+
+// New cria um logger que inclui o request_id e o trace presentes no contexto
+// de cada mensagem (slog.InfoContext, slog.ErrorContext...)
+func New(w io.Writer, level, format string) (*slog.Logger, error) {
+	lvl, err := ParseLevel(level)
+	if err != nil {
+		return nil, err
+	}
+
+	opts := &slog.HandlerOptions{Level: lvl}
+
+	var handler slog.Handler
+	switch strings.ToLower(format) {
+	case FormatJSON:
+		handler = slog.NewJSONHandler(w, opts)
+	case FormatText:
+		handler = slog.NewTextHandler(w, opts)
+	default:
+		return nil, fmt.Errorf("formato de log desconhecido %q (use json ou text)", format)
+	}
+	return slog.New(contextHandler{handler}), nil
+}
+
+// ParseLevel aceita debug, info, warn e error
+func ParseLevel(level string) (slog.Level, error) {
+	var lvl slog.Level
+	if err := lvl.UnmarshalText([]byte(level)); err != nil {
+		return 0, fmt.Errorf("nível de log desconhecido %q (use debug, info, warn ou error)", level)
+	}
+	return lvl, nil
+}
+
+// contextHandler adiciona os atributos guardados no contexto
+type contextHandler struct {
+	slog.Handler
+}
+
+func (h contextHandler) Handle(ctx context.Context, r slog.Record) error {
+	if id, ok := requestid.FromContext(ctx); ok {
+		r.AddAttrs(slog.String("request_id", id))
+	}
+	if sc := trace.SpanContextFromContext(ctx); sc.IsValid() {
+		r.AddAttrs(slog.String("trace_id", sc.TraceID().String()), slog.String("span_id", sc.SpanID().String()))
+	}
+	return h.Handler.Handle(ctx, r)
+}
+
+func (h contextHandler) WithAttrs(attrs []slog.Attr) slog.Handler {
+	return contextHandler{h.Handler.WithAttrs(attrs)}
+}
+
+func (h contextHandler) WithGroup(name string) slog.Handler {
+	return contextHandler{h.Handler.WithGroup(name)}
+}
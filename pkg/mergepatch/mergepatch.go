@@ -0,0 +1,65 @@
+package mergepatch
+
+import (
+	"encoding/json"
+	"errors"
+)
+
+// ContentType é o tipo de mídia do JSON Merge Patch (RFC 7386)
+const ContentType = "application/merge-patch+json"
+
+// ErrInvalidPatch é retornado quando o corpo não é um objeto JSON
+var ErrInvalidPatch = errors.New("o patch deve ser um objeto JSON")
+
+// Apply aplica o patch sobre a representação JSON de current e retorna o
+// resultado no mesmo tipo. Campos omitidos mantêm o valor atual, campos com
+// null são removidos e objetos são mesclados recursivamente.
+func Apply[T any](current T, patch []byte) (T, error) {
+	var result T
+
+	var changes map[string]any
+	if err := json.Unmarshal(patch, &changes); err != nil || changes == nil {
+		return result, ErrInvalidPatch
+	}
+
+	data, err := json.Marshal(current)
+	if err != nil {
+		return result, err
+	}
+
+	var target map[string]any
+	if err := json.Unmarshal(data, &target); err != nil {
+		return result, err
+	}
+
+	merged, err := json.Marshal(merge(target, changes))
+	if err != nil {
+		return result, err
+	}
+
+	if err := json.Unmarshal(merged, &result); err != nil {
+		return result, err
+	}
+	return result, nil
+}
+
+func merge(target, patch map[string]any) map[string]any {
+	if target == nil {
+		target = map[string]any{}
+	}
+
+	for key, value := range patch {
+		if value == nil {
+			delete(target, key)
+			continue
+		}
+
+		if nested, ok := value.(map[string]any); ok {
+			current, _ := target[key].(map[string]any)
+			target[key] = merge(current, nested)
+			continue
+		}
+		target[key] = value
+	}
+	return target
+}
@@ -0,0 +1,136 @@
+package mergepatch
+
+import (
+	"errors"
+	"reflect"
+	"testing"
+)
+
+type testAddress struct {
+	City  string `json:"city,omitempty"`
+	State string `json:"state,omitempty"`
+}
+
+type testResource struct {
+	Title    string            `json:"title"`
+	Note     *string           `json:"note"`
+	Tags     []string          `json:"tags"`
+	Address  testAddress       `json:"address"`
+	Metadata map[string]string `json:"metadata,omitempty"`
+}
+
+func ptr(s string) *string {
+	return &s
+}
+
+func TestApply(t *testing.T) {
+	current := testResource{
+		Title:    "Mercado",
+		Note:     ptr("semanal"),
+		Tags:     []string{"casa", "comida"},
+		Address:  testAddress{City: "Recife", State: "PE"},
+		Metadata: map[string]string{"origem": "csv", "lote": "1"},
+	}
+
+	tests := []struct {
+		name  string
+		patch string
+		want  testResource
+	}{
+		{
+			name:  "patch vazio mantém tudo",
+			patch: `{}`,
+			want:  current,
+		},
+		{
+			name:  "campo omitido é mantido",
+			patch: `{"title":"Feira"}`,
+			want: testResource{
+				Title:    "Feira",
+				Note:     ptr("semanal"),
+				Tags:     []string{"casa", "comida"},
+				Address:  testAddress{City: "Recife", State: "PE"},
+				Metadata: map[string]string{"origem": "csv", "lote": "1"},
+			},
+		},
+		{
+			name:  "null limpa ponteiro e lista",
+			patch: `{"note":null,"tags":null}`,
+			want: testResource{
+				Title:    "Mercado",
+				Address:  testAddress{City: "Recife", State: "PE"},
+				Metadata: map[string]string{"origem": "csv", "lote": "1"},
+			},
+		},
+		{
+			name:  "null em campo obrigatório zera o valor",
+			patch: `{"title":null}`,
+			want: testResource{
+				Note:     ptr("semanal"),
+				Tags:     []string{"casa", "comida"},
+				Address:  testAddress{City: "Recife", State: "PE"},
+				Metadata: map[string]string{"origem": "csv", "lote": "1"},
+			},
+		},
+		{
+			name:  "lista é substituída, não mesclada",
+			patch: `{"tags":["viagem"]}`,
+			want: testResource{
+				Title:    "Mercado",
+				Note:     ptr("semanal"),
+				Tags:     []string{"viagem"},
+				Address:  testAddress{City: "Recife", State: "PE"},
+				Metadata: map[string]string{"origem": "csv", "lote": "1"},
+			},
+		},
+		{
+			name:  "objeto é mesclado recursivamente",
+			patch: `{"address":{"city":"Olinda"},"metadata":{"lote":null,"revisado":"sim"}}`,
+			want: testResource{
+				Title:    "Mercado",
+				Note:     ptr("semanal"),
+				Tags:     []string{"casa", "comida"},
+				Address:  testAddress{City: "Olinda", State: "PE"},
+				Metadata: map[string]string{"origem": "csv", "revisado": "sim"},
+			},
+		},
+		{
+			name:  "campo desconhecido é ignorado",
+			patch: `{"owner":"ana"}`,
+			want:  current,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := Apply(current, []byte(tt.patch))
+			if err != nil {
+				t.Fatalf("Apply() erro inesperado: %v", err)
+			}
+			if !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("Apply() = %#v, esperado %#v", got, tt.want)
+			}
+		})
+	}
+
+	if current.Title != "Mercado" || current.Metadata["lote"] != "1" {
+		t.Errorf("Apply() alterou o valor atual: %#v", current)
+	}
+}
+
+func TestApplyInvalidPatch(t *testing.T) {
+	for _, patch := range []string{``, `null`, `[]`, `"texto"`, `1`, `{"title":`} {
+		t.Run(patch, func(t *testing.T) {
+			_, err := Apply(testResource{}, []byte(patch))
+			if !errors.Is(err, ErrInvalidPatch) {
+				t.Errorf("Apply(%q) erro = %v, esperado %v", patch, err, ErrInvalidPatch)
+			}
+		})
+	}
+}
+
+func TestApplyTypeMismatch(t *testing.T) {
+	if _, err := Apply(testResource{}, []byte(`{"tags":"casa"}`)); err == nil {
+		t.Errorf("Apply() esperado erro para valor com tipo diferente do campo")
+	}
+}
@@ -0,0 +1,89 @@
+package metrics
+
+import (
+	"context"
+	stdsql "database/sql"
+	"time"
+
+	"entgo.io/ent/dialect"
+)
+
+// Driver mede a duração das operações de um driver do ent
+type Driver struct {
+	dialect.Driver
+}
+
+func NewDriver(drv dialect.Driver) *Driver {
+	return &Driver{Driver: drv}
+}
+
+func (d *Driver) Exec(ctx context.Context, query string, args, v any) error {
+	return observe("exec", func() error {
+		return d.Driver.Exec(ctx, query, args, v)
+	})
+}
+
+func (d *Driver) Query(ctx context.Context, query string, args, v any) error {
+	return observe("query", func() error {
+		return d.Driver.Query(ctx, query, args, v)
+	})
+}
+
+func (d *Driver) Tx(ctx context.Context) (dialect.Tx, error) {
+	tx, err := d.Driver.Tx(ctx)
+	if err != nil {
+		return nil, err
+	}
+	return &Tx{Tx: tx}, nil
+}
+
+// BeginTx é usado pelo ent quando o cliente pede opções de transação
+func (d *Driver) BeginTx(ctx context.Context, opts *stdsql.TxOptions) (dialect.Tx, error) {
+	drv, ok := d.Driver.(interface {
+		BeginTx(context.Context, *stdsql.TxOptions) (dialect.Tx, error)
+	})
+	if !ok {
+		return d.Tx(ctx)
+	}
+
+	tx, err := drv.BeginTx(ctx, opts)
+	if err != nil {
+		return nil, err
+	}
+	return &Tx{Tx: tx}, nil
+}
+
+// Tx mede a duração das operações de uma transação
+type Tx struct {
+	dialect.Tx
+}
+
+func (t *Tx) Exec(ctx context.Context, query string, args, v any) error {
+	return observe("exec", func() error {
+		return t.Tx.Exec(ctx, query, args, v)
+	})
+}
+
+func (t *Tx) Query(ctx context.Context, query string, args, v any) error {
+	return observe("query", func() error {
+		return t.Tx.Query(ctx, query, args, v)
+	})
+}
+
+func (t *Tx) Commit() error {
+	return observe("commit", t.Tx.Commit)
+}
+
+func (t *Tx) Rollback() error {
+	return observe("rollback", t.Tx.Rollback)
+}
+
+func observe(operation string, fn func() error) error {
+	start := time.Now()
+	err := fn()
+	DBQueryDuration.WithLabelValues(operation).Observe(time.Since(start).Seconds())
+	if err != nil {
+		DBQueryErrors.WithLabelValues(operation).Inc()
+	}
+	return err
+}
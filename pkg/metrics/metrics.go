@@ -0,0 +1,126 @@
+package metrics
+
+import (
+	"context"
+	stdsql "database/sql"
+	"log/slog"
+	"math"
+	"net/http"
+	"time"
+
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/prometheus/client_golang/prometheus/collectors"
+	"github.com/prometheus/client_golang/prometheus/promhttp"
+)
+
+// Prefixo de todas as métricas da aplicação
+const namespace = "backend"
+
+// Tempo máximo das consultas feitas durante a coleta
+const collectTimeout = 5 * time.Second
+
+// Registry guarda as métricas da aplicação, além das do runtime do Go e do
+// processo
+var Registry = prometheus.NewRegistry()
+
+var (
+	HTTPRequests = prometheus.NewCounterVec(prometheus.CounterOpts{
+		Namespace: namespace,
+		Name:      "http_requests_total",
+		Help:      "Requisições HTTP atendidas, por método, rota e status.",
+	}, []string{"method", "route", "status"})
+
+	HTTPDuration = prometheus.NewHistogramVec(prometheus.HistogramOpts{
+		Namespace: namespace,
+		Name:      "http_request_duration_seconds",
+		Help:      "Duração das requisições HTTP, por método, rota e status.",
+		Buckets:   prometheus.DefBuckets,
+	}, []string{"method", "route", "status"})
+
+	DBQueryDuration = prometheus.NewHistogramVec(prometheus.HistogramOpts{
+		Namespace: namespace,
+		Name:      "db_query_duration_seconds",
+		Help:      "Duração das operações no banco feitas pelo ent, por operação (query, exec, commit ou rollback).",
+		Buckets:   prometheus.ExponentialBuckets(0.0005, 2, 14),
+	}, []string{"operation"})
+
+	DBQueryErrors = prometheus.NewCounterVec(prometheus.CounterOpts{
+		Namespace: namespace,
+		Name:      "db_query_errors_total",
+		Help:      "Operações no banco feitas pelo ent que retornaram erro, por operação.",
+	}, []string{"operation"})
+
+	QueueMessages = prometheus.NewCounterVec(prometheus.CounterOpts{
+		Namespace: namespace,
+		Name:      "queue_messages_total",
+		Help:      "Mensagens publicadas (published) e consumidas (consumed), por fila e resultado.",
+	}, []string{"queue", "direction", "result"})
+
+	QueueLag = prometheus.NewHistogramVec(prometheus.HistogramOpts{
+		Namespace: namespace,
+		Name:      "queue_consume_lag_seconds",
+		Help:      "Tempo entre a publicação e a confirmação de cada mensagem consumida.",
+		Buckets:   []float64{0.1, 0.5, 1, 5, 15, 60, 300, 900, 3600},
+	}, []string{"queue"})
+)
+
+func init() {
+	Registry.MustRegister(
+		collectors.NewGoCollector(),
+		collectors.NewProcessCollector(collectors.ProcessCollectorOpts{}),
+		HTTPRequests,
+		HTTPDuration,
+		DBQueryDuration,
+		DBQueryErrors,
+		QueueMessages,
+		QueueLag,
+	)
+}
+
+// Handler expõe as métricas no formato do Prometheus
+func Handler() http.Handler {
+	return promhttp.HandlerFor(Registry, promhttp.HandlerOpts{Registry: Registry})
+}
+
+// RegisterDBStats expõe as estatísticas do pool de conexões de db
+func RegisterDBStats(db *stdsql.DB, name string) {
+	Registry.MustRegister(collectors.NewDBStatsCollector(db, name))
+}
+
+// RegisterQueueDepth expõe a quantidade de mensagens aguardando na fila,
+// consultada a cada coleta
+func RegisterQueueDepth(queue string, depth func() (int, error)) {
+	Registry.MustRegister(prometheus.NewGaugeFunc(prometheus.GaugeOpts{
+		Namespace:   namespace,
+		Name:        "queue_messages_ready",
+		Help:        "Mensagens aguardando consumo na fila.",
+		ConstLabels: prometheus.Labels{"queue": queue},
+	}, func() float64 {
+		n, err := depth()
+		if err != nil {
+			slog.Warn("erro ao consultar o tamanho da fila", "queue", queue, "error", err)
+			return math.NaN()
+		}
+		return float64(n)
+	}))
+}
+
+// RegisterGauge expõe um valor de negócio, como a quantidade de débitos não
+// pagos, calculado por count a cada coleta. Se count falhar, o valor é NaN.
+func RegisterGauge(name, help string, count func(ctx context.Context) (int, error)) {
+	Registry.MustRegister(prometheus.NewGaugeFunc(prometheus.GaugeOpts{
+		Namespace: namespace,
+		Name:      name,
+		Help:      help,
+	}, func() float64 {
+		ctx, cancel := context.WithTimeout(context.Background(), collectTimeout)
+		defer cancel()
+
+		n, err := count(ctx)
+		if err != nil {
+			slog.Warn("erro ao calcular a métrica", "metric", name, "error", err)
+			return math.NaN()
+		}
+		return float64(n)
+	}))
+}
@@ -0,0 +1,19 @@
+package mixins
+
+import (
+	"entgo.io/ent"
+	"entgo.io/ent/schema/field"
+	"entgo.io/ent/schema/mixin"
+)
+
+// VersionMixin adiciona o version, incrementado pelo VersionHook em pkg/hooks
+// a cada alteração e devolvido como ETag pela API
+type VersionMixin struct {
+	mixin.Schema
+}
+
+func (VersionMixin) Fields() []ent.Field {
+	return []ent.Field{
+		field.Int("version").Default(1),
+	}
+}
@@ -0,0 +1,211 @@
+package pagination
+
+import (
+	"encoding/base64"
+	"encoding/json"
+	"errors"
+	"fmt"
+
+	"entgo.io/ent/dialect/sql"
+)
+
+// Coluna usada para desempatar a ordenação; deve ser única
+const tieBreaker = "id"
+
+// cursor guarda a posição do último registro entregue: a ordenação em que foi
+// gerado e os valores dos campos de ordenação, terminando pelo ID
+type cursor struct {
+	OrderBy string `json:"o"`
+	Values  []any  `json:"v"`
+}
+
+type sortKey struct {
+	column string
+	desc   bool
+}
+
+func decodeCursor(raw string) (*cursor, error) {
+	data, err := base64.RawURLEncoding.DecodeString(raw)
+	if err != nil {
+		return nil, err
+	}
+
+	var c cursor
+	if err := json.Unmarshal(data, &c); err != nil {
+		return nil, err
+	}
+	if c.OrderBy == "" || len(c.Values) == 0 {
+		return nil, errors.New("cursor incompleto")
+	}
+	return &c, nil
+}
+
+func (c cursor) encode() (string, error) {
+	data, err := json.Marshal(c)
+	if err != nil {
+		return "", err
+	}
+	return base64.RawURLEncoding.EncodeToString(data), nil
+}
+
+// SortField resolve um campo de order_by que não é coluna da tabela, como o
+// nome da categoria de um débito, em uma expressão SQL
+type SortField func(s *sql.Selector) sql.Querier
+
+// EdgeField ordena pela coluna column da tabela relacionada pela chave
+// estrangeira fk. where restringe os registros relacionados considerados,
+// como os que não estão na lixeira.
+func EdgeField(table, column, fk string, where ...func(*sql.Selector)) SortField {
+	return func(s *sql.Selector) sql.Querier {
+		build := sql.Dialect(s.Dialect())
+		t := build.Table(table)
+		sub := build.Select(t.C(column)).
+			From(t).
+			Where(sql.ColumnsEQ(t.C(tieBreaker), s.C(fk)))
+		for _, p := range where {
+			p(sub)
+		}
+
+		return sql.ExprFunc(func(b *sql.Builder) {
+			b.Wrap(func(b *sql.Builder) { b.Join(sub) })
+		})
+	}
+}
+
+// sortKeys retorna as colunas da ordenação, sempre terminando pelo ID para
+// que a ordem seja estável entre as páginas
+func (p *Pagination) sortKeys() []sortKey {
+	keys := append([]sortKey{}, p.sort...)
+	for _, key := range keys {
+		if key.column == tieBreaker {
+			return keys
+		}
+	}
+	return append(keys, sortKey{column: tieBreaker, desc: true})
+}
+
+// SortFields retorna os campos usados na ordenação, incluindo o ID
+func (p *Pagination) SortFields() []string {
+	keys := p.sortKeys()
+	fields := make([]string, 0, len(keys))
+	for _, key := range keys {
+		fields = append(fields, key.column)
+	}
+	return fields
+}
+
+func (k sortKey) expr(s *sql.Selector, fields map[string]SortField) sql.Querier {
+	if field, ok := fields[k.column]; ok {
+		return field(s)
+	}
+	return sql.Expr(s.C(k.column))
+}
+
+// Order aplica a ordenação da listagem. fields resolve os campos que não são
+// colunas da tabela. Valores nulos ficam por último, para que o cursor
+// consiga continuar a partir deles.
+func (p *Pagination) Order(fields map[string]SortField) func(*sql.Selector) {
+	keys := p.sortKeys()
+	return func(s *sql.Selector) {
+		for _, key := range keys {
+			expr := key.expr(s, fields)
+			direction := " ASC NULLS LAST"
+			if key.desc {
+				direction = " DESC NULLS LAST"
+			}
+			s.OrderExprFunc(func(b *sql.Builder) {
+				b.Join(expr).WriteString(direction)
+			})
+		}
+	}
+}
+
+// CursorPredicate filtra os registros posteriores ao cursor. Sem cursor, não
+// altera a consulta. O cursor já deve ter sido conferido por ValidateOrderBy.
+func (p *Pagination) CursorPredicate(fields map[string]SortField) func(*sql.Selector) {
+	keys := p.sortKeys()
+	if p.after == nil {
+		return func(*sql.Selector) {}
+	}
+
+	values := p.after.Values
+	return func(s *sql.Selector) {
+		exprs := make([]sql.Querier, len(keys))
+		for i, key := range keys {
+			exprs[i] = key.expr(s, fields)
+		}
+
+		// (k1 após v1) OU (k1 = v1 E k2 após v2) OU ...
+		var or []*sql.Predicate
+		for i, key := range keys {
+			beyond := afterValue(exprs[i], key.desc, values[i])
+			if beyond == nil {
+				continue
+			}
+
+			and := make([]*sql.Predicate, 0, i+1)
+			for j := 0; j < i; j++ {
+				and = append(and, equalValue(exprs[j], values[j]))
+			}
+			or = append(or, sql.And(append(and, beyond)...))
+		}
+		s.Where(sql.Or(or...))
+	}
+}
+
+// afterValue seleciona os valores que vêm depois de value na ordenação. Como
+// os nulos ficam por último, nada vem depois de um nulo na mesma coluna.
+func afterValue(expr sql.Querier, desc bool, value any) *sql.Predicate {
+	if value == nil {
+		return nil
+	}
+	op := sql.OpGT
+	if desc {
+		op = sql.OpLT
+	}
+	return sql.Or(compare(expr, op, value), isNull(expr))
+}
+
+func equalValue(expr sql.Querier, value any) *sql.Predicate {
+	if value == nil {
+		return isNull(expr)
+	}
+	return compare(expr, sql.OpEQ, value)
+}
+
+func compare(expr sql.Querier, op sql.Op, value any) *sql.Predicate {
+	return sql.P(func(b *sql.Builder) {
+		b.Join(expr).WriteOp(op).Arg(value)
+	})
+}
+
+func isNull(expr sql.Querier) *sql.Predicate {
+	return sql.P(func(b *sql.Builder) {
+		b.Join(expr).WriteOp(sql.OpIsNull)
+	})
+}
+
+// Trim remove o registro excedente buscado no modo por cursor e, se havia
+// próxima página, gera o NextCursor a partir do último registro. value
+// retorna o valor de uma coluna de ordenação do registro, incluindo o ID.
+func Trim[T any](p *Pagination, rows []T, value func(row T, column string) any) ([]T, error) {
+	if !p.cursorMode || len(rows) <= p.PageSize {
+		return rows, nil
+	}
+
+	rows = rows[:p.PageSize]
+	last := rows[len(rows)-1]
+
+	keys := p.sortKeys()
+	next := cursor{OrderBy: p.OrderBy, Values: make([]any, 0, len(keys))}
+	for _, key := range keys {
+		next.Values = append(next.Values, value(last, key.column))
+	}
+
+	encoded, err := next.encode()
+	if err != nil {
+		return nil, fmt.Errorf("erro ao gerar cursor: %w", err)
+	}
+	p.NextCursor = encoded
+	return rows, nil
+}
@@ -0,0 +1,281 @@
+package pagination
+
+import (
+	"net/http/httptest"
+	"net/url"
+	"reflect"
+	"testing"
+
+	"entgo.io/ent/dialect"
+	"entgo.io/ent/dialect/sql"
+	"github.com/gin-gonic/gin"
+)
+
+func init() {
+	gin.SetMode(gin.TestMode)
+}
+
+var testColumns = map[string]bool{"name": true, "amount": true}
+
+type testRow struct {
+	ID     string
+	Name   string
+	Amount *float64
+}
+
+func testValue(row testRow, column string) any {
+	switch column {
+	case "name":
+		return row.Name
+	case "amount":
+		if row.Amount == nil {
+			return nil
+		}
+		return *row.Amount
+	}
+	return row.ID
+}
+
+func amount(v float64) *float64 {
+	return &v
+}
+
+func newTestPagination(t *testing.T, query url.Values) (*Pagination, error) {
+	t.Helper()
+	c, _ := gin.CreateTestContext(httptest.NewRecorder())
+	c.Request = httptest.NewRequest("GET", "/?"+query.Encode(), nil)
+	return NewPagination(c)
+}
+
+func encodeTestCursor(t *testing.T, c cursor) string {
+	t.Helper()
+	raw, err := c.encode()
+	if err != nil {
+		t.Fatalf("encode() erro inesperado: %v", err)
+	}
+	return raw
+}
+
+func TestCursorRoundTrip(t *testing.T) {
+	tests := []struct {
+		name    string
+		orderBy string
+		last    testRow
+		want    []any
+	}{
+		{
+			name:    "ordenação padrão",
+			orderBy: "",
+			last:    testRow{ID: "b", Name: "Luz"},
+			want:    []any{"Luz", "b"},
+		},
+		{
+			name:    "número decrescente",
+			orderBy: "-amount,name",
+			last:    testRow{ID: "b", Name: "Luz", Amount: amount(12.5)},
+			want:    []any{12.5, "Luz", "b"},
+		},
+		{
+			name:    "valor nulo",
+			orderBy: "amount",
+			last:    testRow{ID: "b", Name: "Luz"},
+			want:    []any{nil, "b"},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			query := url.Values{"cursor": {""}, "page_size": {"2"}}
+			if tt.orderBy != "" {
+				query.Set("order_by", tt.orderBy)
+			}
+
+			first, err := newTestPagination(t, query)
+			if err != nil {
+				t.Fatalf("NewPagination() erro inesperado: %v", err)
+			}
+			if err := first.ValidateOrderBy("name", testColumns); err != nil {
+				t.Fatalf("ValidateOrderBy() erro inesperado: %v", err)
+			}
+
+			rows := []testRow{{ID: "a", Name: "Água"}, tt.last, {ID: "c", Name: "Mercado"}}
+			page, err := Trim(first, rows, testValue)
+			if err != nil {
+				t.Fatalf("Trim() erro inesperado: %v", err)
+			}
+			if len(page) != 2 || first.NextCursor == "" {
+				t.Fatalf("Trim() = %d registros, cursor %q; esperado 2 registros e um cursor", len(page), first.NextCursor)
+			}
+
+			query.Set("cursor", first.NextCursor)
+			next, err := newTestPagination(t, query)
+			if err != nil {
+				t.Fatalf("NewPagination() com o cursor gerado: %v", err)
+			}
+			if err := next.ValidateOrderBy("name", testColumns); err != nil {
+				t.Fatalf("ValidateOrderBy() com o cursor gerado: %v", err)
+			}
+			if !reflect.DeepEqual(next.after.Values, tt.want) {
+				t.Errorf("valores do cursor = %#v, esperado %#v", next.after.Values, tt.want)
+			}
+		})
+	}
+}
+
+func TestTrimLastPage(t *testing.T) {
+	pgn, err := newTestPagination(t, url.Values{"cursor": {""}, "page_size": {"2"}})
+	if err != nil {
+		t.Fatalf("NewPagination() erro inesperado: %v", err)
+	}
+
+	rows := []testRow{{ID: "a"}, {ID: "b"}}
+	page, err := Trim(pgn, rows, testValue)
+	if err != nil {
+		t.Fatalf("Trim() erro inesperado: %v", err)
+	}
+	if len(page) != 2 || pgn.NextCursor != "" {
+		t.Errorf("Trim() = %d registros, cursor %q; esperado 2 registros sem cursor", len(page), pgn.NextCursor)
+	}
+}
+
+func TestInvalidCursor(t *testing.T) {
+	tests := []struct {
+		name    string
+		cursor  func(t *testing.T) string
+		orderBy string
+	}{
+		{
+			name:   "base64 inválido",
+			cursor: func(*testing.T) string { return "não é base64" },
+		},
+		{
+			name:   "JSON inválido",
+			cursor: func(*testing.T) string { return "e30x" },
+		},
+		{
+			name: "sem ordenação",
+			cursor: func(t *testing.T) string {
+				return encodeTestCursor(t, cursor{Values: []any{"Luz", "b"}})
+			},
+		},
+		{
+			name: "sem valores",
+			cursor: func(t *testing.T) string {
+				return encodeTestCursor(t, cursor{OrderBy: "name"})
+			},
+		},
+		{
+			name: "outra ordenação",
+			cursor: func(t *testing.T) string {
+				return encodeTestCursor(t, cursor{OrderBy: "amount", Values: []any{1.0, "b"}})
+			},
+		},
+		{
+			name: "faltando o ID",
+			cursor: func(t *testing.T) string {
+				return encodeTestCursor(t, cursor{OrderBy: "name", Values: []any{"Luz"}})
+			},
+		},
+		{
+			name: "valores a mais",
+			cursor: func(t *testing.T) string {
+				return encodeTestCursor(t, cursor{OrderBy: "name", Values: []any{"Luz", "b", "c"}})
+			},
+		},
+		{
+			name:    "valores de outra ordenação com o mesmo order_by",
+			orderBy: "-amount,name",
+			cursor: func(t *testing.T) string {
+				return encodeTestCursor(t, cursor{OrderBy: "-amount,name", Values: []any{1.0, "b"}})
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			query := url.Values{"cursor": {tt.cursor(t)}}
+			if tt.orderBy != "" {
+				query.Set("order_by", tt.orderBy)
+			}
+
+			pgn, err := newTestPagination(t, query)
+			if err == nil {
+				err = pgn.ValidateOrderBy("name", testColumns)
+			}
+			if err == nil || err.Error() != "cursor: valor invalido" {
+				t.Errorf("erro = %v, esperado cursor: valor invalido", err)
+			}
+		})
+	}
+}
+
+func TestCursorPredicate(t *testing.T) {
+	tests := []struct {
+		name      string
+		orderBy   string
+		values    []any
+		wantQuery string
+		wantArgs  []any
+	}{
+		{
+			name:      "crescente",
+			orderBy:   "name",
+			values:    []any{"Luz", "b"},
+			wantQuery: `SELECT * FROM "items" WHERE "items"."name" > $1 OR "items"."name" IS NULL OR ("items"."name" = $2 AND ("items"."id" < $3 OR "items"."id" IS NULL))`,
+			wantArgs:  []any{"Luz", "Luz", "b"},
+		},
+		{
+			name:      "decrescente",
+			orderBy:   "-amount",
+			values:    []any{12.5, "b"},
+			wantQuery: `SELECT * FROM "items" WHERE "items"."amount" < $1 OR "items"."amount" IS NULL OR ("items"."amount" = $2 AND ("items"."id" < $3 OR "items"."id" IS NULL))`,
+			wantArgs:  []any{12.5, 12.5, "b"},
+		},
+		{
+			name:      "valor nulo só continua pelo ID",
+			orderBy:   "amount",
+			values:    []any{nil, "b"},
+			wantQuery: `SELECT * FROM "items" WHERE "items"."amount" IS NULL AND ("items"."id" < $1 OR "items"."id" IS NULL)`,
+			wantArgs:  []any{"b"},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			raw := encodeTestCursor(t, cursor{OrderBy: tt.orderBy, Values: tt.values})
+			pgn, err := newTestPagination(t, url.Values{"cursor": {raw}, "order_by": {tt.orderBy}})
+			if err != nil {
+				t.Fatalf("NewPagination() erro inesperado: %v", err)
+			}
+			if err := pgn.ValidateOrderBy("name", testColumns); err != nil {
+				t.Fatalf("ValidateOrderBy() erro inesperado: %v", err)
+			}
+
+			s := sql.Dialect(dialect.Postgres).Select("*").From(sql.Table("items"))
+			pgn.CursorPredicate(nil)(s)
+			query, args := s.Query()
+			if query != tt.wantQuery {
+				t.Errorf("query = %s\nesperado %s", query, tt.wantQuery)
+			}
+			if !reflect.DeepEqual(args, tt.wantArgs) {
+				t.Errorf("args = %#v, esperado %#v", args, tt.wantArgs)
+			}
+		})
+	}
+}
+
+func TestCursorPredicateWithoutCursor(t *testing.T) {
+	pgn, err := newTestPagination(t, url.Values{"cursor": {""}})
+	if err != nil {
+		t.Fatalf("NewPagination() erro inesperado: %v", err)
+	}
+	if err := pgn.ValidateOrderBy("name", testColumns); err != nil {
+		t.Fatalf("ValidateOrderBy() erro inesperado: %v", err)
+	}
+
+	s := sql.Dialect(dialect.Postgres).Select("*").From(sql.Table("items"))
+	pgn.CursorPredicate(nil)(s)
+	if query, _ := s.Query(); query != `SELECT * FROM "items"` {
+		t.Errorf("query = %s, esperado sem filtro", query)
+	}
+}
@@ -3,6 +3,7 @@ package pagination
 import (
 	"fmt"
 	"strconv"
+	"strings"
 
 	"github.com/gin-gonic/gin"
 )
@@ -18,6 +19,21 @@ type Pagination struct {
 	PageSize int    `json:"page_size"`
 	OrderBy  string `json:"order_by"`
 	Search   string `json:"search"`
+	// WithTotal indica se o total de registros deve ser contado. Por padrão,
+	// é contado no modo por página e não no modo por cursor.
+	WithTotal bool `json:"with_total"`
+	// NextCursor é preenchido pelo repositório quando há uma próxima página
+	// no modo por cursor
+	NextCursor string `json:"next_cursor,omitempty"`
+
+	// Modo por cursor (keyset), ativado pelo parâmetro cursor; vazio começa
+	// na primeira página
+	cursorMode bool
+	after      *cursor
+	// Campos de ordenação já validados, na ordem de prioridade
+	sort []sortKey
+	// Indica que order_by não foi informado e a ordenação padrão foi usada
+	defaultOrder bool
 }
 
 func NewPagination(c *gin.Context) (*Pagination, error) {
@@ -34,34 +50,140 @@ func NewPagination(c *gin.Context) (*Pagination, error) {
 	orderBy := c.Query("order_by")
 	search := c.Query("search")
 
-	return &Pagination{
+	pgn := &Pagination{
 		Page:     page,
 		PageSize: pageSize,
 		OrderBy:  orderBy,
 		Search:   search,
-	}, nil
+	}
+
+	if raw, ok := c.GetQuery("cursor"); ok {
+		pgn.cursorMode = true
+		pgn.Page = 1
+		if raw != "" {
+			if pgn.after, err = decodeCursor(raw); err != nil {
+				return nil, fmt.Errorf("%s: %s", "cursor", "valor invalido")
+			}
+		}
+	}
+
+	pgn.WithTotal = !pgn.cursorMode
+	if raw := c.Query("total"); raw != "" {
+		if pgn.WithTotal, err = strconv.ParseBool(raw); err != nil {
+			return nil, fmt.Errorf("%s: %s", "total", "valor invalido")
+		}
+	}
+
+	return pgn, nil
 }
 
 func (p *Pagination) Offset() int {
+	if p.cursorMode {
+		return 0
+	}
 	return (p.Page - 1) * p.PageSize
 }
 
+// Limit retorna quantos registros buscar. No modo por cursor, busca um a
+// mais para saber se existe próxima página; o excedente é removido por Trim.
+func (p *Pagination) Limit() int {
+	if p.cursorMode {
+		return p.PageSize + 1
+	}
+	return p.PageSize
+}
+
+// UsesCursor indica se a listagem está no modo por cursor
+func (p *Pagination) UsesCursor() bool {
+	return p.cursorMode
+}
+
+// UsesDefaultOrder indica se a ordenação padrão foi usada, sem order_by
+func (p *Pagination) UsesDefaultOrder() bool {
+	return p.defaultOrder
+}
+
+// ValidateOrderBy valida a ordenação pedida em order_by, uma lista de campos
+// separados por vírgula em ordem de prioridade. O prefixo "-" ordena o campo
+// de forma decrescente (ex: -due_date,title). Sem order_by, usa defaultOrder.
 func (p *Pagination) ValidateOrderBy(defaultOrder string, validColumns map[string]bool) error {
-	if p.OrderBy == "" {
+	p.defaultOrder = p.OrderBy == ""
+	if p.defaultOrder {
 		p.OrderBy = defaultOrder
-		return nil
 	}
-	if p.OrderBy != "" && !validColumns[p.OrderBy] {
-		return fmt.Errorf("%s: %s", "order_by", "valor invalido")
+
+	seen := make(map[string]bool)
+	p.sort = p.sort[:0]
+	for _, field := range strings.Split(p.OrderBy, ",") {
+		key := sortKey{column: strings.TrimSpace(field)}
+		if column, ok := strings.CutPrefix(key.column, "-"); ok {
+			key.column, key.desc = column, true
+		}
+
+		if !validColumns[key.column] || seen[key.column] {
+			return fmt.Errorf("%s: %s", "order_by", "valor invalido")
+		}
+		seen[key.column] = true
+		p.sort = append(p.sort, key)
+	}
+
+	// O cursor só vale para a ordenação em que foi gerado e precisa trazer
+	// um valor para cada campo dela, incluindo o ID
+	if p.after != nil && (p.after.OrderBy != p.OrderBy || len(p.after.Values) != len(p.sortKeys())) {
+		return fmt.Errorf("%s: %s", "cursor", "valor invalido")
 	}
 	return nil
 }
 
+// SetPaginationHeaders devolve os dados da paginação nos cabeçalhos e os
+// links para as páginas vizinhas no cabeçalho Link. Sem a contagem (total
+// negativo ou WithTotal falso), X-Total-Count e X-Total-Pages são omitidos.
 func (p *Pagination) SetPaginationHeaders(c *gin.Context, total int) {
-	totalPages := (total + p.PageSize - 1) / p.PageSize
-
-	c.Header("X-Page", strconv.Itoa(p.Page))
 	c.Header("X-Page-Size", strconv.Itoa(p.PageSize))
-	c.Header("X-Total-Count", strconv.Itoa(total))
-	c.Header("X-Total-Pages", strconv.Itoa(totalPages))
+
+	var links []string
+	if p.cursorMode {
+		if p.NextCursor != "" {
+			c.Header("X-Next-Cursor", p.NextCursor)
+			links = append(links, link(c, "next", map[string]string{"cursor": p.NextCursor}))
+		}
+	} else {
+		c.Header("X-Page", strconv.Itoa(p.Page))
+		links = append(links, link(c, "first", map[string]string{"page": "1"}))
+		if p.Page > 1 {
+			links = append(links, link(c, "prev", map[string]string{"page": strconv.Itoa(p.Page - 1)}))
+		}
+	}
+
+	if p.WithTotal && total >= 0 {
+		totalPages := (total + p.PageSize - 1) / p.PageSize
+
+		c.Header("X-Total-Count", strconv.Itoa(total))
+		c.Header("X-Total-Pages", strconv.Itoa(totalPages))
+
+		if !p.cursorMode {
+			if p.Page < totalPages {
+				links = append(links, link(c, "next", map[string]string{"page": strconv.Itoa(p.Page + 1)}))
+			}
+			if totalPages > 0 {
+				links = append(links, link(c, "last", map[string]string{"page": strconv.Itoa(totalPages)}))
+			}
+		}
+	}
+
+	if len(links) > 0 {
+		c.Header("Link", strings.Join(links, ", "))
+	}
+}
+
+// link monta um item do cabeçalho Link com a URL da requisição atual e os
+// parâmetros alterados
+func link(c *gin.Context, rel string, params map[string]string) string {
+	u := *c.Request.URL
+	query := u.Query()
+	for key, value := range params {
+		query.Set(key, value)
+	}
+	u.RawQuery = query.Encode()
+	return fmt.Sprintf("<%s>; rel=\"%s\"", u.RequestURI(), rel)
 }
@@ -0,0 +1,45 @@
+package requestid
+
+import (
+	"context"
+
+	"github.com/google/uuid"
+)
+
+// Header usado para receber e devolver o ID da requisição
+const Header = "X-Request-ID"
+
+// Tamanho máximo aceito para um ID enviado pelo cliente
+const MaxLength = 128
+
+type contextKey struct{}
+
+// New gera um novo ID de requisição
+func New() string {
+	return uuid.NewString()
+}
+
+// WithRequestID adiciona o ID da requisição ao contexto
+func WithRequestID(ctx context.Context, id string) context.Context {
+	return context.WithValue(ctx, contextKey{}, id)
+}
+
+// FromContext retorna o ID da requisição presente no contexto
+func FromContext(ctx context.Context) (string, bool) {
+	id, ok := ctx.Value(contextKey{}).(string)
+	return id, ok && id != ""
+}
+
+// Valid indica se o ID enviado pelo cliente pode ser reaproveitado: até
+// MaxLength caracteres ASCII visíveis, sem espaços
+func Valid(id string) bool {
+	if id == "" || len(id) > MaxLength {
+		return false
+	}
+	for i := 0; i < len(id); i++ {
+		if id[i] <= ' ' || id[i] > '~' {
+			return false
+		}
+	}
+	return true
+}
@@ -0,0 +1,102 @@
+package tracing
+
+import (
+	"context"
+	stdsql "database/sql"
+
+	"entgo.io/ent/dialect"
+	"go.opentelemetry.io/otel/attribute"
+	semconv "go.opentelemetry.io/otel/semconv/v1.26.0"
+	"go.opentelemetry.io/otel/trace"
+)
+
+// Driver cria um span para cada operação de um driver do ent, com o SQL
+// executado (sem os argumentos)
+type Driver struct {
+	dialect.Driver
+}
+
+func NewDriver(drv dialect.Driver) *Driver {
+	return &Driver{Driver: drv}
+}
+
+func (d *Driver) Exec(ctx context.Context, query string, args, v any) error {
+	return traceQuery(ctx, "exec", query, func(ctx context.Context) error {
+		return d.Driver.Exec(ctx, query, args, v)
+	})
+}
+
+func (d *Driver) Query(ctx context.Context, query string, args, v any) error {
+	return traceQuery(ctx, "query", query, func(ctx context.Context) error {
+		return d.Driver.Query(ctx, query, args, v)
+	})
+}
+
+func (d *Driver) Tx(ctx context.Context) (dialect.Tx, error) {
+	return d.BeginTx(ctx, nil)
+}
+
+// BeginTx abre um span que cobre toda a transação, até o commit ou rollback
+func (d *Driver) BeginTx(ctx context.Context, opts *stdsql.TxOptions) (dialect.Tx, error) {
+	ctx, span := Start(ctx, "db.transaction", trace.SpanKindClient, dbSystem)
+
+	var tx dialect.Tx
+	var err error
+	if drv, ok := d.Driver.(interface {
+		BeginTx(context.Context, *stdsql.TxOptions) (dialect.Tx, error)
+	}); ok {
+		tx, err = drv.BeginTx(ctx, opts)
+	} else {
+		tx, err = d.Driver.Tx(ctx)
+	}
+	if err != nil {
+		End(span, err)
+		return nil, err
+	}
+	return &Tx{Tx: tx, span: span}, nil
+}
+
+// Tx cria spans para as operações de uma transação e encerra o span dela no
+// commit ou rollback
+type Tx struct {
+	dialect.Tx
+	span trace.Span
+}
+
+func (t *Tx) Exec(ctx context.Context, query string, args, v any) error {
+	return traceQuery(ctx, "exec", query, func(ctx context.Context) error {
+		return t.Tx.Exec(ctx, query, args, v)
+	})
+}
+
+func (t *Tx) Query(ctx context.Context, query string, args, v any) error {
+	return traceQuery(ctx, "query", query, func(ctx context.Context) error {
+		return t.Tx.Query(ctx, query, args, v)
+	})
+}
+
+func (t *Tx) Commit() error {
+	err := t.Tx.Commit()
+	t.span.SetAttributes(attribute.String("db.transaction.result", "commit"))
+	End(t.span, err)
+	return err
+}
+
+func (t *Tx) Rollback() error {
+	err := t.Tx.Rollback()
+	t.span.SetAttributes(attribute.String("db.transaction.result", "rollback"))
+	End(t.span, err)
+	return err
+}
+
+var dbSystem = semconv.DBSystemPostgreSQL
+
+func traceQuery(ctx context.Context, operation, query string, fn func(context.Context) error) error {
+	ctx, span := Start(ctx, "db."+operation, trace.SpanKindClient,
+		dbSystem,
+		semconv.DBQueryText(query),
+	)
+	err := fn(ctx)
+	End(span, err)
+	return err
+}
@@ -1,6 +1,7 @@
 package utils
 
 import (
+	"math"
 	"regexp"
 	"strings"
 	"time"
@@ -85,3 +86,8 @@ func SanitizeString(s string) string {
 	re := regexp.MustCompile(`[^a-zA-Z0-9\s]`) // Remove caracteres especiais, mantendo letras, números e espaço
 	return strings.ToLower(re.ReplaceAllString(RemoveAccents(s), ""))
 }
+
+// RoundCents arredonda o valor para centavos
+func RoundCents(v float64) float64 {
+	return math.Round(v*100) / 100
+}